@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/recording"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 )
 
@@ -55,6 +56,10 @@ type TestData struct {
 
 	// resourceLabel is the local used for the resource - generally "test""
 	resourceLabel string
+
+	// random is the source of random values for this test when the traffic is being recorded/replayed, so that
+	// the same values are generated when replaying this test
+	random *rand.Rand
 }
 
 // BuildTestData generates some test data for the given resource
@@ -85,9 +90,59 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 		Secondary: os.Getenv("ARM_TEST_SUBSCRIPTION_ID_ALT"),
 	}
 
+	if session := recording.SessionFor(t); session != nil {
+		testData.configureForRecording(t, session)
+	}
+
 	return testData
 }
 
+// configureForRecording generates the random values for this test from the Session, so that the same values are
+// used when the test is replayed - and (when replaying) uses the values the test was recorded with
+func (td *TestData) configureForRecording(t *testing.T, session *recording.Session) {
+	td.random = session.Random()
+	// the time is in UTC so that the same values are generated regardless of the timezone the test is replayed in
+	td.RandomInteger = randTimeIntAt(session.RecordedAt().UTC(), td.random)
+	td.RandomString = randStringFromCharSetUsing(td.random, 5, charSetAlphaNum)
+
+	// Resource Provider registration is cached for the process rather than the test, so it can't be replayed and
+	// is skipped - as such the Resource Providers must already be registered when recording
+	t.Setenv("ARM_SKIP_PROVIDER_REGISTRATION", "true")
+
+	// the Locations and Resource Providers used for Enhanced Validation are also cached for the process, so this
+	// is disabled too - which means that invalid values are instead caught by the API when recording
+	t.Setenv("ARM_PROVIDER_ENHANCED_VALIDATION", "false")
+
+	if session.Mode() != recording.ModeReplay {
+		return
+	}
+
+	primary, secondary, ternary := session.Locations()
+	td.Locations = Regions{
+		Primary:   primary,
+		Secondary: secondary,
+		Ternary:   ternary,
+	}
+	td.Subscriptions = Subscriptions{
+		Primary:   recording.ReplaySubscriptionId,
+		Secondary: recording.ReplaySubscriptionIdAlt,
+	}
+
+	// the Provider (and the PreCheck) are configured from the Environment, which must match the recorded traffic
+	for k, v := range map[string]string{
+		"ARM_SUBSCRIPTION_ID":          recording.ReplaySubscriptionId,
+		"ARM_TEST_SUBSCRIPTION_ID_ALT": recording.ReplaySubscriptionIdAlt,
+		"ARM_TENANT_ID":                recording.ReplayTenantId,
+		"ARM_CLIENT_ID":                recording.ReplayClientId,
+		"ARM_CLIENT_SECRET":            "replay",
+		"ARM_TEST_LOCATION":            primary,
+		"ARM_TEST_LOCATION_ALT":        secondary,
+		"ARM_TEST_LOCATION_ALT2":       ternary,
+	} {
+		t.Setenv(k, v)
+	}
+}
+
 // RandomIntOfLength is a random 8 to 18 digit integer which is unique to this test case
 func (td *TestData) RandomIntOfLength(len int) int {
 	// len should not be
@@ -123,6 +178,10 @@ func (td *TestData) RandomStringOfLength(len int) string {
 		panic("Invalid Test: RandomStringOfLength: length argument must be between 1 and 1024 characters")
 	}
 
+	if td.random != nil {
+		return randStringFromCharSetUsing(td.random, len, charSetAlphaNum)
+	}
+
 	return randString(len)
 }
 
@@ -140,3 +199,13 @@ func randStringFromCharSet(strlen int, charSet string) string {
 	}
 	return string(result)
 }
+
+// randStringFromCharSetUsing generates a random string by selecting characters from
+// the charset provided, using the specified source of random values
+func randStringFromCharSetUsing(rng *rand.Rand, strlen int, charSet string) string {
+	result := make([]byte, strlen)
+	for i := 0; i < strlen; i++ {
+		result[i] = charSet[rng.Intn(len(charSet))]
+	}
+	return string(result)
+}
@@ -4,6 +4,8 @@
 package acceptance
 
 import (
+	"fmt"
+	"math/rand"
 	"strconv"
 	"strings"
 	"time"
@@ -12,14 +14,23 @@ import (
 )
 
 func RandTimeInt() int {
+	return randTimeIntAt(time.Now().Local(), nil)
+}
+
+// randTimeIntAt returns a value in the same format as RandTimeInt for the specified time, where the random
+// postfix is generated from rng when specified (for example when replaying a recorded test)
+func randTimeIntAt(t time.Time, rng *rand.Rand) int {
 	// acctest.RantInt() returns a value of size:
 	// 000000000000000000
 	// YYMMddHHmmsshhRRRR
 
 	// go format: 2006-01-02 15:04:05.00
 
-	timeStr := strings.Replace(time.Now().Local().Format("060102150405.00"), ".", "", 1) // no way to not have a .?
+	timeStr := strings.Replace(t.Format("060102150405.00"), ".", "", 1) // no way to not have a .?
 	postfix := acctest.RandStringFromCharSet(4, "0123456789")
+	if rng != nil {
+		postfix = fmt.Sprintf("%04d", rng.Intn(10000))
+	}
 
 	i, err := strconv.Atoi(timeStr + postfix)
 	if err != nil {
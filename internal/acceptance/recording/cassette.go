@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recording
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+// recordingsDirectory is the directory (relative to the package containing the test) containing the Cassettes
+var recordingsDirectory = filepath.Join("testdata", "recordings")
+
+// Cassette contains the (sanitized) traffic recorded for a single test, alongside the values required to
+// reproduce the same requests when this is replayed
+type Cassette struct {
+	// Name is the name of the test this traffic was recorded for
+	Name string `json:"name"`
+
+	// Seed is the seed used to generate the random values for this test
+	Seed int64 `json:"seed"`
+
+	// RecordedAt is the time at which this test was recorded, used to generate the random integers for this test
+	RecordedAt time.Time `json:"recorded_at"`
+
+	// Locations are the Azure Regions used by this test (the Primary, Secondary and Ternary location)
+	Locations []string `json:"locations"`
+
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a single request sent during the test, and the response received for it
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+type RecordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   Body   `json:"body,omitempty"`
+}
+
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       Body        `json:"body,omitempty"`
+}
+
+// Body is the body of a request/response, which is serialized as a string when this is valid UTF-8 (so that
+// it can be reviewed) and otherwise as base64
+type Body []byte
+
+func (b Body) MarshalJSON() ([]byte, error) {
+	if utf8.Valid(b) {
+		return json.Marshal(string(b))
+	}
+	return json.Marshal(map[string]string{
+		"base64": base64.StdEncoding.EncodeToString(b),
+	})
+}
+
+func (b *Body) UnmarshalJSON(input []byte) error {
+	var raw string
+	if err := json.Unmarshal(input, &raw); err == nil {
+		*b = Body(raw)
+		return nil
+	}
+
+	var encoded map[string]string
+	if err := json.Unmarshal(input, &encoded); err != nil {
+		return fmt.Errorf("expected the body to be a string or an object containing a `base64` value: %+v", err)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded["base64"])
+	if err != nil {
+		return fmt.Errorf("decoding the body: %+v", err)
+	}
+	*b = decoded
+	return nil
+}
+
+// cassetteNameForTest returns the name of the Cassette for the specified test, which is unique within the package
+func cassetteNameForTest(t *testing.T) string {
+	return strings.NewReplacer("/", "_", " ", "_").Replace(t.Name())
+}
+
+func cassettePath(name string) string {
+	return filepath.Join(recordingsDirectory, fmt.Sprintf("%s.json", name))
+}
+
+func loadCassette(name string) (*Cassette, error) {
+	path := cassettePath(name)
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no recording exists at %q - this test must be recorded by running it with `%s=%s`", path, EnvironmentVariable, ModeRecord)
+		}
+		return nil, fmt.Errorf("reading %q: %+v", path, err)
+	}
+
+	var cassette Cassette
+	if err := json.Unmarshal(contents, &cassette); err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", path, err)
+	}
+
+	return &cassette, nil
+}
+
+func (c Cassette) save() error {
+	path := cassettePath(c.Name)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("creating the directory for %q: %+v", path, err)
+	}
+
+	contents, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing the recording: %+v", err)
+	}
+
+	if err := os.WriteFile(path, append(contents, '\n'), 0o644); err != nil { // nolint:gosec
+		return fmt.Errorf("writing %q: %+v", path, err)
+	}
+
+	return nil
+}
+
+// interactionKey returns the key used to match a request to the recorded Interactions
+func interactionKey(method string, input string) string {
+	u, err := url.Parse(input)
+	if err != nil {
+		return fmt.Sprintf("%s %s", strings.ToUpper(method), input)
+	}
+
+	return fmt.Sprintf("%s %s://%s%s", strings.ToUpper(method), strings.ToLower(u.Scheme), strings.ToLower(u.Host), u.RequestURI())
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recording
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+var subscriptionIdPattern = regexp.MustCompile(`(?i)/subscriptions/([0-9a-f-]{36})`)
+
+// TestCommittedRecordingsAreSanitized checks that the recordings committed to the repository can be replayed and
+// don't contain any real identifiers or secrets
+func TestCommittedRecordingsAreSanitized(t *testing.T) {
+	root := filepath.Join("..", "..", "..")
+	paths, err := filepath.Glob(filepath.Join(root, "internal", "services", "*", recordingsDirectory, "*.json"))
+	if err != nil {
+		t.Fatalf("finding the recordings: %+v", err)
+	}
+
+	for _, path := range paths {
+		t.Run(strings.TrimPrefix(path, root+string(filepath.Separator)), func(t *testing.T) {
+			contents, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("reading: %+v", err)
+			}
+
+			var cassette Cassette
+			decoder := json.NewDecoder(bytes.NewReader(contents))
+			decoder.DisallowUnknownFields()
+			if err := decoder.Decode(&cassette); err != nil {
+				t.Fatalf("parsing: %+v", err)
+			}
+
+			if expected := strings.TrimSuffix(filepath.Base(path), ".json"); cassette.Name != expected {
+				t.Fatalf("expected the name to be %q but got %q", expected, cassette.Name)
+			}
+			if cassette.Seed == 0 || cassette.RecordedAt.IsZero() {
+				t.Fatalf("expected the seed and the time the test was recorded at to be set")
+			}
+			if len(cassette.Interactions) == 0 {
+				t.Fatalf("expected the recording to contain at least one interaction")
+			}
+
+			for _, match := range subscriptionIdPattern.FindAllStringSubmatch(string(contents), -1) {
+				if id := strings.ToLower(match[1]); id != ReplaySubscriptionId && id != ReplaySubscriptionIdAlt {
+					t.Fatalf("expected only the replay Subscription IDs to be used but got %q", match[0])
+				}
+			}
+
+			for i, interaction := range cassette.Interactions {
+				request, err := http.NewRequest(interaction.Request.Method, interaction.Request.URL, nil)
+				if err != nil {
+					t.Fatalf("parsing the request for interaction %d: %+v", i, err)
+				}
+
+				for _, header := range ignoredResponseHeaders {
+					if _, ok := interaction.Response.Headers[header]; ok {
+						t.Fatalf("expected the %q header to be removed from interaction %d", header, i)
+					}
+				}
+
+				// redacting a sanitized recording again shouldn't change anything
+				headers := interaction.Response.Headers.Clone()
+				common.RedactHeaders(request, headers, redactedSecret)
+				if !reflect.DeepEqual(headers, interaction.Response.Headers) {
+					t.Fatalf("expected the response headers for interaction %d to be redacted", i)
+				}
+				for name, body := range map[string]Body{
+					"request":  interaction.Request.Body,
+					"response": interaction.Response.Body,
+				} {
+					if redacted := common.RedactBody(request, body, redactedSecret); !bytes.Equal(redacted, body) {
+						t.Fatalf("expected the %s body for interaction %d to be redacted but got %s", name, i, body)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recording
+
+import (
+	"os"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// EnvironmentVariable is the Environment Variable used to switch the Acceptance Tests between running against
+// a live Subscription (the default), recording the traffic sent and replaying previously recorded traffic
+const EnvironmentVariable = "ARM_TEST_RECORDING_MODE"
+
+type Mode string
+
+const (
+	// ModeLive runs the Acceptance Tests against a live Subscription, without recording any traffic
+	ModeLive Mode = "live"
+
+	// ModeRecord runs the Acceptance Tests against a live Subscription, recording the traffic sent into a Cassette
+	ModeRecord Mode = "record"
+
+	// ModeReplay runs the Acceptance Tests against the traffic previously recorded into a Cassette, without
+	// requiring a Subscription (or network access)
+	ModeReplay Mode = "replay"
+)
+
+// The identifiers used in place of the real identifiers when traffic is recorded, which are then used when replaying
+const (
+	ReplaySubscriptionId    = "00000000-0000-0000-0000-000000000000"
+	ReplaySubscriptionIdAlt = "11111111-1111-1111-1111-111111111111"
+	ReplayTenantId          = "22222222-2222-2222-2222-222222222222"
+	ReplayClientId          = "33333333-3333-3333-3333-333333333333"
+	ReplayObjectId          = "44444444-4444-4444-4444-444444444444"
+)
+
+// CurrentMode returns the Mode configured using the `ARM_TEST_RECORDING_MODE` Environment Variable
+func CurrentMode() Mode {
+	switch strings.ToLower(os.Getenv(EnvironmentVariable)) {
+	case string(ModeRecord):
+		return ModeRecord
+	case string(ModeReplay):
+		return ModeReplay
+	}
+
+	return ModeLive
+}
+
+// Enabled returns whether traffic is being recorded or replayed
+func Enabled() bool {
+	return CurrentMode() != ModeLive
+}
+
+var (
+	activeLock    = &sync.Mutex{}
+	activeSession *Session
+)
+
+// SessionFor returns the Session recording/replaying the traffic for the specified test, starting this if necessary,
+// or nil when traffic isn't being recorded or replayed.
+//
+// Since the traffic for all clients (including the shared test client) is attributed to the active Session, only a
+// single test can be recorded/replayed at a time - as such these tests are run sequentially.
+func SessionFor(t *testing.T) *Session {
+	mode := CurrentMode()
+	if mode == ModeLive {
+		return nil
+	}
+
+	activeLock.Lock()
+	defer activeLock.Unlock()
+
+	if activeSession != nil {
+		if activeSession.t == t {
+			return activeSession
+		}
+		t.Fatalf("unable to %s %q since %q is already being recorded/replayed - these tests must be run sequentially", mode, t.Name(), activeSession.t.Name())
+		return nil
+	}
+
+	session, err := newSession(t, cassetteNameForTest(t), mode)
+	if err != nil {
+		t.Fatalf("starting to %s %q: %+v", mode, t.Name(), err)
+		return nil
+	}
+
+	activeSession = session
+	t.Cleanup(func() {
+		activeLock.Lock()
+		if activeSession == session {
+			activeSession = nil
+		}
+		activeLock.Unlock()
+
+		if err := session.close(); err != nil {
+			t.Errorf("finishing the %s of %q: %+v", mode, t.Name(), err)
+		}
+	})
+
+	return session
+}
+
+func currentSession() *Session {
+	activeLock.Lock()
+	defer activeLock.Unlock()
+
+	return activeSession
+}
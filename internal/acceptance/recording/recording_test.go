@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recording
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+const (
+	testSubscriptionId = "12345678-9abc-def0-1234-56789abcdef0"
+	testStorageKey     = "c3VwZXItc2VjcmV0LWtleQ=="
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	recordingsDirectory = t.TempDir()
+	t.Setenv("ARM_SUBSCRIPTION_ID", testSubscriptionId)
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Date", "Mon, 01 Jan 2024 00:00:00 GMT")
+
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/listKeys"):
+			fmt.Fprintf(w, `{"keys":[{"keyName":"key1","value":%q}]}`, testStorageKey)
+		default:
+			fmt.Fprintf(w, `{"id":%q,"name":"example","location":"westeurope"}`, r.URL.Path)
+		}
+	}))
+
+	var recordedRandom int64
+	t.Run("record", func(t *testing.T) {
+		t.Setenv(EnvironmentVariable, string(ModeRecord))
+		session := startTestSession(t, ModeRecord)
+		recordedRandom = session.Random().Int63()
+
+		assertListKeys(t, server.URL, testSubscriptionId, testStorageKey)
+		assertGetResourceGroup(t, server.URL, testSubscriptionId)
+	})
+	server.Close()
+
+	if requests != 2 {
+		t.Fatalf("expected 2 requests to be sent when recording but got %d", requests)
+	}
+
+	contents, err := os.ReadFile(cassettePath("example"))
+	if err != nil {
+		t.Fatalf("reading the recording: %+v", err)
+	}
+	for _, v := range []string{testSubscriptionId, testStorageKey, "Mon, 01 Jan 2024"} {
+		if strings.Contains(string(contents), v) {
+			t.Fatalf("expected %q to be removed from the recording but got:\n%s", v, contents)
+		}
+	}
+	if !strings.Contains(string(contents), ReplaySubscriptionId) {
+		t.Fatalf("expected the Subscription ID to be replaced with %q but got:\n%s", ReplaySubscriptionId, contents)
+	}
+
+	t.Run("replay", func(t *testing.T) {
+		t.Setenv(EnvironmentVariable, string(ModeReplay))
+		session := startTestSession(t, ModeReplay)
+		if v := session.Random().Int63(); v != recordedRandom {
+			t.Fatalf("expected the random values to match the recording (%d) but got %d", recordedRandom, v)
+		}
+
+		// the server has been closed, so these can only be served from the recording
+		assertListKeys(t, server.URL, ReplaySubscriptionId, redactedSecret)
+		assertGetResourceGroup(t, server.URL, ReplaySubscriptionId)
+	})
+
+	t.Run("replay without a session", func(t *testing.T) {
+		t.Setenv(EnvironmentVariable, string(ModeReplay))
+
+		sender := Interceptor().Sender(autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			t.Fatalf("expected the request not to be sent")
+			return nil, nil
+		}))
+		request, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		if _, err := sender.Do(request); err == nil {
+			t.Fatalf("expected an error when replaying without a session")
+		}
+	})
+}
+
+func TestBodyRoundTrip(t *testing.T) {
+	for _, input := range []Body{
+		Body(`{"name":"example"}`),
+		{0xff, 0xfe, 0x00, 0x01},
+	} {
+		encoded, err := json.Marshal(input)
+		if err != nil {
+			t.Fatalf("marshaling %v: %+v", input, err)
+		}
+
+		var decoded Body
+		if err := json.Unmarshal(encoded, &decoded); err != nil {
+			t.Fatalf("unmarshaling %s: %+v", encoded, err)
+		}
+		if string(decoded) != string(input) {
+			t.Fatalf("expected %v but got %v", input, decoded)
+		}
+	}
+}
+
+func TestInteractionKey(t *testing.T) {
+	a := interactionKey("get", "HTTPS://Management.Azure.com/subscriptions/abc?api-version=2020-01-01")
+	b := interactionKey("GET", "https://management.azure.com/subscriptions/abc?api-version=2020-01-01")
+	if a != b {
+		t.Fatalf("expected %q and %q to match", a, b)
+	}
+}
+
+// startTestSession starts a Session with a fixed name, since the name of each subtest differs
+func startTestSession(t *testing.T, mode Mode) *Session {
+	session, err := newSession(t, "example", mode)
+	if err != nil {
+		t.Fatalf("starting the session: %+v", err)
+	}
+
+	activeLock.Lock()
+	activeSession = session
+	activeLock.Unlock()
+
+	t.Cleanup(func() {
+		activeLock.Lock()
+		activeSession = nil
+		activeLock.Unlock()
+
+		if err := session.close(); err != nil {
+			t.Fatalf("closing the session: %+v", err)
+		}
+	})
+
+	return session
+}
+
+// assertListKeys sends a request using a go-azure-sdk client
+func assertListKeys(t *testing.T, endpoint string, subscriptionId string, expectedKey string) {
+	c := client.NewClient(endpoint, "recording", "2023-01-01")
+	c.DisableRetries = true
+	c.AppendRequestMiddleware(Interceptor().RequestMiddleware())
+	c.AppendResponseMiddleware(Interceptor().ResponseMiddleware())
+
+	ctx := context.TODO()
+	req, err := c.NewRequest(ctx, client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusOK},
+		HttpMethod:          http.MethodPost,
+		Path:                fmt.Sprintf("/subscriptions/%s/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example/listKeys", subscriptionId),
+	})
+	if err != nil {
+		t.Fatalf("building the request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		t.Fatalf("sending the request: %+v", err)
+	}
+
+	var model struct {
+		Keys []struct {
+			Value string `json:"value"`
+		} `json:"keys"`
+	}
+	if err := resp.Unmarshal(&model); err != nil {
+		t.Fatalf("unmarshaling the response: %+v", err)
+	}
+	if len(model.Keys) != 1 || model.Keys[0].Value != expectedKey {
+		t.Fatalf("expected the key %q but got %+v", expectedKey, model.Keys)
+	}
+	if resp.Request.URL.Host != strings.TrimPrefix(endpoint, "http://") {
+		t.Fatalf("expected the request URL to be restored to %q but got %q", endpoint, resp.Request.URL.Host)
+	}
+}
+
+// assertGetResourceGroup sends a request using a go-autorest Sender
+func assertGetResourceGroup(t *testing.T, endpoint string, subscriptionId string) {
+	sender := Interceptor().Sender(autorest.SenderFunc(http.DefaultClient.Do))
+
+	path := fmt.Sprintf("/subscriptions/%s/resourceGroups/example", subscriptionId)
+	request, err := http.NewRequest(http.MethodGet, endpoint+path+"?api-version=2020-06-01", nil)
+	if err != nil {
+		t.Fatalf("building the request: %+v", err)
+	}
+
+	resp, err := sender.Do(request)
+	if err != nil {
+		t.Fatalf("sending the request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading the response: %+v", err)
+	}
+	if !strings.Contains(string(body), path) {
+		t.Fatalf("expected the response to contain %q but got %s", path, body)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recording
+
+import (
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+// redactedSecret is the value which secrets are replaced with when recording, which is valid base64 (since the
+// Provider decodes some secrets, such as Storage Account Keys, when replaying)
+const redactedSecret = "UkVEQUNURUQ="
+
+// ignoredResponseHeaders are the response headers which aren't recorded, since these are either recalculated
+// when replaying or vary between requests
+var ignoredResponseHeaders = []string{
+	"Content-Length",
+	"Date",
+	"Set-Cookie",
+	"Strict-Transport-Security",
+	"X-Ms-Correlation-Request-Id",
+	"X-Ms-Request-Id",
+	"X-Ms-Routing-Request-Id",
+}
+
+// sanitizer replaces the identifiers for the Subscription/Tenant/Client used when recording with the identifiers
+// used when replaying, and masks any secrets contained within the traffic
+type sanitizer struct {
+	replacements []sanitizerReplacement
+}
+
+type sanitizerReplacement struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+func newSanitizerFromEnvironment() *sanitizer {
+	s := &sanitizer{}
+	for variable, replacement := range map[string]string{
+		"ARM_SUBSCRIPTION_ID":          ReplaySubscriptionId,
+		"ARM_TEST_SUBSCRIPTION_ID_ALT": ReplaySubscriptionIdAlt,
+		"ARM_TENANT_ID":                ReplayTenantId,
+		"ARM_CLIENT_ID":                ReplayClientId,
+	} {
+		value := strings.TrimSpace(os.Getenv(variable))
+		if value == "" || strings.EqualFold(value, replacement) {
+			continue
+		}
+
+		s.replacements = append(s.replacements, sanitizerReplacement{
+			pattern:     regexp.MustCompile(`(?i)` + regexp.QuoteMeta(value)),
+			replacement: replacement,
+		})
+	}
+
+	return s
+}
+
+func (s *sanitizer) sanitize(input string) string {
+	for _, r := range s.replacements {
+		input = r.pattern.ReplaceAllString(input, r.replacement)
+	}
+	return input
+}
+
+func (s *sanitizer) sanitizeInteraction(request recordedRequest, response *http.Response, responseBody []byte) Interaction {
+	// the rules used to mask secrets are based on the URI of the request
+	uri, err := http.NewRequest(request.method, request.url, nil)
+	if err != nil {
+		uri = &http.Request{Method: request.method, URL: &url.URL{}}
+	}
+
+	headers := response.Header.Clone()
+	for _, header := range ignoredResponseHeaders {
+		headers.Del(header)
+	}
+	common.RedactHeaders(uri, headers, redactedSecret)
+	for name, values := range headers {
+		for i, v := range values {
+			values[i] = s.sanitize(v)
+		}
+		headers[name] = values
+	}
+
+	return Interaction{
+		Request: RecordedRequest{
+			Method: request.method,
+			URL:    s.sanitize(request.url),
+			Body:   Body(s.sanitize(string(common.RedactBody(uri, request.body, redactedSecret)))),
+		},
+		Response: RecordedResponse{
+			StatusCode: response.StatusCode,
+			Headers:    headers,
+			Body:       Body(s.sanitize(string(common.RedactBody(uri, responseBody, redactedSecret)))),
+		},
+	}
+}
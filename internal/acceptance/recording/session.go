@@ -0,0 +1,316 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recording
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sync"
+	"testing"
+	"time"
+)
+
+// headerOriginalEndpoint is the header used to pass the original endpoint of a request to the replay server
+const headerOriginalEndpoint = "X-Recording-Original-Endpoint"
+
+// Session records/replays the traffic for a single test
+type Session struct {
+	t        *testing.T
+	mode     Mode
+	cassette *Cassette
+	random   *rand.Rand
+
+	lock sync.Mutex
+
+	// pending contains the requests which have been sent but not yet received a response, which (when recording)
+	// is the recordedRequest and (when replaying) is the original url.URL of the request
+	pending sync.Map
+
+	// sanitizer replaces the sensitive values within the traffic being recorded
+	sanitizer *sanitizer
+
+	// replayIndexes contains the index of the next Interaction to be replayed for each interactionKey
+	replayIndexes map[string]int
+
+	// replayServer serves the recorded traffic for requests sent by go-azure-sdk clients
+	replayServer *httptest.Server
+}
+
+func newSession(t *testing.T, name string, mode Mode) (*Session, error) {
+	session := &Session{
+		t:    t,
+		mode: mode,
+	}
+
+	switch mode {
+	case ModeRecord:
+		now := time.Now()
+		session.cassette = &Cassette{
+			Name:       name,
+			Seed:       now.UnixNano(),
+			RecordedAt: now,
+			Locations: []string{
+				os.Getenv("ARM_TEST_LOCATION"),
+				os.Getenv("ARM_TEST_LOCATION_ALT"),
+				os.Getenv("ARM_TEST_LOCATION_ALT2"),
+			},
+			Interactions: make([]Interaction, 0),
+		}
+		session.sanitizer = newSanitizerFromEnvironment()
+
+	case ModeReplay:
+		cassette, err := loadCassette(name)
+		if err != nil {
+			return nil, err
+		}
+		session.cassette = cassette
+		session.replayIndexes = make(map[string]int)
+		session.replayServer = httptest.NewServer(http.HandlerFunc(session.serveReplay))
+
+	default:
+		return nil, fmt.Errorf("unsupported mode %q", mode)
+	}
+
+	session.random = rand.New(rand.NewSource(session.cassette.Seed)) // nolint:gosec
+	return session, nil
+}
+
+// Mode returns whether this Session is recording or replaying traffic
+func (s *Session) Mode() Mode {
+	return s.mode
+}
+
+// Random returns the source of random values for this test, which is seeded from the Cassette so that the same
+// values are generated when replaying this test
+func (s *Session) Random() *rand.Rand {
+	return s.random
+}
+
+// RecordedAt returns the time at which this test was recorded
+func (s *Session) RecordedAt() time.Time {
+	return s.cassette.RecordedAt
+}
+
+// Locations returns the Primary, Secondary and Ternary Azure Regions used when this test was recorded
+func (s *Session) Locations() (primary string, secondary string, ternary string) {
+	locations := append(append([]string{}, s.cassette.Locations...), "", "", "")
+	return locations[0], locations[1], locations[2]
+}
+
+func (s *Session) close() error {
+	if s.replayServer != nil {
+		s.replayServer.Close()
+	}
+
+	if s.mode != ModeRecord {
+		return nil
+	}
+
+	if s.t.Failed() {
+		log.Printf("[DEBUG] Not saving the recording for %q since the test failed", s.cassette.Name)
+		return nil
+	}
+
+	return s.cassette.save()
+}
+
+// interceptRequest is called (via a Request Middleware) prior to a request being sent by a go-azure-sdk client
+func (s *Session) interceptRequest(request *http.Request) (*http.Request, error) {
+	switch s.mode {
+	case ModeRecord:
+		body, err := readAndReplaceBody(&request.Body)
+		if err != nil {
+			return nil, fmt.Errorf("reading the request body to record: %+v", err)
+		}
+		s.pending.Store(request, recordedRequest{
+			method: request.Method,
+			url:    request.URL.String(),
+			body:   body,
+		})
+
+	case ModeReplay:
+		// the request is redirected to the replay server, and restored once the response has been received
+		original := *request.URL
+		s.pending.Store(request, original)
+
+		target, err := url.Parse(s.replayServer.URL)
+		if err != nil {
+			return nil, fmt.Errorf("parsing the replay server URL: %+v", err)
+		}
+		request.Header.Set(headerOriginalEndpoint, fmt.Sprintf("%s://%s", original.Scheme, original.Host))
+		request.URL.Scheme = target.Scheme
+		request.URL.Host = target.Host
+		request.Host = target.Host
+	}
+
+	return request, nil
+}
+
+// interceptResponse is called (via a Response Middleware) once a response has been received by a go-azure-sdk client
+func (s *Session) interceptResponse(request *http.Request, response *http.Response) (*http.Response, error) {
+	v, ok := s.pending.LoadAndDelete(request)
+	if !ok {
+		return response, nil
+	}
+
+	switch s.mode {
+	case ModeRecord:
+		if err := s.record(v.(recordedRequest), response); err != nil {
+			return nil, err
+		}
+
+	case ModeReplay:
+		// the URL is updated in-place, since this is shared with the Request within the Response
+		original := v.(url.URL)
+		*request.URL = original
+		request.Host = original.Host
+		request.Header.Del(headerOriginalEndpoint)
+	}
+
+	return response, nil
+}
+
+// send is used to send a request from a go-autorest client
+func (s *Session) send(request *http.Request, base func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	if s.mode == ModeReplay {
+		recorded, err := s.nextInteraction(request.Method, request.URL.String())
+		if err != nil {
+			return nil, err
+		}
+		return recorded.httpResponse(request), nil
+	}
+
+	body, err := readAndReplaceBody(&request.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading the request body to record: %+v", err)
+	}
+
+	response, err := base(request)
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	if err := s.record(recordedRequest{method: request.Method, url: request.URL.String(), body: body}, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+type recordedRequest struct {
+	method string
+	url    string
+	body   []byte
+}
+
+func (s *Session) record(request recordedRequest, response *http.Response) error {
+	if response == nil {
+		return nil
+	}
+
+	body, err := readAndReplaceBody(&response.Body)
+	if err != nil {
+		return fmt.Errorf("reading the response body to record: %+v", err)
+	}
+
+	interaction := s.sanitizer.sanitizeInteraction(request, response, body)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.cassette.Interactions = append(s.cassette.Interactions, interaction)
+
+	return nil
+}
+
+// nextInteraction returns the next recorded response for the specified request - where once all of the responses
+// for a request have been replayed, the last response is repeated (for example when polling)
+func (s *Session) nextInteraction(method string, requestUrl string) (*RecordedResponse, error) {
+	key := interactionKey(method, requestUrl)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	matches := make([]int, 0)
+	for i, interaction := range s.cassette.Interactions {
+		if interactionKey(interaction.Request.Method, interaction.Request.URL) == key {
+			matches = append(matches, i)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no recorded response was found for %s %s in %q - this test may need to be re-recorded", method, requestUrl, s.cassette.Name)
+	}
+
+	index := s.replayIndexes[key]
+	if index >= len(matches) {
+		index = len(matches) - 1
+	}
+	s.replayIndexes[key] = index + 1
+
+	return &s.cassette.Interactions[matches[index]].Response, nil
+}
+
+// serveReplay serves the recorded response for requests redirected to the replay server
+func (s *Session) serveReplay(w http.ResponseWriter, r *http.Request) {
+	requestUrl := fmt.Sprintf("%s%s", r.Header.Get(headerOriginalEndpoint), r.URL.RequestURI())
+	recorded, err := s.nextInteraction(r.Method, requestUrl)
+	if err != nil {
+		log.Printf("[DEBUG] %+v", err)
+		http.Error(w, err.Error(), http.StatusNotImplemented)
+		return
+	}
+
+	response := recorded.httpResponse(r)
+	for k, v := range response.Header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(response.StatusCode)
+	_, _ = w.Write(recorded.Body)
+}
+
+// httpResponse returns a http.Response for the recorded response
+func (r RecordedResponse) httpResponse(request *http.Request) *http.Response {
+	headers := r.Headers.Clone()
+	if headers == nil {
+		headers = http.Header{}
+	}
+
+	// there's no need to wait when replaying, for example when polling a long-running operation
+	if headers.Get("Retry-After") != "" {
+		headers.Set("Retry-After", "0")
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        headers,
+		Body:          io.NopCloser(bytes.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       request,
+	}
+}
+
+// readAndReplaceBody reads the specified body, replacing it so that it can be read again
+func readAndReplaceBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	contents, err := io.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return nil, err
+	}
+	*body = io.NopCloser(bytes.NewReader(contents))
+
+	return contents, nil
+}
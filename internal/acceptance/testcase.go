@@ -4,16 +4,20 @@
 package acceptance
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/recording"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 )
 
@@ -170,6 +174,12 @@ func (td TestData) runAcceptanceTest(t *testing.T, testCase resource.TestCase) {
 	testCase.ExternalProviders = td.externalProviders()
 	testCase.ProviderFactories = td.providers()
 
+	// only a single test can be recorded/replayed at a time
+	if recording.SessionFor(t) != nil {
+		resource.Test(t, testCase)
+		return
+	}
+
 	resource.ParallelTest(t, testCase)
 }
 
@@ -183,17 +193,37 @@ func (td TestData) runAcceptanceSequentialTest(t *testing.T, testCase resource.T
 func (td TestData) providers() map[string]func() (*schema.Provider, error) {
 	return map[string]func() (*schema.Provider, error){
 		"azurerm": func() (*schema.Provider, error) { //nolint:unparam
-			azurerm := provider.TestAzureProvider()
+			azurerm := td.testAzureProvider()
 			return azurerm, nil
 		},
 		"azurerm-alt": func() (*schema.Provider, error) { //nolint:unparam
-			azurerm := provider.TestAzureProvider()
+			azurerm := td.testAzureProvider()
 			return azurerm, nil
 		},
 	}
 }
 
+// testAzureProvider returns the Provider used for the Acceptance Tests, which (when the traffic is being
+// recorded/replayed) sends all requests via the recording.Interceptor
+func (td TestData) testAzureProvider() *schema.Provider {
+	azurerm := provider.TestAzureProvider()
+	if !recording.Enabled() {
+		return azurerm
+	}
+
+	configure := azurerm.ConfigureContextFunc
+	azurerm.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		return configure(common.WithHTTPInterceptor(ctx, recording.Interceptor()), d)
+	}
+	return azurerm
+}
+
 func (td TestData) externalProviders() map[string]resource.ExternalProvider {
+	// the traffic for External Providers isn't recorded, so these are unavailable when replaying
+	if recording.CurrentMode() == recording.ModeReplay {
+		return nil
+	}
+
 	return map[string]resource.ExternalProvider{
 		"azuread": {
 			VersionConstraint: "=2.47.0",
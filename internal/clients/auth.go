@@ -36,6 +36,12 @@ func NewResourceManagerAccount(ctx context.Context, config auth.Credentials, sub
 		return nil, fmt.Errorf("unable to build authorizer for Microsoft Graph API: %+v", err)
 	}
 
+	return newResourceManagerAccount(ctx, config, authorizer, subscriptionId, registeredResourceProviders)
+}
+
+// newResourceManagerAccount builds a ResourceManagerAccount using the claims from a token acquired using the
+// specified Microsoft Graph authorizer
+func newResourceManagerAccount(ctx context.Context, config auth.Credentials, authorizer auth.Authorizer, subscriptionId string, registeredResourceProviders resourceproviders.ResourceProviders) (*ResourceManagerAccount, error) {
 	// Acquire an access token so we can inspect the claims
 	token, err := authorizer.Token(ctx, &http.Request{})
 	if err != nil {
@@ -10,13 +10,14 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	authWrapper "github.com/hashicorp/go-azure-sdk/sdk/auth/autorest"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
 type ClientBuilder struct {
@@ -26,12 +27,20 @@ type ClientBuilder struct {
 	CustomCorrelationRequestID  string
 	DisableCorrelationRequestID bool
 	DisableTerraformPartnerID   bool
+	HTTPInterceptor             common.HTTPInterceptor
+	MaxConcurrentRequests       int
+	MaxRetryDuration            time.Duration
+	MetadataCacheFile           string
+	MetadataCacheTTL            time.Duration
 	MetadataHost                string
 	PartnerID                   string
+	ReadOnly                    bool
 	RegisteredResourceProviders resourceproviders.ResourceProviders
 	StorageUseAzureAD           bool
 	SubscriptionID              string
+	TagDefaults                 tags.ProviderDefaults
 	TerraformVersion            string
+	TimeoutDefaults             timeouts.ProviderDefaults
 }
 
 const azureStackEnvironmentError = `
@@ -51,25 +60,37 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return nil, fmt.Errorf(azureStackEnvironmentError)
 	}
 
+	newAuthorizer := func(api environments.Api) (auth.Authorizer, error) {
+		return auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, api)
+	}
+	if builder.HTTPInterceptor != nil {
+		if authorizer := builder.HTTPInterceptor.Authorizer(); authorizer != nil {
+			log.Printf("[DEBUG] Using the Authorizer from the HTTP Interceptor rather than the configured credentials")
+			newAuthorizer = func(environments.Api) (auth.Authorizer, error) {
+				return authorizer, nil
+			}
+		}
+	}
+
 	var resourceManagerAuth, storageAuth, synapseAuth, batchManagementAuth, keyVaultAuth auth.Authorizer
 
-	resourceManagerAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ResourceManager)
+	resourceManagerAuth, err = newAuthorizer(builder.AuthConfig.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Resource Manager API: %+v", err)
 	}
 
-	storageAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Storage)
+	storageAuth, err = newAuthorizer(builder.AuthConfig.Environment.Storage)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Storage API: %+v", err)
 	}
 
-	keyVaultAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.KeyVault)
+	keyVaultAuth, err = newAuthorizer(builder.AuthConfig.Environment.KeyVault)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Key Vault API: %+v", err)
 	}
 
 	if builder.AuthConfig.Environment.Synapse.Available() {
-		synapseAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Synapse)
+		synapseAuth, err = newAuthorizer(builder.AuthConfig.Environment.Synapse)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Synapse API: %+v", err)
 		}
@@ -78,7 +99,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	}
 
 	if builder.AuthConfig.Environment.Batch.Available() {
-		batchManagementAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Batch)
+		batchManagementAuth, err = newAuthorizer(builder.AuthConfig.Environment.Batch)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Batch Management API: %+v", err)
 		}
@@ -88,7 +109,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 
 	// Helper for obtaining endpoint-specific tokens
 	authorizerFunc := common.ApiAuthorizerFunc(func(api environments.Api) (auth.Authorizer, error) {
-		authorizer, err := auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, api)
+		authorizer, err := newAuthorizer(api)
 		if err != nil {
 			return nil, fmt.Errorf("building custom authorizer for API %q: %+v", api.Name(), err)
 		}
@@ -96,14 +117,19 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return authorizer, nil
 	})
 
-	account, err := NewResourceManagerAccount(ctx, *builder.AuthConfig, builder.SubscriptionID, builder.RegisteredResourceProviders)
+	graphAuth, err := newAuthorizer(builder.AuthConfig.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build authorizer for Microsoft Graph API: %+v", err)
+	}
+
+	account, err := newResourceManagerAccount(ctx, *builder.AuthConfig, graphAuth, builder.SubscriptionID, builder.RegisteredResourceProviders)
 	if err != nil {
 		return nil, fmt.Errorf("building account: %+v", err)
 	}
 
 	var managedHSMAuth auth.Authorizer
 	if builder.AuthConfig.Environment.ManagedHSM.Available() {
-		managedHSMAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ManagedHSM)
+		managedHSMAuth, err = newAuthorizer(builder.AuthConfig.Environment.ManagedHSM)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Managed HSM API: %+v", err)
 		}
@@ -131,9 +157,11 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 			AuthorizerFunc:  authorizerFunc,
 		},
 
-		AuthConfig:  builder.AuthConfig,
-		Environment: builder.AuthConfig.Environment,
-		Features:    builder.Features,
+		AuthConfig:      builder.AuthConfig,
+		Environment:     builder.AuthConfig.Environment,
+		Features:        builder.Features,
+		TagDefaults:     builder.TagDefaults,
+		TimeoutDefaults: builder.TimeoutDefaults,
 
 		SubscriptionId:   account.SubscriptionId,
 		TenantId:         account.TenantId,
@@ -149,23 +177,42 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		CustomCorrelationRequestID:  builder.CustomCorrelationRequestID,
 		DisableCorrelationRequestID: builder.DisableCorrelationRequestID,
 		DisableTerraformPartnerID:   builder.DisableTerraformPartnerID,
+		ReadOnly:                    builder.ReadOnly,
 		SkipProviderReg:             len(builder.RegisteredResourceProviders) == 0,
 		StorageUseAzureAD:           builder.StorageUseAzureAD,
 
 		ResourceManagerEndpoint: *resourceManagerEndpoint,
+
+		HTTPInterceptor: builder.HTTPInterceptor,
+
+		RequestScheduler: common.NewRequestScheduler(common.ThrottlingOptions{
+			MaxConcurrentRequests: builder.MaxConcurrentRequests,
+			MaxRetryDuration:      builder.MaxRetryDuration,
+		}),
 	}
 
 	if err := client.Build(ctx, o); err != nil {
 		return nil, fmt.Errorf("building Client: %+v", err)
 	}
 
+	if builder.MetadataCacheFile != "" {
+		resourceproviders.ConfigureCacheFile(&resourceproviders.CacheFileOptions{
+			Path:        builder.MetadataCacheFile,
+			TTL:         builder.MetadataCacheTTL,
+			Environment: builder.AuthConfig.Environment.Name,
+			TenantId:    account.TenantId,
+		})
+	} else {
+		resourceproviders.ConfigureCacheFile(nil)
+	}
+
 	if features.EnhancedValidationEnabled() {
 		subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
 
 		ctx2, cancel := context.WithTimeout(ctx, 10*time.Minute)
 		defer cancel()
 
-		location.CacheSupportedLocations(ctx2, *resourceManagerEndpoint)
+		resourceproviders.CacheSupportedLocations(ctx2, *resourceManagerEndpoint)
 		if err := resourceproviders.CacheSupportedProviders(ctx2, client.Resource.ResourceProvidersClient, subscriptionId); err != nil {
 			log.Printf("[DEBUG] error retrieving providers: %s. Enhanced validation will be unavailable", err)
 		}
@@ -144,6 +144,8 @@ import (
 	voiceServices "github.com/hashicorp/terraform-provider-azurerm/internal/services/voiceservices/client"
 	web "github.com/hashicorp/terraform-provider-azurerm/internal/services/web/client"
 	workloads "github.com/hashicorp/terraform-provider-azurerm/internal/services/workloads/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
 type Client struct {
@@ -155,6 +157,17 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// TagDefaults are the `default_tags` and `ignore_tags` configured in the Provider block
+	TagDefaults tags.ProviderDefaults
+
+	// TimeoutDefaults are the `default_timeouts` configured in the Provider block, which are applied to the Plugin SDK
+	// Resources when the Provider is configured - and resolved by the Plugin Framework Resources when they're used
+	TimeoutDefaults timeouts.ProviderDefaults
+
+	// RootCorrelationRequestID is the correlation request ID sent for requests which aren't part of a Resource operation,
+	// which is empty when correlation request IDs are disabled
+	RootCorrelationRequestID string
+
 	AadB2c                            *aadb2c_v2021_04_01_preview.Client
 	Advisor                           *advisor.Client
 	AnalysisServices                  *analysisservices_v2017_08_01.Client
@@ -289,6 +302,11 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 
 	client.Features = o.Features
 	client.StopContext = ctx
+	client.TagDefaults = o.TagDefaults
+	client.TimeoutDefaults = o.TimeoutDefaults
+	// the `default_tags` and `ignore_tags` are applied by the Expand/Flatten functions in the `tags` package
+	tags.ConfigureProviderDefaults(o.TagDefaults)
+	client.RootCorrelationRequestID = o.RootCorrelationRequestID()
 
 	var err error
 
@@ -9,11 +9,12 @@ import (
 	"strings"
 
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/hashicorp/go-azure-helpers/sender"
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/version"
 )
 
@@ -32,10 +33,12 @@ type Authorizers struct {
 type ApiAuthorizerFunc func(api environments.Api) (auth.Authorizer, error)
 
 type ClientOptions struct {
-	Authorizers *Authorizers
-	AuthConfig  *auth.Credentials
-	Environment environments.Environment
-	Features    features.UserFeatures
+	Authorizers     *Authorizers
+	AuthConfig      *auth.Credentials
+	Environment     environments.Environment
+	Features        features.UserFeatures
+	TagDefaults     tags.ProviderDefaults
+	TimeoutDefaults timeouts.ProviderDefaults
 
 	SubscriptionId   string
 	TenantId         string
@@ -48,8 +51,18 @@ type ClientOptions struct {
 	DisableTerraformPartnerID bool
 	StorageUseAzureAD         bool
 
+	// ReadOnly rejects any request which could modify a resource, so that a plan/refresh can't make any changes
+	ReadOnly bool
+
 	ResourceManagerEndpoint string
 
+	// RequestScheduler is shared between all clients, so that requests are queued per Subscription
+	// and Resource Provider regardless of the client sending them
+	RequestScheduler *RequestScheduler
+
+	// HTTPInterceptor optionally intercepts the requests sent by each client, e.g. to record/replay these during the Acceptance Tests
+	HTTPInterceptor HTTPInterceptor
+
 	// Legacy authorizers for go-autorest
 	BatchManagementAuthorizer autorest.Authorizer
 	KeyVaultAuthorizer        autorest.Authorizer
@@ -66,16 +79,31 @@ func (o ClientOptions) Configure(c client.BaseClient, authorizer auth.Authorizer
 	c.SetAuthorizer(authorizer)
 	c.SetUserAgent(userAgent(c.GetUserAgent(), o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID))
 
-	if !o.DisableCorrelationRequestID {
-		id := o.CustomCorrelationRequestID
-		if id == "" {
-			id = correlationRequestID()
-		}
+	if id := o.RootCorrelationRequestID(); id != "" {
 		c.AppendRequestMiddleware(correlationRequestIDMiddleware(id))
 	}
 
+	if o.ReadOnly {
+		c.AppendRequestMiddleware(readOnlyMiddleware())
+	}
+
+	// the RequestScheduler must release each request it schedules, so its Response Middleware is first (and its
+	// Request Middleware last) since a failing middleware prevents any subsequent middlewares from being called
+	if o.RequestScheduler != nil {
+		c.AppendResponseMiddleware(o.RequestScheduler.ResponseMiddleware())
+	}
+
 	c.AppendRequestMiddleware(requestLoggerMiddleware("AzureRM"))
 	c.AppendResponseMiddleware(responseLoggerMiddleware("AzureRM"))
+
+	if o.HTTPInterceptor != nil {
+		c.AppendRequestMiddleware(o.HTTPInterceptor.RequestMiddleware())
+		c.AppendResponseMiddleware(o.HTTPInterceptor.ResponseMiddleware())
+	}
+
+	if o.RequestScheduler != nil {
+		c.AppendRequestMiddleware(o.RequestScheduler.RequestMiddleware())
+	}
 }
 
 // ConfigureClient sets up an autorest.Client using an autorest.Authorizer
@@ -83,17 +111,37 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	c.UserAgent = userAgent(c.UserAgent, o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID)
 
 	c.Authorizer = authorizer
-	c.Sender = sender.BuildSender("AzureRM")
+	c.Sender = buildSender("AzureRM")
+	if o.RequestScheduler != nil {
+		c.Sender = o.RequestScheduler.Sender(c.Sender)
+	}
+	if o.HTTPInterceptor != nil {
+		c.Sender = o.HTTPInterceptor.Sender(c.Sender)
+	}
+	if o.ReadOnly {
+		c.Sender = readOnlySender(c.Sender)
+	}
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
-	if !o.DisableCorrelationRequestID {
-		id := o.CustomCorrelationRequestID
-		if id == "" {
-			id = correlationRequestID()
-		}
+	if id := o.RootCorrelationRequestID(); id != "" {
 		c.RequestInspector = withCorrelationRequestID(id)
 	}
 }
 
+// RootCorrelationRequestID returns the correlation request ID sent for requests which aren't part of a Resource
+// operation, which is either the custom correlation request ID or one generated for this process. An empty
+// value is returned when correlation request IDs are disabled.
+func (o ClientOptions) RootCorrelationRequestID() string {
+	if o.DisableCorrelationRequestID {
+		return ""
+	}
+
+	if o.CustomCorrelationRequestID != "" {
+		return o.CustomCorrelationRequestID
+	}
+
+	return correlationRequestID()
+}
+
 func userAgent(userAgent, tfVersion, partnerID string, disableTerraformPartnerID bool) string {
 	tfUserAgent := fmt.Sprintf("HashiCorp Terraform/%s (+https://www.terraform.io)", tfVersion)
 
@@ -4,7 +4,9 @@
 package common
 
 import (
+	"context"
 	"log"
+	"net/http"
 	"sync"
 
 	"github.com/Azure/go-autorest/autorest"
@@ -21,10 +23,72 @@ var (
 	msCorrelationRequestID     string
 )
 
+// operationCorrelationRequestIDKey is the key for the correlation request ID of the current Resource operation within a context
+type operationCorrelationRequestIDKey struct{}
+
 // withCorrelationRequestID returns a PrepareDecorator that adds an HTTP extension header of
-// `x-ms-correlation-request-id` whose value is passed, undecorated UUID (e.g.,7F5A6223-F475-4A9C-B9D5-12575AA6B11B`).
+// `x-ms-correlation-request-id` whose value is passed, undecorated UUID (e.g.,7F5A6223-F475-4A9C-B9D5-12575AA6B11B`),
+// unless the request is part of a Resource operation which has its own correlation request ID.
 func withCorrelationRequestID(uuid string) autorest.PrepareDecorator {
-	return autorest.WithHeader(HeaderCorrelationRequestID, uuid)
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			return autorest.WithHeader(HeaderCorrelationRequestID, correlationRequestIDForContext(r.Context(), uuid))(p).Prepare(r)
+		})
+	}
+}
+
+// correlationRequestIDForContext returns the correlation request ID for the Resource operation within ctx, or
+// the root correlation request ID when the request isn't part of a Resource operation
+func correlationRequestIDForContext(ctx context.Context, root string) string {
+	if ctx != nil {
+		if id, ok := ctx.Value(operationCorrelationRequestIDKey{}).(string); ok && id != "" {
+			return id
+		}
+	}
+
+	return root
+}
+
+// WithOperationCorrelationRequestID returns a copy of ctx containing a new (child) correlation request ID, which is sent
+// in place of the root correlation request ID for each request made using this context, and the new ID. An empty root
+// means that correlation request IDs are disabled, in which case ctx is returned unchanged.
+func WithOperationCorrelationRequestID(ctx context.Context, root string) (context.Context, string) {
+	if root == "" {
+		return ctx, ""
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		log.Printf("[WARN] Failed to generate uuid for the Operation Correlation Request Id: %+v", err)
+		return ctx, ""
+	}
+
+	return ContextWithOperationCorrelationRequestID(ctx, id), id
+}
+
+// ContextWithOperationCorrelationRequestID returns a copy of ctx containing the existing (child) correlation request ID,
+// for when a single Resource operation makes requests using more than one context
+func ContextWithOperationCorrelationRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, operationCorrelationRequestIDKey{}, id)
+}
+
+// OperationCorrelationRequestID returns the (child) correlation request ID for the Resource operation within ctx, or
+// an empty string when there's none
+func OperationCorrelationRequestID(ctx context.Context) string {
+	return correlationRequestIDForContext(ctx, "")
+}
+
+// LogOperationCorrelationRequestID logs the Resource operation which a (child) correlation request ID was used for,
+// so that the requests for a specific operation can be found from the root correlation request ID
+func LogOperationCorrelationRequestID(root, id, operation, resourceType, resourceId string) {
+	if id == "" {
+		return
+	}
+
+	if resourceId == "" {
+		resourceId = "(unknown)"
+	}
+	log.Printf("[DEBUG] Correlation Request Id %q (root %q) was used to %s the %s %q", id, root, operation, resourceType, resourceId)
 }
 
 // correlationRequestID generates an UUID to pass through `x-ms-correlation-request-id` header.
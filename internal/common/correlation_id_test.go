@@ -4,6 +4,7 @@
 package common
 
 import (
+	"context"
 	"net/http"
 	"testing"
 
@@ -32,3 +33,56 @@ func TestWithCorrelationRequestID(t *testing.T) {
 			HeaderCorrelationRequestID, uuid, req.Header.Get(HeaderCorrelationRequestID))
 	}
 }
+
+func TestWithOperationCorrelationRequestID(t *testing.T) {
+	root := "11111111-1111-1111-1111-111111111111"
+
+	first, firstId := WithOperationCorrelationRequestID(context.TODO(), root)
+	second, secondId := WithOperationCorrelationRequestID(context.TODO(), root)
+	if firstId == "" || secondId == "" {
+		t.Fatal("no operation correlation request ID generated")
+	}
+	if firstId == root || firstId == secondId {
+		t.Fatalf("expected a unique operation correlation request ID but got %q and %q (root %q)", firstId, secondId, root)
+	}
+
+	// go-autorest
+	req, _ := autorest.Prepare((&http.Request{}).WithContext(first), withCorrelationRequestID(root))
+	if actual := req.Header.Get(HeaderCorrelationRequestID); actual != firstId {
+		t.Fatalf("expected withCorrelationRequestID to set %q but got %q", firstId, actual)
+	}
+	req, _ = autorest.Prepare((&http.Request{}).WithContext(context.TODO()), withCorrelationRequestID(root))
+	if actual := req.Header.Get(HeaderCorrelationRequestID); actual != root {
+		t.Fatalf("expected withCorrelationRequestID to set the root %q outside of an operation but got %q", root, actual)
+	}
+
+	// go-azure-sdk
+	req, _ = http.NewRequestWithContext(second, http.MethodGet, "https://management.azure.com", nil)
+	req, _ = correlationRequestIDMiddleware(root)(req)
+	if actual := req.Header.Get(HeaderCorrelationRequestID); actual != secondId {
+		t.Fatalf("expected correlationRequestIDMiddleware to set %q but got %q", secondId, actual)
+	}
+	req, _ = http.NewRequestWithContext(context.TODO(), http.MethodGet, "https://management.azure.com", nil)
+	req, _ = correlationRequestIDMiddleware(root)(req)
+	if actual := req.Header.Get(HeaderCorrelationRequestID); actual != root {
+		t.Fatalf("expected correlationRequestIDMiddleware to set the root %q outside of an operation but got %q", root, actual)
+	}
+
+	// when correlation request IDs are disabled the context is returned as-is
+	ctx := context.TODO()
+	if actual, id := WithOperationCorrelationRequestID(ctx, ""); actual != ctx || id != "" {
+		t.Fatalf("expected no operation correlation request ID when disabled but got %q", id)
+	}
+}
+
+func TestRootCorrelationRequestID(t *testing.T) {
+	if actual := (ClientOptions{CustomCorrelationRequestID: "custom"}).RootCorrelationRequestID(); actual != "custom" {
+		t.Fatalf("expected the custom correlation request ID to be the root but got %q", actual)
+	}
+	if actual := (ClientOptions{}).RootCorrelationRequestID(); actual != correlationRequestID() {
+		t.Fatalf("expected the generated correlation request ID to be the root but got %q", actual)
+	}
+	if actual := (ClientOptions{CustomCorrelationRequestID: "custom", DisableCorrelationRequestID: true}).RootCorrelationRequestID(); actual != "" {
+		t.Fatalf("expected no root correlation request ID when disabled but got %q", actual)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+// HTTPInterceptor intercepts the requests sent by (and the responses received by) each client, which allows the
+// traffic sent during the Acceptance Tests to be recorded and subsequently replayed without a Subscription.
+type HTTPInterceptor interface {
+	// RequestMiddleware returns a client.RequestMiddleware which is appended to each go-azure-sdk client
+	RequestMiddleware() client.RequestMiddleware
+
+	// ResponseMiddleware returns a client.ResponseMiddleware which is appended to each go-azure-sdk client
+	ResponseMiddleware() client.ResponseMiddleware
+
+	// Sender returns an autorest.Sender wrapping the specified autorest.Sender, which is used by each go-autorest client
+	Sender(base autorest.Sender) autorest.Sender
+
+	// Authorizer optionally returns an auth.Authorizer which should be used in place of authenticating using the
+	// configured credentials (for example when replaying traffic), or nil when the credentials should be used.
+	Authorizer() auth.Authorizer
+}
+
+type httpInterceptorContextKey struct{}
+
+// WithHTTPInterceptor returns a copy of the specified context containing the HTTPInterceptor which should be
+// used by the clients built during the Provider's configuration
+func WithHTTPInterceptor(ctx context.Context, interceptor HTTPInterceptor) context.Context {
+	return context.WithValue(ctx, httpInterceptorContextKey{}, interceptor)
+}
+
+// HTTPInterceptorFromContext returns the HTTPInterceptor contained within the specified context, if any
+func HTTPInterceptorFromContext(ctx context.Context) HTTPInterceptor {
+	if v, ok := ctx.Value(httpInterceptorContextKey{}).(HTTPInterceptor); ok {
+		return v
+	}
+	return nil
+}
@@ -4,6 +4,8 @@
 package common
 
 import (
+	"bytes"
+	"io"
 	"log"
 	"net/http"
 	"net/http/httputil"
@@ -11,34 +13,41 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 )
 
-func correlationRequestIDMiddleware(id string) client.RequestMiddleware {
+func correlationRequestIDMiddleware(root string) client.RequestMiddleware {
 	return func(request *http.Request) (*http.Request, error) {
-		// ensure the `X-Correlation-ID` field is set
-		request.Header.Add(HeaderCorrelationRequestID, id)
+		// ensure the `X-Correlation-ID` field is set, using the ID for the current Resource operation where present
+		request.Header.Add(HeaderCorrelationRequestID, correlationRequestIDForContext(request.Context(), root))
 		return request, nil
 	}
 }
 
 func requestLoggerMiddleware(providerName string) client.RequestMiddleware {
 	return func(request *http.Request) (*http.Request, error) {
-		// strip the authorization header prior to printing
-		authHeaderName := "Authorization"
-		auth := request.Header.Get(authHeaderName)
-		if auth != "" {
-			request.Header.Del(authHeaderName)
+		// mask any sensitive values prior to printing, using a copy so that the request itself is unchanged
+		redactor := newRedactor("request", request)
+		clone := request.Clone(request.Context())
+		redactor.redactHeaders(clone.Header)
+		redactor.redactURL(clone.URL)
+
+		body, err := readRequestBody(request)
+		if err != nil {
+			log.Printf("[DEBUG] %s Request: %s to %s (reading the body: %+v)\n", providerName, request.Method, clone.URL, err)
+			return request, nil
+		}
+		if body != nil {
+			clone.Body = io.NopCloser(bytes.NewReader(body))
 		}
 
 		// dump request to wire format
-		if dump, err := httputil.DumpRequestOut(request, true); err == nil {
-			log.Printf("[DEBUG] %s Request: \n%s\n", providerName, dump)
+		if dump, err := httputil.DumpRequestOut(clone, false); err == nil {
+			log.Printf("[DEBUG] %s Request: \n%s%s\n", providerName, dump, redactor.redactBody(body))
 		} else {
 			// fallback to basic message
-			log.Printf("[DEBUG] %s Request: %s to %s\n", providerName, request.Method, request.URL)
+			log.Printf("[DEBUG] %s Request: %s to %s\n", providerName, request.Method, clone.URL)
 		}
 
-		// add the auth header back
-		if auth != "" {
-			request.Header.Add(authHeaderName, auth)
+		if summary := redactor.finalise(clone.URL); !summary.empty() {
+			log.Printf("[DEBUG] %s Redacted: %s\n", providerName, summary)
 		}
 
 		return request, nil
@@ -47,13 +56,70 @@ func requestLoggerMiddleware(providerName string) client.RequestMiddleware {
 
 func responseLoggerMiddleware(providerName string) client.ResponseMiddleware {
 	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		if response == nil {
+			return response, nil
+		}
+
+		redactor := newRedactor("response", request)
+		requestURL := *request.URL
+		redactor.redactURL(&requestURL)
+
+		body, err := readResponseBody(response)
+		if err != nil {
+			log.Printf("[DEBUG] %s Response: %s for %s (reading the body: %+v)\n", providerName, response.Status, requestURL.String(), err)
+			return response, nil
+		}
+
+		// mask any sensitive values prior to printing, using a copy so that the response itself is unchanged
+		clone := *response
+		clone.Header = response.Header.Clone()
+		clone.Body = nil
+		redactor.redactHeaders(clone.Header)
+
 		// dump response to wire format
-		if dump, err2 := httputil.DumpResponse(response, true); err2 == nil {
-			log.Printf("[DEBUG] %s Response for %s: \n%s\n", providerName, request.URL, dump)
+		if dump, err2 := httputil.DumpResponse(&clone, false); err2 == nil {
+			log.Printf("[DEBUG] %s Response for %s: \n%s%s\n", providerName, requestURL.String(), dump, redactor.redactBody(body))
 		} else {
 			// fallback to basic message
-			log.Printf("[DEBUG] %s Response: %s for %s\n", providerName, response.Status, request.URL)
+			log.Printf("[DEBUG] %s Response: %s for %s\n", providerName, response.Status, requestURL.String())
+		}
+
+		if summary := redactor.finalise(&requestURL); !summary.empty() {
+			log.Printf("[DEBUG] %s Redacted: %s\n", providerName, summary)
 		}
+
 		return response, nil
 	}
 }
+
+// readRequestBody returns the body of the request, which is replaced so that it can be read again when sent
+func readRequestBody(request *http.Request) ([]byte, error) {
+	if request.Body == nil || request.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(request.Body)
+	request.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	request.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+// readResponseBody returns the body of the response, which is replaced so that it can be read again
+func readResponseBody(response *http.Response) ([]byte, error) {
+	if response.Body == nil || response.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+// readOnlyAllowedActions are the (lower-cased) paths for POST requests which only retrieve information,
+// and as such can be sent when the Provider is configured to be read-only
+var readOnlyAllowedActions = []*regexp.Regexp{
+	// e.g. `listKeys`, `listConnectionStrings`, `listSecrets` and `config/appsettings/list`
+	regexp.MustCompile(`/list[a-z]*$`),
+
+	// e.g. `checkNameAvailability`
+	regexp.MustCompile(`/check[a-z]*availability$`),
+}
+
+// readOnlyAllowedActionsLock guards readOnlyAllowedActions, which can be registered whilst requests are being sent
+var readOnlyAllowedActionsLock = &sync.RWMutex{}
+
+// RegisterReadOnlyAllowedActions allows the POST requests matching the specified (lower-cased) paths to be sent when
+// the Provider is configured to be read-only - which should only be used for actions which don't modify anything
+func RegisterReadOnlyAllowedActions(patterns ...*regexp.Regexp) {
+	readOnlyAllowedActionsLock.Lock()
+	defer readOnlyAllowedActionsLock.Unlock()
+
+	readOnlyAllowedActions = append(readOnlyAllowedActions, patterns...)
+}
+
+// isReadOnlyAllowedAction returns whether the specified (lower-cased) path is for a POST request which only
+// retrieves information
+func isReadOnlyAllowedAction(path string) bool {
+	readOnlyAllowedActionsLock.RLock()
+	defer readOnlyAllowedActionsLock.RUnlock()
+
+	for _, pattern := range readOnlyAllowedActions {
+		if pattern.MatchString(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ReadOnlyError is returned when a request which could modify a resource is sent whilst the Provider is
+// configured to be read-only
+type ReadOnlyError struct {
+	Method     string
+	Operation  string
+	ResourceId string
+}
+
+func (e ReadOnlyError) Error() string {
+	return fmt.Sprintf("the Provider is configured to be read-only (`read_only = true`) so the %q operation (%s) was not performed on %q - this indicates a Read function is attempting to modify this resource, or that this resource is being created/updated/deleted", e.Operation, e.Method, e.ResourceId)
+}
+
+// checkReadOnly returns a ReadOnlyError if the specified request could modify a resource
+func checkReadOnly(request *http.Request) error {
+	switch request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return nil
+	}
+
+	path := ""
+	if request.URL != nil {
+		path = strings.TrimSuffix(request.URL.Path, "/")
+	}
+
+	if request.Method == http.MethodPost && isReadOnlyAllowedAction(strings.ToLower(path)) {
+		return nil
+	}
+
+	operation := map[string]string{
+		http.MethodDelete: "Delete",
+		http.MethodPatch:  "Update",
+		http.MethodPut:    "CreateOrUpdate",
+	}[request.Method]
+	resourceId := path
+	if request.Method == http.MethodPost {
+		// the operation for a POST request is the action, which is the last segment of the path
+		if i := strings.LastIndex(path, "/"); i >= 0 {
+			operation = path[i+1:]
+			resourceId = path[:i]
+		}
+	}
+	if operation == "" {
+		operation = request.Method
+	}
+
+	return ReadOnlyError{
+		Method:     request.Method,
+		Operation:  operation,
+		ResourceId: resourceId,
+	}
+}
+
+// readOnlyMiddleware returns a client.RequestMiddleware which rejects any request which could modify a resource
+func readOnlyMiddleware() client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		if err := checkReadOnly(request); err != nil {
+			return nil, err
+		}
+		return request, nil
+	}
+}
+
+// readOnlySender returns an autorest.Sender which rejects any request which could modify a resource
+func readOnlySender(base autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+		if err := checkReadOnly(request); err != nil {
+			return nil, err
+		}
+		return base.Do(request)
+	})
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestCheckReadOnly(t *testing.T) {
+	testData := []struct {
+		method             string
+		url                string
+		expectedOperation  string
+		expectedResourceId string
+		allowed            bool
+	}{
+		{
+			method:  http.MethodGet,
+			url:     "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example?api-version=2020-06-01",
+			allowed: true,
+		},
+		{
+			method:  http.MethodHead,
+			url:     "https://example.blob.core.windows.net/container/blob",
+			allowed: true,
+		},
+		{
+			method:  http.MethodPost,
+			url:     "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example/listKeys?api-version=2023-01-01",
+			allowed: true,
+		},
+		{
+			method:  http.MethodPost,
+			url:     "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.DocumentDB/databaseAccounts/example/listConnectionStrings?api-version=2023-01-01",
+			allowed: true,
+		},
+		{
+			method:  http.MethodPost,
+			url:     "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Web/sites/example/config/appsettings/list?api-version=2023-01-01",
+			allowed: true,
+		},
+		{
+			method:  http.MethodPost,
+			url:     "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Storage/checkNameAvailability?api-version=2023-01-01",
+			allowed: true,
+		},
+		{
+			method:             http.MethodPost,
+			url:                "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Storage/register?api-version=2022-09-01",
+			expectedOperation:  "register",
+			expectedResourceId: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Storage",
+		},
+		{
+			method:             http.MethodPut,
+			url:                "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example?api-version=2020-06-01",
+			expectedOperation:  "CreateOrUpdate",
+			expectedResourceId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+		},
+		{
+			method:             http.MethodPatch,
+			url:                "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example?api-version=2020-06-01",
+			expectedOperation:  "Update",
+			expectedResourceId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+		},
+		{
+			method:             http.MethodDelete,
+			url:                "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/?api-version=2020-06-01",
+			expectedOperation:  "Delete",
+			expectedResourceId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+		},
+		{
+			method:             http.MethodPost,
+			url:                "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example/regenerateKey?api-version=2023-01-01",
+			expectedOperation:  "regenerateKey",
+			expectedResourceId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example",
+		},
+		{
+			method:             http.MethodPost,
+			url:                "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Compute/virtualMachines/example/start?api-version=2023-01-01",
+			expectedOperation:  "start",
+			expectedResourceId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Compute/virtualMachines/example",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %s %s", v.method, v.url)
+
+		request := httptest.NewRequest(v.method, v.url, nil)
+		err := checkReadOnly(request)
+		if v.allowed {
+			if err != nil {
+				t.Fatalf("expected the request to be allowed but got: %+v", err)
+			}
+			continue
+		}
+
+		var readOnlyErr ReadOnlyError
+		if !errors.As(err, &readOnlyErr) {
+			t.Fatalf("expected a ReadOnlyError but got: %+v", err)
+		}
+		if readOnlyErr.Operation != v.expectedOperation {
+			t.Fatalf("expected the operation to be %q but got %q", v.expectedOperation, readOnlyErr.Operation)
+		}
+		if readOnlyErr.ResourceId != v.expectedResourceId {
+			t.Fatalf("expected the resource id to be %q but got %q", v.expectedResourceId, readOnlyErr.ResourceId)
+		}
+	}
+}
+
+func TestReadOnlySender(t *testing.T) {
+	sent := 0
+	sender := readOnlySender(autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+		sent++
+		return &http.Response{StatusCode: http.StatusOK, Request: request}, nil
+	}))
+
+	get := httptest.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example", nil)
+	if _, err := sender.Do(get); err != nil {
+		t.Fatalf("expected the GET request to be sent but got: %+v", err)
+	}
+
+	put := httptest.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example", nil)
+	if _, err := sender.Do(put); err == nil {
+		t.Fatalf("expected the PUT request to be rejected")
+	}
+
+	if sent != 1 {
+		t.Fatalf("expected 1 request to be sent but got %d", sent)
+	}
+}
+
+func TestRegisterReadOnlyAllowedActions(t *testing.T) {
+	existing := readOnlyAllowedActions
+	t.Cleanup(func() {
+		readOnlyAllowedActions = existing
+	})
+
+	action := "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Web/sites/example/config/publishingcredentials/read"
+
+	// the actions can be registered whilst requests are being checked
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			RegisterReadOnlyAllowedActions(regexp.MustCompile(`/publishingcredentials/read$`))
+		}()
+		go func() {
+			defer wg.Done()
+			_ = checkReadOnly(httptest.NewRequest(http.MethodPost, action, nil))
+		}()
+	}
+	wg.Wait()
+
+	if err := checkReadOnly(httptest.NewRequest(http.MethodPost, action, nil)); err != nil {
+		t.Fatalf("expected the registered action to be allowed but got: %+v", err)
+	}
+}
@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// redactedValue is the value which sensitive values are replaced with prior to being logged
+const redactedValue = "REDACTED"
+
+// RedactionRule defines the sensitive values which should be masked when logging requests/responses
+type RedactionRule struct {
+	// Service is the name of the Service this rule applies to, which is used to summarise what was masked
+	Service string
+
+	// PathPattern optionally limits this rule to requests where the (lower-cased) URI path matches this pattern,
+	// when omitted this rule applies to every request
+	PathPattern *regexp.Regexp
+
+	// Keys is a list of JSON keys (matched case-insensitively, at any depth) whose values should be masked
+	Keys []string
+
+	// Headers is a list of HTTP Headers (matched case-insensitively) whose values should be masked
+	Headers []string
+
+	// QueryParameters is a list of Query String Parameters (matched case-insensitively) whose values should be masked
+	QueryParameters []string
+}
+
+var (
+	redactionRulesLock sync.RWMutex
+	redactionRules     = []RedactionRule{
+		{
+			Service: "Common",
+			Keys: []string{
+				"accessKey",
+				"adminPassword",
+				"administratorLoginPassword",
+				"clientSecret",
+				"connectionString",
+				"password",
+				"primaryConnectionString",
+				"primaryKey",
+				"primaryMasterKey",
+				"sasToken",
+				"secondaryConnectionString",
+				"secondaryKey",
+				"secondaryMasterKey",
+				"sharedKey",
+			},
+			Headers: []string{
+				"Authorization",
+				"Cookie",
+				"Ocp-Apim-Subscription-Key",
+				"Proxy-Authorization",
+				"Set-Cookie",
+				"x-ms-authorization-auxiliary",
+				"x-ms-encryption-key",
+			},
+			QueryParameters: []string{
+				"code",
+				"sig",
+			},
+		},
+		{
+			// e.g. Storage Accounts and Cognitive Services, which return the keys as `value` and `key1`/`key2`
+			Service:     "Common",
+			PathPattern: regexp.MustCompile(`/(listkeys|regeneratekey|listaccountsas|listservicesas)$`),
+			Keys: []string{
+				"accountSasToken",
+				"key1",
+				"key2",
+				"serviceSasToken",
+				"value",
+			},
+		},
+		{
+			Service:     "KeyVault",
+			PathPattern: regexp.MustCompile(`/secrets/`),
+			Keys: []string{
+				"value",
+			},
+		},
+	}
+
+	// connectionStringSecretPattern matches the secret within a connection string, for example `AccountKey=abc123;`
+	connectionStringSecretPattern = regexp.MustCompile(`(?i)((?:AccountKey|SharedAccessKey|SharedAccessSignature|Password|Pwd)=)[^;"'\s&]+`)
+)
+
+// RedactBody masks the sensitive values within the body of a request/response sent to the URI of the specified
+// request, replacing these with the specified value and returning the masked body
+func RedactBody(request *http.Request, body []byte, replacement string) []byte {
+	r := newRedactor("", request)
+	r.replacement = replacement
+	return r.redactBody(body)
+}
+
+// RedactHeaders masks the sensitive values within the specified headers (which are modified in place),
+// replacing these with the specified value
+func RedactHeaders(request *http.Request, headers http.Header, replacement string) {
+	r := newRedactor("", request)
+	r.replacement = replacement
+	r.redactHeaders(headers)
+}
+
+// RegisterRedactionRules registers additional rules for masking sensitive values when logging requests/responses,
+// which allows each Service to define the values which are sensitive for the API(s) it uses.
+func RegisterRedactionRules(rules ...RedactionRule) {
+	redactionRulesLock.Lock()
+	defer redactionRulesLock.Unlock()
+
+	redactionRules = append(redactionRules, rules...)
+}
+
+// redactionSummary is a structured summary of the values masked from a request/response
+type redactionSummary struct {
+	Direction       string   `json:"direction"`
+	Method          string   `json:"method"`
+	URL             string   `json:"url"`
+	Services        []string `json:"services,omitempty"`
+	Headers         []string `json:"headers,omitempty"`
+	QueryParameters []string `json:"query_parameters,omitempty"`
+	Fields          []string `json:"fields,omitempty"`
+}
+
+func (s redactionSummary) empty() bool {
+	return len(s.Headers) == 0 && len(s.QueryParameters) == 0 && len(s.Fields) == 0
+}
+
+func (s redactionSummary) String() string {
+	// this only contains strings, so can't fail to be marshalled
+	out, _ := json.Marshal(s)
+	return string(out)
+}
+
+// redactor masks the sensitive values for a single request/response, using the rules applicable to its URI
+type redactor struct {
+	// replacement is the value which sensitive values are replaced with
+	replacement string
+
+	keys            map[string]string
+	headers         map[string]string
+	queryParameters map[string]string
+
+	summary  redactionSummary
+	services map[string]struct{}
+}
+
+func newRedactor(direction string, request *http.Request) *redactor {
+	r := &redactor{
+		replacement:     redactedValue,
+		keys:            make(map[string]string),
+		headers:         make(map[string]string),
+		queryParameters: make(map[string]string),
+		services:        make(map[string]struct{}),
+		summary: redactionSummary{
+			Direction: direction,
+			Method:    request.Method,
+		},
+	}
+
+	path := ""
+	if request.URL != nil {
+		path = strings.ToLower(request.URL.Path)
+	}
+
+	redactionRulesLock.RLock()
+	defer redactionRulesLock.RUnlock()
+
+	for _, rule := range redactionRules {
+		if rule.PathPattern != nil && !rule.PathPattern.MatchString(path) {
+			continue
+		}
+
+		for _, k := range rule.Keys {
+			r.keys[strings.ToLower(k)] = rule.Service
+		}
+		for _, h := range rule.Headers {
+			r.headers[strings.ToLower(h)] = rule.Service
+		}
+		for _, q := range rule.QueryParameters {
+			r.queryParameters[strings.ToLower(q)] = rule.Service
+		}
+	}
+
+	return r
+}
+
+func (r *redactor) masked(service string) {
+	if service != "" {
+		r.services[service] = struct{}{}
+	}
+}
+
+// redactHeaders masks the sensitive values within the specified headers, which are modified in place
+func (r *redactor) redactHeaders(headers http.Header) {
+	for name := range headers {
+		service, ok := r.headers[strings.ToLower(name)]
+		if !ok {
+			continue
+		}
+
+		headers.Set(name, r.replacement)
+		r.summary.Headers = append(r.summary.Headers, http.CanonicalHeaderKey(name))
+		r.masked(service)
+	}
+}
+
+// redactURL masks the sensitive Query String Parameters within the specified URL, which is modified in place
+func (r *redactor) redactURL(input *url.URL) {
+	if input == nil || input.RawQuery == "" {
+		return
+	}
+
+	values := input.Query()
+	updated := false
+	for name := range values {
+		service, ok := r.queryParameters[strings.ToLower(name)]
+		if !ok {
+			continue
+		}
+
+		values.Set(name, r.replacement)
+		r.summary.QueryParameters = append(r.summary.QueryParameters, name)
+		r.masked(service)
+		updated = true
+	}
+
+	if updated {
+		input.RawQuery = values.Encode()
+	}
+}
+
+// redactBody masks the sensitive values within the specified body, returning the masked body
+func (r *redactor) redactBody(body []byte) []byte {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return body
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		// not JSON, so mask any connection strings within the body
+		return []byte(r.redactString("", string(body)))
+	}
+
+	fields := len(r.summary.Fields)
+	value = r.redactValue("", value)
+	if len(r.summary.Fields) == fields {
+		// nothing was masked, so output the body as-is
+		return body
+	}
+
+	out, err := json.Marshal(value)
+	if err != nil {
+		return []byte(fmt.Sprintf("<the body contained sensitive values but could not be redacted: %+v>", err))
+	}
+	return out
+}
+
+func (r *redactor) redactValue(path string, input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			itemPath := key
+			if path != "" {
+				itemPath = fmt.Sprintf("%s.%s", path, key)
+			}
+
+			if service, ok := r.keys[strings.ToLower(key)]; ok && item != nil {
+				v[key] = r.replacement
+				r.summary.Fields = append(r.summary.Fields, itemPath)
+				r.masked(service)
+				continue
+			}
+
+			v[key] = r.redactValue(itemPath, item)
+		}
+		return v
+
+	case []interface{}:
+		for i, item := range v {
+			v[i] = r.redactValue(fmt.Sprintf("%s[%d]", path, i), item)
+		}
+		return v
+
+	case string:
+		return r.redactString(path, v)
+	}
+
+	return input
+}
+
+// redactString masks the secrets within any connection strings contained within the specified value
+func (r *redactor) redactString(path string, input string) string {
+	if !connectionStringSecretPattern.MatchString(input) {
+		return input
+	}
+
+	if path == "" {
+		path = "<body>"
+	}
+	r.summary.Fields = append(r.summary.Fields, path)
+	r.masked("Common")
+
+	return connectionStringSecretPattern.ReplaceAllString(input, "${1}"+r.replacement)
+}
+
+// finalise returns the summary of the values which were masked
+func (r *redactor) finalise(input *url.URL) redactionSummary {
+	if input != nil {
+		r.summary.URL = input.String()
+	}
+
+	for service := range r.services {
+		r.summary.Services = append(r.summary.Services, service)
+	}
+	sort.Strings(r.summary.Services)
+	sort.Strings(r.summary.Headers)
+	sort.Strings(r.summary.QueryParameters)
+	sort.Strings(r.summary.Fields)
+
+	return r.summary
+}
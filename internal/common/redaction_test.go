@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestRedactorBody(t *testing.T) {
+	testData := []struct {
+		name           string
+		path           string
+		input          string
+		expected       string
+		expectedFields []string
+	}{
+		{
+			name:     "nothing sensitive",
+			path:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			input:    `{"location":"westeurope","properties":{"provisioningState":"Succeeded"}}`,
+			expected: `{"location":"westeurope","properties":{"provisioningState":"Succeeded"}}`,
+		},
+		{
+			name:           "nested keys",
+			path:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Compute/virtualMachines/example",
+			input:          `{"properties":{"osProfile":{"adminUsername":"adminuser","adminPassword":"P@ssw0rd1234!"}}}`,
+			expected:       `{"properties":{"osProfile":{"adminPassword":"REDACTED","adminUsername":"adminuser"}}}`,
+			expectedFields: []string{"properties.osProfile.adminPassword"},
+		},
+		{
+			name:           "storage account list keys",
+			path:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example/listKeys",
+			input:          `{"keys":[{"keyName":"key1","value":"abc123==","permissions":"FULL"}]}`,
+			expected:       `{"keys":[{"keyName":"key1","permissions":"FULL","value":"REDACTED"}]}`,
+			expectedFields: []string{"keys[0].value"},
+		},
+		{
+			name:     "value outside of list keys",
+			path:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts",
+			input:    `{"value":[]}`,
+			expected: `{"value":[]}`,
+		},
+		{
+			name:           "key vault secret",
+			path:           "/secrets/example/00000000000000000000000000000000",
+			input:          `{"value":"s3cr3t","id":"https://example.vault.azure.net/secrets/example/00000000000000000000000000000000"}`,
+			expected:       `{"id":"https://example.vault.azure.net/secrets/example/00000000000000000000000000000000","value":"REDACTED"}`,
+			expectedFields: []string{"value"},
+		},
+		{
+			name:           "connection string within another field",
+			path:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Web/sites/example/config/appsettings/list",
+			input:          `{"properties":{"STORAGE":"DefaultEndpointsProtocol=https;AccountName=example;AccountKey=abc123==;EndpointSuffix=core.windows.net"}}`,
+			expected:       `{"properties":{"STORAGE":"DefaultEndpointsProtocol=https;AccountName=example;AccountKey=REDACTED;EndpointSuffix=core.windows.net"}}`,
+			expectedFields: []string{"properties.STORAGE"},
+		},
+		{
+			name:           "not json",
+			path:           "/example",
+			input:          "Endpoint=sb://example.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=abc123=",
+			expected:       "Endpoint=sb://example.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=REDACTED",
+			expectedFields: []string{"<body>"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		redactor := newRedactor("response", httptest.NewRequest(http.MethodPost, v.path, nil))
+		actual := string(redactor.redactBody([]byte(v.input)))
+		if actual != v.expected {
+			t.Fatalf("expected %s but got %s", v.expected, actual)
+		}
+
+		if summary := redactor.finalise(nil); !reflect.DeepEqual(summary.Fields, v.expectedFields) {
+			t.Fatalf("expected the masked fields to be %+v but got %+v", v.expectedFields, summary.Fields)
+		}
+	}
+}
+
+func TestRegisterRedactionRules(t *testing.T) {
+	existing := redactionRules
+	defer func() {
+		redactionRules = existing
+	}()
+
+	RegisterRedactionRules(RedactionRule{
+		Service:     "Example",
+		PathPattern: regexp.MustCompile(`/providers/microsoft\.example/`),
+		Keys:        []string{"token"},
+	})
+
+	redactor := newRedactor("response", httptest.NewRequest(http.MethodGet, "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Example/things/example", nil))
+	actual := string(redactor.redactBody([]byte(`{"token":"abc123"}`)))
+	if expected := `{"token":"REDACTED"}`; actual != expected {
+		t.Fatalf("expected %s but got %s", expected, actual)
+	}
+	if summary := redactor.finalise(nil); !reflect.DeepEqual(summary.Services, []string{"Example"}) {
+		t.Fatalf("expected the Example service to be listed in the summary but got %+v", summary.Services)
+	}
+
+	redactor = newRedactor("response", httptest.NewRequest(http.MethodGet, "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Other/things/example", nil))
+	if actual := string(redactor.redactBody([]byte(`{"token":"abc123"}`))); actual != `{"token":"abc123"}` {
+		t.Fatalf("expected the rule to only apply to Microsoft.Example but got %s", actual)
+	}
+}
+
+func TestLoggerMiddlewaresRedactSecrets(t *testing.T) {
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	requestBody := `{"properties":{"administratorLoginPassword":"P@ssw0rd1234!"}}`
+	request := httptest.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Sql/servers/example?sig=abc123", strings.NewReader(requestBody))
+	request.Header.Set("Authorization", "Bearer abc123")
+
+	request, err := requestLoggerMiddleware("AzureRM")(request)
+	if err != nil {
+		t.Fatalf("logging the request: %+v", err)
+	}
+
+	// the request itself must be unchanged
+	if request.Header.Get("Authorization") != "Bearer abc123" {
+		t.Fatalf("expected the Authorization header to be retained but got %q", request.Header.Get("Authorization"))
+	}
+	if request.URL.Query().Get("sig") != "abc123" {
+		t.Fatalf("expected the `sig` query parameter to be retained but got %q", request.URL.Query().Get("sig"))
+	}
+	if body, _ := io.ReadAll(request.Body); string(body) != requestBody {
+		t.Fatalf("expected the request body to be retained but got %s", body)
+	}
+
+	response := &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header: http.Header{
+			"Content-Type": []string{"application/json"},
+		},
+		Body: io.NopCloser(strings.NewReader(`{"properties":{"connectionString":"Server=example;Password=abc123;"}}`)),
+	}
+	response, err = responseLoggerMiddleware("AzureRM")(request, response)
+	if err != nil {
+		t.Fatalf("logging the response: %+v", err)
+	}
+	if body, _ := io.ReadAll(response.Body); !strings.Contains(string(body), "abc123") {
+		t.Fatalf("expected the response body to be retained but got %s", body)
+	}
+
+	output := logs.String()
+	if strings.Contains(output, "abc123") || strings.Contains(output, "P@ssw0rd1234!") {
+		t.Fatalf("expected the sensitive values to be redacted but got:\n%s", output)
+	}
+	for _, expected := range []string{
+		`"headers":["Authorization"]`,
+		`"query_parameters":["sig"]`,
+		`"fields":["properties.administratorLoginPassword"]`,
+		`"fields":["properties.connectionString"]`,
+	} {
+		if !strings.Contains(output, expected) {
+			t.Fatalf("expected the summary to contain %s but got:\n%s", expected, output)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// buildSender returns the autorest.Sender used by each go-autorest client, which logs the requests and responses
+// in the same manner (and with the same sensitive values redacted) as the go-azure-sdk clients
+func buildSender(providerName string) autorest.Sender {
+	return autorest.DecorateSender(&http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+		},
+	}, withRedactedRequestLogging(providerName))
+}
+
+func withRedactedRequestLogging(providerName string) autorest.SendDecorator {
+	logRequest := requestLoggerMiddleware(providerName)
+	logResponse := responseLoggerMiddleware(providerName)
+
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			// the logger middlewares never return an error, they only log one
+			r, _ = logRequest(r)
+
+			resp, err := s.Do(r)
+			if resp != nil {
+				resp, _ = logResponse(r, resp)
+			} else {
+				requestURL := *r.URL
+				newRedactor("response", r).redactURL(&requestURL)
+				if err != nil {
+					// a *url.Error contains the unredacted URL, so only the underlying error is logged
+					logged := err
+					var urlErr *url.Error
+					if errors.As(err, &urlErr) {
+						logged = urlErr.Err
+					}
+					log.Printf("[DEBUG] %s Response Error: %s for %s\n", providerName, logged, requestURL.String())
+				} else {
+					log.Printf("[DEBUG] Request to %s completed with no response", requestURL.String())
+				}
+			}
+
+			return resp, err
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/tombuildsstuff/kermit/sdk/keyvault/7.4/keyvault"
+)
+
+func TestSenderRedactsKeyVaultSecrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/secrets/example/" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"value":"s3cr3t","id":"https://example.vault.azure.net/secrets/example/00000000000000000000000000000000"}`))
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	client := keyvault.New()
+	ClientOptions{DisableCorrelationRequestID: true}.ConfigureClient(&client.Client, autorest.NullAuthorizer{})
+
+	secret, err := client.GetSecret(context.Background(), server.URL, "example", "")
+	if err != nil {
+		t.Fatalf("retrieving the secret: %+v", err)
+	}
+
+	// the client must still receive the secret, only the logs are redacted
+	if secret.Value == nil || *secret.Value != "s3cr3t" {
+		t.Fatalf("expected the secret value to be returned but got %+v", secret.Value)
+	}
+
+	output := logs.String()
+	if strings.Contains(output, "s3cr3t") {
+		t.Fatalf("expected the secret value to be redacted but got:\n%s", output)
+	}
+	for _, expected := range []string{
+		"AzureRM Request:",
+		"AzureRM Response for",
+		`"value":"REDACTED"`,
+		`"fields":["value"]`,
+	} {
+		if !strings.Contains(output, expected) {
+			t.Fatalf("expected the logs to contain %s but got:\n%s", expected, output)
+		}
+	}
+}
@@ -0,0 +1,592 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/http/httptrace"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+const (
+	headerRemainingSubscriptionReads  = "x-ms-ratelimit-remaining-subscription-reads"
+	headerRemainingSubscriptionWrites = "x-ms-ratelimit-remaining-subscription-writes"
+	headerRetryAfter                  = "Retry-After"
+
+	// DefaultMaxRetryDuration is the default maximum length of time a throttled request is retried for
+	DefaultMaxRetryDuration = 5 * time.Minute
+
+	// lowRemainingRequests is the number of remaining requests for a Subscription (as returned by ARM) below which
+	// requests are paced, rather than sent immediately, to avoid being throttled
+	lowRemainingRequests = 10
+
+	// lowRemainingRequestsDelay is the delay between requests when pacing requests
+	lowRemainingRequestsDelay = 1 * time.Second
+
+	// minRetryBackoff and maxRetryBackoff bound the exponential backoff used when a throttled response
+	// doesn't contain a `Retry-After` header
+	minRetryBackoff = 1 * time.Second
+	maxRetryBackoff = 60 * time.Second
+)
+
+// ThrottlingOptions configures how requests to Azure Resource Manager are scheduled
+type ThrottlingOptions struct {
+	// MaxConcurrentRequests is the maximum number of concurrent requests sent to each Resource Provider within a
+	// Subscription, where 0 means that the number of concurrent requests isn't limited
+	MaxConcurrentRequests int
+
+	// MaxRetryDuration is the maximum length of time spent waiting to retry a throttled request, after which the
+	// throttled response is returned - where 0 means that DefaultMaxRetryDuration is used. The time taken to send
+	// each attempt isn't counted. Since go-azure-sdk clients retry requests themselves, for these clients this
+	// limits the time spent waiting between all retries, after which the retry is cancelled before it's sent.
+	MaxRetryDuration time.Duration
+}
+
+// RequestScheduler schedules requests to Azure Resource Manager, queueing requests per Subscription and per Resource
+// Provider to avoid being throttled (returning a 429) - by tracking the number of remaining requests returned by ARM
+// and waiting for the `Retry-After` duration (with jitter) once a request has been throttled.
+type RequestScheduler struct {
+	maxConcurrentRequests int
+	maxRetryDuration      time.Duration
+
+	// jitter returns the jitter to be added to the specified delay, which is overridden in tests
+	jitter func(delay time.Duration) time.Duration
+
+	lock          sync.Mutex
+	queues        map[string]*requestQueue
+	subscriptions map[string]*subscriptionLimits
+}
+
+// requestQueue tracks the in-flight requests for a Resource Provider within a Subscription
+type requestQueue struct {
+	// slots is a semaphore limiting the number of concurrent requests, which is nil when this isn't limited
+	slots chan struct{}
+
+	blockedUntil time.Time
+}
+
+// subscriptionLimits tracks the rate limits for a Subscription, as returned by ARM
+type subscriptionLimits struct {
+	// remainingReads and remainingWrites are -1 until a value has been returned by ARM
+	remainingReads  int
+	remainingWrites int
+
+	blockedUntil  time.Time
+	nextRequestAt time.Time
+}
+
+// NewRequestScheduler returns a RequestScheduler configured using the specified ThrottlingOptions
+func NewRequestScheduler(options ThrottlingOptions) *RequestScheduler {
+	maxRetryDuration := options.MaxRetryDuration
+	if maxRetryDuration <= 0 {
+		maxRetryDuration = DefaultMaxRetryDuration
+	}
+
+	return &RequestScheduler{
+		maxConcurrentRequests: options.MaxConcurrentRequests,
+		maxRetryDuration:      maxRetryDuration,
+		jitter:                defaultJitter,
+		queues:                make(map[string]*requestQueue),
+		subscriptions:         make(map[string]*subscriptionLimits),
+	}
+}
+
+// defaultJitter returns a random jitter of up to 25% of the specified delay, so that throttled requests
+// aren't all retried at the same time
+func defaultJitter(delay time.Duration) time.Duration {
+	if delay <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(delay/4) + 1)) // nolint:gosec
+}
+
+// RoundTripper returns a http.RoundTripper which schedules requests sent via the specified http.RoundTripper,
+// retrying throttled requests for up to the MaxRetryDuration
+func (s *RequestScheduler) RoundTripper(base http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		return s.do(request, base.RoundTrip)
+	})
+}
+
+// Sender returns an autorest.Sender which schedules requests sent via the specified autorest.Sender,
+// retrying throttled requests for up to the MaxRetryDuration
+func (s *RequestScheduler) Sender(base autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+		return s.do(request, base.Do)
+	})
+}
+
+// RequestMiddleware returns a client.RequestMiddleware which waits until the request can be sent - this
+// must be used alongside ResponseMiddleware, which tracks the response and releases the request. Since the
+// slot for the request is acquired here, this must be the last client.RequestMiddleware.
+//
+// Since go-azure-sdk clients retry requests themselves (within a single call to the http.Client), each attempt is
+// tracked using a httptrace.ClientTrace - the slot for the request is released once an attempt has received a
+// response (or failed) and is acquired again before the request is retried. Once the time spent waiting to retry
+// the request has exceeded the MaxRetryDuration, the retry is cancelled before it's sent and an error is returned.
+func (s *RequestScheduler) RequestMiddleware() client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		release, err := s.wait(request.Context(), request)
+		if err != nil {
+			return nil, fmt.Errorf("waiting to send the request: %+v", err)
+		}
+
+		ctx, cancel := context.WithCancelCause(request.Context())
+		scheduled := &scheduledRequest{
+			scheduler: s,
+			request:   request,
+			ctx:       causeContext{Context: ctx},
+			cancel:    cancel,
+			release:   release,
+			inFlight:  true,
+		}
+
+		// the ResponseMiddleware isn't called when no response is received, in which case the request is
+		// released once the context for the request is done
+		scheduled.stop = context.AfterFunc(ctx, scheduled.complete)
+
+		ctx = httptrace.WithClientTrace(context.WithValue(scheduled.ctx, scheduledRequestKey{}, scheduled), scheduled.trace())
+
+		// the request is updated in place, since earlier middlewares (e.g. the HTTPInterceptor) can track the request
+		*request = *request.WithContext(ctx)
+		return request, nil
+	}
+}
+
+// ResponseMiddleware returns a client.ResponseMiddleware which tracks the rate limits returned in the response
+// and releases the request - this must be used alongside RequestMiddleware. Since this releases the request,
+// this must be the first client.ResponseMiddleware.
+func (s *RequestScheduler) ResponseMiddleware() client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		if scheduled, ok := request.Context().Value(scheduledRequestKey{}).(*scheduledRequest); ok {
+			scheduled.complete()
+		}
+
+		s.observe(request, response, 0)
+		return response, nil
+	}
+}
+
+type scheduledRequestKey struct{}
+
+// scheduledRequest tracks each attempt to send a request using a go-azure-sdk client
+type scheduledRequest struct {
+	scheduler *RequestScheduler
+	request   *http.Request
+	ctx       context.Context
+	cancel    context.CancelCauseFunc
+
+	// stop unregisters the function releasing the request once the context is done
+	stop func() bool
+
+	lock      sync.Mutex
+	attempts  int
+	release   func()
+	completed bool
+
+	// inFlight is whether an attempt is being sent, finishedAt is when the last attempt received a response (or
+	// failed) and waitedToRetry is the total length of time spent between attempts, which is limited by the
+	// MaxRetryDuration - so that neither the time spent sending each attempt, nor waiting for a slot, is counted
+	inFlight      bool
+	finishedAt    time.Time
+	waitedToRetry time.Duration
+}
+
+func (r *scheduledRequest) trace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		GetConn: func(string) {
+			r.startAttempt()
+		},
+		GotFirstResponseByte: r.finishAttempt,
+		DNSDone: func(info httptrace.DNSDoneInfo) {
+			if info.Err != nil {
+				r.finishAttempt()
+			}
+		},
+		ConnectDone: func(_, _ string, err error) {
+			if err != nil {
+				r.finishAttempt()
+			}
+		},
+		TLSHandshakeDone: func(_ tls.ConnectionState, err error) {
+			if err != nil {
+				r.finishAttempt()
+			}
+		},
+		WroteRequest: func(info httptrace.WroteRequestInfo) {
+			if info.Err != nil {
+				r.finishAttempt()
+			}
+		},
+	}
+}
+
+// startAttempt is called before each attempt to send the request, waiting until a retry can be sent - the first
+// attempt has already waited in the RequestMiddleware. Since this is called before the attempt is sent, a retry
+// which would exceed the MaxRetryDuration is cancelled here rather than once a response has been received.
+func (r *scheduledRequest) startAttempt() {
+	r.lock.Lock()
+	r.attempts++
+	retry := r.attempts > 1
+	r.lock.Unlock()
+	if !retry {
+		return
+	}
+
+	// the previous attempt may have failed without a response, in which case the slot is still held
+	r.finishAttempt()
+
+	r.lock.Lock()
+	r.waitedToRetry += time.Since(r.finishedAt)
+	exceeded := r.waitedToRetry > r.scheduler.maxRetryDuration
+	r.lock.Unlock()
+	if exceeded {
+		log.Printf("[DEBUG] Request to %s has exceeded the maximum retry duration of %s, so won't be retried", r.request.URL, r.scheduler.maxRetryDuration)
+		r.cancel(fmt.Errorf("the request has exceeded the maximum retry duration of %s", r.scheduler.maxRetryDuration))
+		return
+	}
+
+	release, err := r.scheduler.wait(r.ctx, r.request)
+	if err != nil {
+		// the context is done, so the attempt fails without being sent
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.inFlight = true
+	if r.completed {
+		release()
+		return
+	}
+	r.release = release
+}
+
+// finishAttempt releases the slot held for the current attempt, once it's received a response or failed
+func (r *scheduledRequest) finishAttempt() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.inFlight {
+		r.inFlight = false
+		r.finishedAt = time.Now()
+	}
+	if r.release != nil {
+		r.release()
+		r.release = nil
+	}
+}
+
+// complete releases the request, once the response has been received or the context is done
+func (r *scheduledRequest) complete() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.completed = true
+	if r.release != nil {
+		r.release()
+		r.release = nil
+	}
+	if r.stop != nil {
+		r.stop()
+	}
+}
+
+// causeContext is the context for a request sent using a go-azure-sdk client, which (via go-retryablehttp) checks
+// the context for an error after each attempt - returning why the request was cancelled (for example because the
+// MaxRetryDuration has been exceeded), rather than a generic `context canceled`
+type causeContext struct {
+	context.Context
+}
+
+func (c causeContext) Err() error {
+	if c.Context.Err() != nil {
+		return context.Cause(c.Context)
+	}
+	return nil
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
+
+func (s *RequestScheduler) do(request *http.Request, send func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	// only the time spent waiting to retry the request counts towards the MaxRetryDuration
+	waitedToRetry := time.Duration(0)
+	for attempt := 0; ; attempt++ {
+		release, err := s.wait(request.Context(), request)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := send(request)
+		release()
+		if err != nil {
+			return response, err
+		}
+
+		delay, throttled := s.observe(request, response, attempt)
+		if !throttled {
+			return response, nil
+		}
+
+		waitedToRetry += delay
+		if waitedToRetry > s.maxRetryDuration {
+			log.Printf("[DEBUG] Request to %s was throttled and has exceeded the maximum retry duration of %s", request.URL, s.maxRetryDuration)
+			return response, nil
+		}
+
+		// the request body has been consumed, so must be rewound before it can be sent again
+		if request.Body != nil && request.Body != http.NoBody {
+			if request.GetBody == nil {
+				return response, nil
+			}
+			body, err := request.GetBody()
+			if err != nil {
+				return response, fmt.Errorf("rewinding the request body to retry: %+v", err)
+			}
+			request.Body = body
+		}
+
+		// drain the response body, so that the connection can be reused
+		if response.Body != nil {
+			_, _ = io.Copy(io.Discard, response.Body)
+			response.Body.Close()
+		}
+
+		log.Printf("[DEBUG] Request to %s was throttled, retrying in %s (attempt %d)", request.URL, delay, attempt+1)
+	}
+}
+
+// wait blocks until the specified request can be sent, returning a function to release the request once
+// a response has been received
+func (s *RequestScheduler) wait(ctx context.Context, request *http.Request) (func(), error) {
+	subscriptionId, resourceProvider := parseRequestScope(request)
+	if subscriptionId == "" {
+		// requests which aren't scoped to a Subscription (e.g. Data Plane requests) aren't scheduled
+		return func() {}, nil
+	}
+
+	queue, limits := s.queueFor(subscriptionId, resourceProvider)
+
+	for {
+		delay := s.delayFor(queue, limits, isReadRequest(request))
+		if delay <= 0 {
+			break
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	if queue.slots == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case queue.slots <- struct{}{}:
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			<-queue.slots
+		})
+	}, nil
+}
+
+// delayFor returns the length of time to wait before a request can be sent
+func (s *RequestScheduler) delayFor(queue *requestQueue, limits *subscriptionLimits, read bool) time.Duration {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := time.Now()
+	blockedUntil := queue.blockedUntil
+	if limits.blockedUntil.After(blockedUntil) {
+		blockedUntil = limits.blockedUntil
+	}
+	if blockedUntil.After(now) {
+		return blockedUntil.Sub(now)
+	}
+
+	remaining := limits.remainingWrites
+	if read {
+		remaining = limits.remainingReads
+	}
+	if remaining >= 0 && remaining < lowRemainingRequests {
+		if limits.nextRequestAt.After(now) {
+			return limits.nextRequestAt.Sub(now)
+		}
+		limits.nextRequestAt = now.Add(lowRemainingRequestsDelay)
+	}
+
+	return 0
+}
+
+// observe tracks the rate limits returned in the response, returning the length of time to wait before
+// retrying the request and whether the request was throttled
+func (s *RequestScheduler) observe(request *http.Request, response *http.Response, attempt int) (time.Duration, bool) {
+	subscriptionId, resourceProvider := parseRequestScope(request)
+	if subscriptionId == "" || response == nil {
+		return 0, false
+	}
+
+	queue, limits := s.queueFor(subscriptionId, resourceProvider)
+	read := isReadRequest(request)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	subscriptionExhausted := false
+	if v, ok := parseRemainingRequests(response.Header.Get(headerRemainingSubscriptionReads)); ok {
+		limits.remainingReads = v
+		subscriptionExhausted = subscriptionExhausted || (read && v == 0)
+	}
+	if v, ok := parseRemainingRequests(response.Header.Get(headerRemainingSubscriptionWrites)); ok {
+		limits.remainingWrites = v
+		subscriptionExhausted = subscriptionExhausted || (!read && v == 0)
+	}
+
+	if response.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	delay, ok := parseRetryAfter(response.Header.Get(headerRetryAfter), time.Now())
+	if !ok {
+		delay = exponentialBackoff(attempt)
+	}
+	delay += s.jitter(delay)
+
+	blockedUntil := time.Now().Add(delay)
+	if blockedUntil.After(queue.blockedUntil) {
+		queue.blockedUntil = blockedUntil
+	}
+	// when the Subscription has no remaining requests, every Resource Provider within it is throttled
+	if subscriptionExhausted && blockedUntil.After(limits.blockedUntil) {
+		limits.blockedUntil = blockedUntil
+	}
+
+	return delay, true
+}
+
+func (s *RequestScheduler) queueFor(subscriptionId, resourceProvider string) (*requestQueue, *subscriptionLimits) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	limits, ok := s.subscriptions[subscriptionId]
+	if !ok {
+		limits = &subscriptionLimits{
+			remainingReads:  -1,
+			remainingWrites: -1,
+		}
+		s.subscriptions[subscriptionId] = limits
+	}
+
+	key := fmt.Sprintf("%s/%s", subscriptionId, resourceProvider)
+	queue, ok := s.queues[key]
+	if !ok {
+		queue = &requestQueue{}
+		if s.maxConcurrentRequests > 0 {
+			queue.slots = make(chan struct{}, s.maxConcurrentRequests)
+		}
+		s.queues[key] = queue
+	}
+
+	return queue, limits
+}
+
+// parseRequestScope returns the Subscription ID and Resource Provider (both normalised to lower-case) that the
+// request is for, based on the URI - where the Resource Provider is the last one within the URI, since this is
+// the Resource Provider processing the request
+func parseRequestScope(request *http.Request) (subscriptionId string, resourceProvider string) {
+	if request == nil || request.URL == nil {
+		return "", ""
+	}
+
+	segments := strings.Split(strings.Trim(request.URL.Path, "/"), "/")
+	for i := 0; i < len(segments)-1; i++ {
+		switch strings.ToLower(segments[i]) {
+		case "subscriptions":
+			if subscriptionId == "" {
+				subscriptionId = strings.ToLower(segments[i+1])
+			}
+		case "providers":
+			resourceProvider = strings.ToLower(segments[i+1])
+		}
+	}
+
+	return subscriptionId, resourceProvider
+}
+
+func isReadRequest(request *http.Request) bool {
+	return request.Method == http.MethodGet || request.Method == http.MethodHead
+}
+
+func parseRemainingRequests(input string) (int, bool) {
+	if input == "" {
+		return 0, false
+	}
+
+	v, err := strconv.Atoi(strings.TrimSpace(input))
+	if err != nil || v < 0 {
+		return 0, false
+	}
+
+	return v, true
+}
+
+// parseRetryAfter parses the `Retry-After` header, which is either a number of seconds or a HTTP Date
+func parseRetryAfter(input string, now time.Time) (time.Duration, bool) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseInt(input, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(input); err == nil {
+		delay := date.Sub(now)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+func exponentialBackoff(attempt int) time.Duration {
+	delay := time.Duration(math.Pow(2, float64(attempt))) * minRetryBackoff
+	if delay <= 0 || delay > maxRetryBackoff {
+		return maxRetryBackoff
+	}
+	return delay
+}
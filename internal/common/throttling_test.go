@@ -0,0 +1,408 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+const testThrottlingPath = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Compute/virtualMachines/example"
+
+// scriptedServer returns a httptest server which returns the scripted status codes in order (returning the last
+// one once the script is exhausted), alongside the number of requests received
+func scriptedServer(t *testing.T, script ...int) (*httptest.Server, *int32) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		i := int(atomic.AddInt32(&requests, 1)) - 1
+		if i >= len(script) {
+			i = len(script) - 1
+		}
+
+		if script[i] == http.StatusTooManyRequests {
+			w.Header().Set(headerRetryAfter, "1")
+			w.Header().Set(headerRemainingSubscriptionReads, "0")
+		} else {
+			w.Header().Set(headerRemainingSubscriptionReads, "11999")
+		}
+		w.WriteHeader(script[i])
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func TestRequestSchedulerRetriesThrottledRequests(t *testing.T) {
+	server, requests := scriptedServer(t, http.StatusTooManyRequests, http.StatusOK)
+
+	scheduler := NewRequestScheduler(ThrottlingOptions{})
+	delays := make([]time.Duration, 0)
+	scheduler.jitter = func(delay time.Duration) time.Duration {
+		delays = append(delays, delay)
+		return 0
+	}
+
+	httpClient := &http.Client{Transport: scheduler.RoundTripper(http.DefaultTransport)}
+	start := time.Now()
+	resp, err := httpClient.Get(server.URL + testThrottlingPath)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected a 200 but got %d", resp.StatusCode)
+	}
+	if v := atomic.LoadInt32(requests); v != 2 {
+		t.Fatalf("expected 2 requests but got %d", v)
+	}
+	if len(delays) != 1 || delays[0] != time.Second {
+		t.Fatalf("expected the `Retry-After` of 1s to be jittered but got %+v", delays)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("expected the request to be retried after 1s but it was retried after %s", elapsed)
+	}
+
+	limits := scheduler.subscriptions["00000000-0000-0000-0000-000000000000"]
+	if limits == nil || limits.remainingReads != 11999 {
+		t.Fatalf("expected the remaining reads to be tracked from the last response but got %+v", limits)
+	}
+}
+
+func TestRequestSchedulerMaxRetryDuration(t *testing.T) {
+	server, requests := scriptedServer(t, http.StatusTooManyRequests)
+
+	scheduler := NewRequestScheduler(ThrottlingOptions{
+		MaxRetryDuration: 500 * time.Millisecond,
+	})
+	scheduler.jitter = func(time.Duration) time.Duration {
+		return 0
+	}
+
+	httpClient := &http.Client{Transport: scheduler.RoundTripper(http.DefaultTransport)}
+	resp, err := httpClient.Get(server.URL + testThrottlingPath)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected the throttled response to be returned but got %d", resp.StatusCode)
+	}
+	if v := atomic.LoadInt32(requests); v != 1 {
+		t.Fatalf("expected a single request but got %d", v)
+	}
+}
+
+// executeUsingSdkClient sends a request using a go-azure-sdk client configured to use the RequestScheduler
+func executeUsingSdkClient(ctx context.Context, endpoint, method string, scheduler *RequestScheduler) (*client.Response, error) {
+	c := client.NewClient(endpoint, "throttling", "2023-01-01")
+	ClientOptions{
+		DisableCorrelationRequestID: true,
+		RequestScheduler:            scheduler,
+	}.Configure(c, nil)
+
+	req, err := c.NewRequest(ctx, client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusOK},
+		HttpMethod:          method,
+		Path:                testThrottlingPath,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return req.Execute(ctx)
+}
+
+func TestRequestSchedulerMaxRetryDurationUsingSdkClient(t *testing.T) {
+	server, requests := scriptedServer(t, http.StatusTooManyRequests)
+
+	scheduler := NewRequestScheduler(ThrottlingOptions{
+		MaxConcurrentRequests: 1,
+		MaxRetryDuration:      1500 * time.Millisecond,
+	})
+	scheduler.jitter = func(time.Duration) time.Duration {
+		return 0
+	}
+
+	// the go-azure-sdk client retries a throttled request (waiting for the `Retry-After` of 1s) up to 16 times
+	start := time.Now()
+	_, err := executeUsingSdkClient(context.Background(), server.URL, http.MethodGet, scheduler)
+	if err == nil || !strings.Contains(err.Error(), "maximum retry duration") {
+		t.Fatalf("expected an error once the maximum retry duration was exceeded but got: %+v", err)
+	}
+	if v := atomic.LoadInt32(requests); v != 2 {
+		t.Fatalf("expected the request to be retried once within 1.5s (2 requests) but got %d requests", v)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected the request to stop being retried after 1.5s but it took %s", elapsed)
+	}
+
+	// the slot for the request is released, so a subsequent request can be sent
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := scheduler.RequestMiddleware()(httptest.NewRequest(http.MethodGet, testThrottlingPath, nil).WithContext(ctx)); err != nil {
+		t.Fatalf("expected the slot to be released once the request completed but got: %+v", err)
+	}
+}
+
+func TestRequestSchedulerMaxRetryDurationExcludesSlowRequestsUsingSdkClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(750 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+
+		// the body is sent separately, so that it's read after the response has been checked for retries
+		time.Sleep(100 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"name": "example"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	scheduler := NewRequestScheduler(ThrottlingOptions{
+		MaxRetryDuration: 250 * time.Millisecond,
+	})
+
+	// the request takes longer than the maximum retry duration, but since it isn't retried it should succeed
+	resp, err := executeUsingSdkClient(context.Background(), server.URL, http.MethodGet, scheduler)
+	if err != nil {
+		t.Fatalf("expected the slow request to succeed but got: %+v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected a 200 but got %d", resp.StatusCode)
+	}
+	if err := resp.Request.Context().Err(); err != nil {
+		t.Fatalf("expected the context for the request not to be cancelled but got: %+v", err)
+	}
+
+	var model struct {
+		Name string `json:"name"`
+	}
+	if err := resp.Unmarshal(&model); err != nil {
+		t.Fatalf("expected the response body to be read but got: %+v", err)
+	}
+	if model.Name != "example" {
+		t.Fatalf("expected the name to be %q but got %q", "example", model.Name)
+	}
+}
+
+func TestRequestSchedulerReleasesFailedRequestsUsingSdkClient(t *testing.T) {
+	// requests to a closed server fail without a response
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	scheduler := NewRequestScheduler(ThrottlingOptions{
+		MaxConcurrentRequests: 1,
+	})
+
+	// the request isn't retried since it's not idempotent, so the context remains active once it's failed
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if _, err := executeUsingSdkClient(ctx, server.URL, http.MethodPut, scheduler); err == nil || !strings.Contains(err.Error(), "connect") {
+		t.Fatalf("expected the request to fail to connect but got: %+v", err)
+	}
+
+	waitCtx, waitCancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer waitCancel()
+	if _, err := scheduler.RequestMiddleware()(httptest.NewRequest(http.MethodGet, testThrottlingPath, nil).WithContext(waitCtx)); err != nil {
+		t.Fatalf("expected the slot to be released once the request failed but got: %+v", err)
+	}
+}
+
+func TestRequestSchedulerMaxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			previous := atomic.LoadInt32(&maxInFlight)
+			if current <= previous || atomic.CompareAndSwapInt32(&maxInFlight, previous, current) {
+				break
+			}
+		}
+
+		time.Sleep(50 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	scheduler := NewRequestScheduler(ThrottlingOptions{
+		MaxConcurrentRequests: 2,
+	})
+	httpClient := &http.Client{Transport: scheduler.RoundTripper(http.DefaultTransport)}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := httpClient.Get(server.URL + testThrottlingPath)
+			if err != nil {
+				t.Errorf("sending request: %+v", err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if v := atomic.LoadInt32(&maxInFlight); v > 2 {
+		t.Fatalf("expected at most 2 concurrent requests but got %d", v)
+	}
+}
+
+func TestRequestSchedulerExhaustedSubscription(t *testing.T) {
+	scheduler := NewRequestScheduler(ThrottlingOptions{})
+	scheduler.jitter = func(time.Duration) time.Duration {
+		return 0
+	}
+
+	request := httptest.NewRequest(http.MethodGet, testThrottlingPath, nil)
+	response := &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{},
+	}
+	response.Header.Set(headerRetryAfter, "30")
+	response.Header.Set(headerRemainingSubscriptionReads, "0")
+	response.Header.Set(headerRemainingSubscriptionWrites, "1199")
+	delay, throttled := scheduler.observe(request, response, 0)
+	if !throttled || delay != 30*time.Second {
+		t.Fatalf("expected the request to be throttled for 30s but got %t / %s", throttled, delay)
+	}
+
+	// reads to any other Resource Provider within the Subscription must wait, since there are no remaining reads
+	otherQueue, limits := scheduler.queueFor("00000000-0000-0000-0000-000000000000", "microsoft.network")
+	if v := scheduler.delayFor(otherQueue, limits, true); v <= 0 {
+		t.Fatalf("expected reads for another Resource Provider to be delayed but got %s", v)
+	}
+
+	// whereas requests for another Subscription are unaffected
+	otherQueue, limits = scheduler.queueFor("11111111-1111-1111-1111-111111111111", "microsoft.compute")
+	if v := scheduler.delayFor(otherQueue, limits, true); v != 0 {
+		t.Fatalf("expected reads for another Subscription not to be delayed but got %s", v)
+	}
+}
+
+func TestRequestSchedulerMiddlewares(t *testing.T) {
+	scheduler := NewRequestScheduler(ThrottlingOptions{
+		MaxConcurrentRequests: 1,
+	})
+	requestMiddleware := scheduler.RequestMiddleware()
+	responseMiddleware := scheduler.ResponseMiddleware()
+
+	first, err := requestMiddleware(httptest.NewRequest(http.MethodGet, testThrottlingPath, nil))
+	if err != nil {
+		t.Fatalf("expected the first request to be sent but got: %+v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := requestMiddleware(httptest.NewRequest(http.MethodGet, testThrottlingPath, nil).WithContext(ctx)); err == nil {
+		t.Fatalf("expected the second request to wait for the first request to complete")
+	}
+
+	if _, err := responseMiddleware(first, &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}); err != nil {
+		t.Fatalf("releasing the first request: %+v", err)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := requestMiddleware(httptest.NewRequest(http.MethodGet, testThrottlingPath, nil).WithContext(ctx)); err != nil {
+		t.Fatalf("expected the third request to be sent once the first request completed but got: %+v", err)
+	}
+}
+
+func TestParseRequestScope(t *testing.T) {
+	testData := []struct {
+		path             string
+		subscriptionId   string
+		resourceProvider string
+	}{
+		{
+			path:             "/providers/Microsoft.Resources/operations",
+			resourceProvider: "microsoft.resources",
+		},
+		{
+			path:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			subscriptionId: "00000000-0000-0000-0000-000000000000",
+		},
+		{
+			path:             testThrottlingPath,
+			subscriptionId:   "00000000-0000-0000-0000-000000000000",
+			resourceProvider: "microsoft.compute",
+		},
+		{
+			path:             "/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example/providers/Microsoft.Insights/diagnosticSettings/example",
+			subscriptionId:   "00000000-0000-0000-0000-000000000000",
+			resourceProvider: "microsoft.insights",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.path)
+
+		subscriptionId, resourceProvider := parseRequestScope(httptest.NewRequest(http.MethodGet, v.path, nil))
+		if subscriptionId != v.subscriptionId {
+			t.Fatalf("expected the Subscription ID to be %q but got %q", v.subscriptionId, subscriptionId)
+		}
+		if resourceProvider != v.resourceProvider {
+			t.Fatalf("expected the Resource Provider to be %q but got %q", v.resourceProvider, resourceProvider)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	testData := []struct {
+		input    string
+		expected time.Duration
+		ok       bool
+	}{
+		{
+			input: "",
+		},
+		{
+			input: "invalid",
+		},
+		{
+			input: "-1",
+		},
+		{
+			input:    "30",
+			expected: 30 * time.Second,
+			ok:       true,
+		},
+		{
+			input:    "Mon, 01 Jan 2024 12:01:00 GMT",
+			expected: time.Minute,
+			ok:       true,
+		},
+		{
+			input:    "Mon, 01 Jan 2024 11:59:00 GMT",
+			expected: 0,
+			ok:       true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		actual, ok := parseRetryAfter(v.input, now)
+		if ok != v.ok {
+			t.Fatalf("expected ok to be %t but got %t", v.ok, ok)
+		}
+		if actual != v.expected {
+			t.Fatalf("expected %s but got %s", v.expected, actual)
+		}
+	}
+}
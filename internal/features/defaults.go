@@ -75,5 +75,8 @@ func Default() UserFeatures {
 			VMBackupStopProtectionAndRetainDataOnDestroy: false,
 			PurgeProtectedItemsFromVaultOnDestroy:        false,
 		},
+		StorageAccount: StorageAccountFeatures{
+			RecoverSoftDeleted: false,
+		},
 	}
 }
@@ -20,6 +20,7 @@ type UserFeatures struct {
 	PostgresqlFlexibleServer PostgresqlFlexibleServerFeatures
 	MachineLearning          MachineLearningFeatures
 	RecoveryService          RecoveryServiceFeatures
+	StorageAccount           StorageAccountFeatures
 }
 
 type CognitiveAccountFeatures struct {
@@ -104,3 +105,7 @@ type RecoveryServiceFeatures struct {
 	VMBackupStopProtectionAndRetainDataOnDestroy bool
 	PurgeProtectedItemsFromVaultOnDestroy        bool
 }
+
+type StorageAccountFeatures struct {
+	RecoverSoftDeleted bool
+}
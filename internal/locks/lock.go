@@ -3,40 +3,69 @@
 
 package locks
 
-// armMutexKV is the instance of MutexKV for ARM resources
-var armMutexKV = newMutexKV()
+import (
+	"context"
+)
 
+// armLocks is the instance of lockManager for ARM resources
+var armLocks = newLockManager()
+
+// ByID acquires the lock for the given ID, waiting until it's available. Since no owner is specified deadlocks can't be
+// detected, and so this can't fail - ByIDWithContext should be used where possible instead.
 func ByID(id string) {
-	armMutexKV.Lock(id)
+	_ = armLocks.Lock(context.Background(), id)
+}
+
+// ByIDWithContext acquires the lock for the given ID, returning an error if the context is done (for example when the
+// operation times out) or a deadlock is detected before the lock is acquired. Deadlocks are only detected when the
+// context identifies the operation acquiring the lock, see WithOwner.
+func ByIDWithContext(ctx context.Context, id string) error {
+	return armLocks.Lock(ctx, id)
 }
 
-// handle the case of using the same name for different kinds of resources
+// ByName acquires the lock for the given name, handling the case of using the same name for different kinds of
+// resources. As with ByID this can't fail - ByNameWithContext should be used where possible instead.
 func ByName(name string, resourceType string) {
-	updatedName := resourceType + "." + name
-	armMutexKV.Lock(updatedName)
+	_ = armLocks.Lock(context.Background(), nameKey(name, resourceType))
+}
+
+// ByNameWithContext acquires the lock for the given name, returning an error if the context is done (for example when
+// the operation times out) or a deadlock is detected before the lock is acquired, as with ByIDWithContext
+func ByNameWithContext(ctx context.Context, name string, resourceType string) error {
+	return armLocks.Lock(ctx, nameKey(name, resourceType))
 }
 
+// MultipleByName acquires the locks for all the given names. As with ByID this can't fail - MultipleByNameWithContext
+// should be used where possible instead.
 func MultipleByName(names *[]string, resourceType string) {
-	newSlice := removeDuplicatesFromStringArray(*names)
+	_ = armLocks.LockMultiple(context.Background(), nameKeys(*names, resourceType))
+}
 
-	for _, name := range newSlice {
-		ByName(name, resourceType)
-	}
+// MultipleByNameWithContext acquires the locks for all the given names, or none of them if an error is returned
+func MultipleByNameWithContext(ctx context.Context, names *[]string, resourceType string) error {
+	return armLocks.LockMultiple(ctx, nameKeys(*names, resourceType))
 }
 
 func UnlockByID(id string) {
-	armMutexKV.Unlock(id)
+	armLocks.Unlock(id)
 }
 
 func UnlockByName(name string, resourceType string) {
-	updatedName := resourceType + "." + name
-	armMutexKV.Unlock(updatedName)
+	armLocks.Unlock(nameKey(name, resourceType))
 }
 
 func UnlockMultipleByName(names *[]string, resourceType string) {
-	newSlice := removeDuplicatesFromStringArray(*names)
+	armLocks.UnlockMultiple(nameKeys(*names, resourceType))
+}
+
+func nameKey(name string, resourceType string) string {
+	return resourceType + "." + name
+}
 
-	for _, name := range newSlice {
-		UnlockByName(name, resourceType)
+func nameKeys(names []string, resourceType string) []string {
+	keys := make([]string, 0, len(names))
+	for _, name := range names {
+		keys = append(keys, nameKey(name, resourceType))
 	}
+	return keys
 }
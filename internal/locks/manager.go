@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package locks
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DeadlockError is returned when acquiring a lock would never succeed, since the holder of the lock is (directly or
+// indirectly) waiting for a lock held by the caller.
+type DeadlockError struct {
+	// Keys is the cycle of locks, starting with the lock being acquired - each of which is held by an operation
+	// waiting for the next, and the last of which is held by the caller
+	Keys []string
+}
+
+func (e DeadlockError) Error() string {
+	return fmt.Sprintf("deadlock detected: acquiring the lock for %s would never succeed, since the locks %s are each held by an operation waiting for the next, the last of which is held by this operation", strconv.Quote(e.Keys[0]), quoteAll(e.Keys))
+}
+
+// lockManager is a key/value store of locks, which can be used to serialize changes across arbitrary collaborators
+// that share knowledge of the keys they must serialize on. Unlike a sync.Mutex, acquiring a lock can be cancelled
+// using a context, and a cycle of operations waiting on each other is reported as a DeadlockError.
+type lockManager struct {
+	lock sync.Mutex
+
+	// held is the locks which are currently held, keyed by the lock key
+	held map[string]*heldLock
+
+	// waiting is the lock key each owner is currently waiting to acquire
+	waiting map[owner]string
+}
+
+// owner identifies the operation holding or waiting for a lock
+type owner uint64
+
+// lastOwner is the most recently allocated owner token
+var lastOwner atomic.Uint64
+
+type ownerKey struct{}
+
+// WithOwner returns a context identifying a single operation (e.g. the Create of a resource) which acquires locks
+// using it. Locks acquired using the same owner are tracked together, so that a cycle of operations waiting on each
+// other can be reported as a DeadlockError. When the context already identifies an owner it's returned as-is.
+func WithOwner(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(ownerKey{}).(owner); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, ownerKey{}, newOwner())
+}
+
+func newOwner() owner {
+	return owner(lastOwner.Add(1))
+}
+
+// ownerFromContext returns the owner identified by the context and true - or when there's none, a new owner for
+// this call alone and false
+func ownerFromContext(ctx context.Context) (owner, bool) {
+	if o, ok := ctx.Value(ownerKey{}).(owner); ok {
+		return o, true
+	}
+	return newOwner(), false
+}
+
+type heldLock struct {
+	owner    owner
+	since    time.Time
+	released chan struct{}
+}
+
+// newLockManager returns a properly initialized lockManager
+func newLockManager() *lockManager {
+	return &lockManager{
+		held:    make(map[string]*heldLock),
+		waiting: make(map[owner]string),
+	}
+}
+
+// Lock acquires the lock for the given key, waiting until it's available, the context is done or a deadlock is
+// detected. The owner of the lock is taken from the context (see WithOwner) - deadlocks can only be detected when
+// it has one. Caller is responsible for calling Unlock for the same key when this returns nil.
+func (m *lockManager) Lock(ctx context.Context, key string) error {
+	o, detectDeadlocks := ownerFromContext(ctx)
+	return m.lockAs(ctx, o, key, detectDeadlocks)
+}
+
+// LockMultiple acquires the locks for all the given keys in a canonical order, so that callers acquiring overlapping
+// sets of keys can't deadlock each other. Either all the locks are acquired, or none are when an error is returned.
+func (m *lockManager) LockMultiple(ctx context.Context, keys []string) error {
+	o, detectDeadlocks := ownerFromContext(ctx)
+	keys = canonicalKeys(keys)
+	for i, key := range keys {
+		if err := m.lockAs(ctx, o, key, detectDeadlocks); err != nil {
+			m.UnlockMultiple(keys[:i])
+			return err
+		}
+	}
+	return nil
+}
+
+// Unlock releases the lock for the given key. Caller must have acquired the lock for the same key first
+func (m *lockManager) Unlock(key string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	held, ok := m.held[key]
+	if !ok {
+		panic(fmt.Sprintf("unlocking %q which is not locked", key))
+	}
+	delete(m.held, key)
+	close(held.released)
+	log.Printf("[DEBUG] Unlocked %q after holding it for %s", key, time.Since(held.since))
+}
+
+// UnlockMultiple releases the locks for all the given keys, in the reverse of the order they were acquired
+func (m *lockManager) UnlockMultiple(keys []string) {
+	keys = canonicalKeys(keys)
+	for i := len(keys) - 1; i >= 0; i-- {
+		m.Unlock(keys[i])
+	}
+}
+
+// lockAs acquires the lock for the given key as the given owner. The owner is always recorded as waiting so that
+// other owners can detect a cycle involving it, but it's only checked for a cycle itself when detectDeadlocks is true.
+func (m *lockManager) lockAs(ctx context.Context, o owner, key string, detectDeadlocks bool) error {
+	log.Printf("[DEBUG] Locking %q", key)
+	start := time.Now()
+	for {
+		m.lock.Lock()
+		held, ok := m.held[key]
+		if !ok {
+			delete(m.waiting, o)
+			m.held[key] = &heldLock{
+				owner:    o,
+				since:    time.Now(),
+				released: make(chan struct{}),
+			}
+			m.lock.Unlock()
+			log.Printf("[DEBUG] Locked %q after waiting %s", key, time.Since(start))
+			return nil
+		}
+
+		if detectDeadlocks {
+			if keys := m.cycle(o, key); keys != nil {
+				delete(m.waiting, o)
+				m.lock.Unlock()
+				return DeadlockError{Keys: keys}
+			}
+		}
+
+		m.waiting[o] = key
+		released := held.released
+		m.lock.Unlock()
+
+		select {
+		case <-released:
+			// the lock may be acquired by another waiter first, so check again
+		case <-ctx.Done():
+			m.lock.Lock()
+			delete(m.waiting, o)
+			m.lock.Unlock()
+			return fmt.Errorf("waiting %s for the lock for %q: %+v", time.Since(start), key, ctx.Err())
+		}
+	}
+}
+
+// cycle returns the keys involved when the owner waiting for the given key would complete a cycle of operations
+// waiting on each other, or nil if there's no such cycle. The caller must hold m.lock.
+func (m *lockManager) cycle(o owner, key string) []string {
+	keys := []string{key}
+	// each owner can only be waiting on a single key, so a cycle can't be longer than the number of waiters
+	for i := 0; i <= len(m.waiting); i++ {
+		held, ok := m.held[keys[len(keys)-1]]
+		if !ok {
+			return nil
+		}
+		if held.owner == o {
+			return keys
+		}
+		next, ok := m.waiting[held.owner]
+		if !ok {
+			return nil
+		}
+		keys = append(keys, next)
+	}
+	return nil
+}
+
+// canonicalKeys returns the given keys de-duplicated and sorted
+func canonicalKeys(keys []string) []string {
+	result := removeDuplicatesFromStringArray(keys)
+	sort.Strings(result)
+	return result
+}
+
+func quoteAll(keys []string) string {
+	quoted := make([]string, 0, len(keys))
+	for _, key := range keys {
+		quoted = append(quoted, strconv.Quote(key))
+	}
+	return strings.Join(quoted, " -> ")
+}
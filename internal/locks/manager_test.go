@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package locks
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLockManager_LockUnlock(t *testing.T) {
+	m := newLockManager()
+	if err := m.Lock(context.Background(), "a"); err != nil {
+		t.Fatalf("locking: %+v", err)
+	}
+
+	acquired := make(chan struct{})
+	go func() {
+		if err := m.Lock(context.Background(), "a"); err != nil {
+			t.Errorf("locking from another goroutine: %+v", err)
+		}
+		close(acquired)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatalf("expected the lock to be held")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	m.Unlock("a")
+	select {
+	case <-acquired:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected the lock to be acquired once released")
+	}
+	m.Unlock("a")
+}
+
+func TestLockManager_ContextCancelled(t *testing.T) {
+	m := newLockManager()
+	if err := m.Lock(context.Background(), "a"); err != nil {
+		t.Fatalf("locking: %+v", err)
+	}
+	defer m.Unlock("a")
+
+	errs := make(chan error)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		errs <- m.Lock(ctx, "a")
+	}()
+
+	if err := <-errs; err == nil {
+		t.Fatalf("expected an error when the context is done")
+	}
+	if len(m.waiting) != 0 {
+		t.Fatalf("expected no waiters once the context is done, got %+v", m.waiting)
+	}
+}
+
+func TestLockManager_Deadlock(t *testing.T) {
+	m := newLockManager()
+	// the first goroutine holds "nic" and waits for "subnet"
+	nicLocked := make(chan struct{})
+	errs := make(chan error, 1)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ctx := WithOwner(context.Background())
+		if err := m.Lock(ctx, "nic"); err != nil {
+			t.Errorf("locking nic: %+v", err)
+			return
+		}
+		close(nicLocked)
+		errs <- m.Lock(ctx, "subnet")
+		m.Unlock("subnet")
+		m.Unlock("nic")
+	}()
+
+	// this operation holds "subnet" and then waits for "nic" - completing the cycle
+	ctx := WithOwner(context.Background())
+	if err := m.Lock(ctx, "subnet"); err != nil {
+		t.Fatalf("locking subnet: %+v", err)
+	}
+	<-nicLocked
+	for {
+		m.lock.Lock()
+		waiting := len(m.waiting)
+		m.lock.Unlock()
+		if waiting == 1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	err := m.Lock(ctx, "nic")
+	var deadlock DeadlockError
+	if !errors.As(err, &deadlock) {
+		t.Fatalf("expected a DeadlockError but got %+v", err)
+	}
+	if expected := []string{"nic", "subnet"}; !reflect.DeepEqual(deadlock.Keys, expected) {
+		t.Fatalf("expected the keys %+v but got %+v", expected, deadlock.Keys)
+	}
+
+	// releasing the lock allows the other operation to complete
+	m.Unlock("subnet")
+	wg.Wait()
+	if err := <-errs; err != nil {
+		t.Fatalf("expected the other goroutine to acquire the lock: %+v", err)
+	}
+}
+
+func TestLockManager_DeadlockSameOwner(t *testing.T) {
+	m := newLockManager()
+	ctx := WithOwner(context.Background())
+	if err := m.Lock(ctx, "a"); err != nil {
+		t.Fatalf("locking: %+v", err)
+	}
+	defer m.Unlock("a")
+
+	var deadlock DeadlockError
+	if err := m.Lock(ctx, "a"); !errors.As(err, &deadlock) {
+		t.Fatalf("expected a DeadlockError when locking a held key again but got %+v", err)
+	}
+}
+
+func TestLockManager_WithoutOwner(t *testing.T) {
+	m := newLockManager()
+	if err := m.Lock(context.Background(), "a"); err != nil {
+		t.Fatalf("locking: %+v", err)
+	}
+	defer m.Unlock("a")
+
+	// each lock acquired without an owner is independent, so this waits for the lock rather than reporting a deadlock
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	var deadlock DeadlockError
+	err := m.Lock(ctx, "a")
+	if err == nil || errors.As(err, &deadlock) {
+		t.Fatalf("expected to wait for the lock until the context is done but got %+v", err)
+	}
+}
+
+func TestWithOwner(t *testing.T) {
+	ctx := WithOwner(context.Background())
+	first, ok := ownerFromContext(ctx)
+	if !ok {
+		t.Fatalf("expected the context to identify an owner")
+	}
+	if second, _ := ownerFromContext(WithOwner(ctx)); second != first {
+		t.Fatalf("expected the existing owner %d to be retained but got %d", first, second)
+	}
+	if other, _ := ownerFromContext(WithOwner(context.Background())); other == first {
+		t.Fatalf("expected each operation to have a different owner")
+	}
+}
+
+func TestLockManager_LockMultiple(t *testing.T) {
+	m := newLockManager()
+	if err := m.Lock(context.Background(), "b"); err != nil {
+		t.Fatalf("locking: %+v", err)
+	}
+
+	// the locks are acquired in a canonical order, so "a" is released when "b" can't be acquired
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	errs := make(chan error)
+	go func() {
+		errs <- m.LockMultiple(ctx, []string{"c", "b", "a", "c"})
+	}()
+	if err := <-errs; err == nil {
+		t.Fatalf("expected an error when one of the locks is held")
+	}
+	if _, ok := m.held["a"]; ok {
+		t.Fatalf("expected the lock for %q to be released", "a")
+	}
+	m.Unlock("b")
+
+	if err := m.LockMultiple(context.Background(), []string{"c", "b", "a", "c"}); err != nil {
+		t.Fatalf("locking multiple: %+v", err)
+	}
+	for _, key := range []string{"a", "b", "c"} {
+		if _, ok := m.held[key]; !ok {
+			t.Fatalf("expected the lock for %q to be held", key)
+		}
+	}
+	m.UnlockMultiple([]string{"a", "b", "c"})
+	if len(m.held) != 0 {
+		t.Fatalf("expected no locks to be held, got %+v", m.held)
+	}
+}
+
+func TestLocks_ByName(t *testing.T) {
+	names := []string{"nic2", "nic1"}
+	MultipleByName(&names, "azurerm_network_interface")
+	if err := ByNameWithContext(context.Background(), "subnet1", "azurerm_subnet"); err != nil {
+		t.Fatalf("locking by name: %+v", err)
+	}
+	UnlockByName("subnet1", "azurerm_subnet")
+	UnlockMultipleByName(&names, "azurerm_network_interface")
+}
@@ -1,51 +0,0 @@
-// Copyright (c) HashiCorp, Inc.
-// SPDX-License-Identifier: MPL-2.0
-
-package locks
-
-import (
-	"log"
-	"sync"
-)
-
-// mutexKV is a simple key/value store for arbitrary mutexes. It can be used to
-// serialize changes across arbitrary collaborators that share knowledge of the
-// keys they must serialize on.
-type mutexKV struct {
-	lock  sync.Mutex
-	store map[string]*sync.Mutex
-}
-
-// Locks the mutex for the given key. Caller is responsible for calling Unlock
-// for the same key
-func (m *mutexKV) Lock(key string) {
-	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
-	log.Printf("[DEBUG] Locked %q", key)
-}
-
-// Unlock the mutex for the given key. Caller must have called Lock for the same key first
-func (m *mutexKV) Unlock(key string) {
-	log.Printf("[DEBUG] Unlocking %q", key)
-	m.get(key).Unlock()
-	log.Printf("[DEBUG] Unlocked %q", key)
-}
-
-// Returns a mutex for the given key, no guarantee of its lock status
-func (m *mutexKV) get(key string) *sync.Mutex {
-	m.lock.Lock()
-	defer m.lock.Unlock()
-	mutex, ok := m.store[key]
-	if !ok {
-		mutex = &sync.Mutex{}
-		m.store[key] = mutex
-	}
-	return mutex
-}
-
-// newMutexKV returns a properly initialized mutexKV
-func newMutexKV() *mutexKV {
-	return &mutexKV{
-		store: make(map[string]*sync.Mutex),
-	}
-}
@@ -372,6 +372,21 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 				},
 			},
 		},
+
+		"storage_account": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"recover_soft_deleted": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
 	}
 
 	// this is a temporary hack to enable us to gradually add provider blocks to test configurations
@@ -624,5 +639,15 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["storage_account"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			storageAccountRaw := items[0].(map[string]interface{})
+			if v, ok := storageAccountRaw["recover_soft_deleted"]; ok {
+				featuresMap.StorageAccount.RecoverSoftDeleted = v.(bool)
+			}
+		}
+	}
+
 	return featuresMap
 }
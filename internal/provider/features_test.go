@@ -88,6 +88,9 @@ func TestExpandFeatures(t *testing.T) {
 					VMBackupStopProtectionAndRetainDataOnDestroy: false,
 					PurgeProtectedItemsFromVaultOnDestroy:        false,
 				},
+				StorageAccount: features.StorageAccountFeatures{
+					RecoverSoftDeleted: false,
+				},
 			},
 		},
 		{
@@ -193,6 +196,11 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_protected_items_from_vault_on_destroy":          true,
 						},
 					},
+					"storage_account": []interface{}{
+						map[string]interface{}{
+							"recover_soft_deleted": true,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -263,6 +271,9 @@ func TestExpandFeatures(t *testing.T) {
 					VMBackupStopProtectionAndRetainDataOnDestroy: true,
 					PurgeProtectedItemsFromVaultOnDestroy:        true,
 				},
+				StorageAccount: features.StorageAccountFeatures{
+					RecoverSoftDeleted: true,
+				},
 			},
 		},
 		{
@@ -368,6 +379,11 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_protected_items_from_vault_on_destroy":          false,
 						},
 					},
+					"storage_account": []interface{}{
+						map[string]interface{}{
+							"recover_soft_deleted": false,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -438,6 +454,9 @@ func TestExpandFeatures(t *testing.T) {
 					VMBackupStopProtectionAndRetainDataOnDestroy: false,
 					PurgeProtectedItemsFromVaultOnDestroy:        false,
 				},
+				StorageAccount: features.StorageAccountFeatures{
+					RecoverSoftDeleted: false,
+				},
 			},
 		},
 	}
@@ -1678,3 +1697,68 @@ func TestExpandFeaturesRecoveryService(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesStorageAccount(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"storage_account": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				StorageAccount: features.StorageAccountFeatures{
+					RecoverSoftDeleted: false,
+				},
+			},
+		},
+		{
+			Name: "Recover Soft Deleted Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"storage_account": []interface{}{
+						map[string]interface{}{
+							"recover_soft_deleted": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				StorageAccount: features.StorageAccountFeatures{
+					RecoverSoftDeleted: true,
+				},
+			},
+		},
+		{
+			Name: "Recover Soft Deleted Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"storage_account": []interface{}{
+						map[string]interface{}{
+							"recover_soft_deleted": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				StorageAccount: features.StorageAccountFeatures{
+					RecoverSoftDeleted: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.StorageAccount, testCase.Expected.StorageAccount) {
+			t.Fatalf("Expected %+v but got %+v", result.StorageAccount, testCase.Expected.StorageAccount)
+		}
+	}
+}
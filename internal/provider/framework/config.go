@@ -5,7 +5,9 @@ package framework
 
 import (
 	"context"
+	"log"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
@@ -124,6 +126,39 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 	p.clientBuilder.PartnerID = partnerId
 	p.clientBuilder.DisableCorrelationRequestID = getEnvBoolOrDefault(data.DisableCorrelationRequestId, "ARM_DISABLE_CORRELATION_REQUEST_ID", false)
 	p.clientBuilder.DisableTerraformPartnerID = getEnvBoolOrDefault(data.DisableTerraformPartnerId, "ARM_DISABLE_TERRAFORM_PARTNER_ID", false)
+
+	maxConcurrentRequests := getEnvInt64OrDefault(data.MaxConcurrentRequests, "ARM_MAX_CONCURRENT_REQUESTS", 0)
+	if maxConcurrentRequests < 0 {
+		diags.Append(diag.NewErrorDiagnostic("validating max_concurrent_requests", "expected `max_concurrent_requests` to be at least 0"))
+		return
+	}
+	p.clientBuilder.MaxConcurrentRequests = int(maxConcurrentRequests)
+	maxRetryDuration := getEnvStringIfValueAbsent(data.MaxRetryDuration, "ARM_MAX_RETRY_DURATION")
+	if _, errs := provider.ValidateDuration(maxRetryDuration, "max_retry_duration"); len(errs) > 0 {
+		diags.Append(diag.NewErrorDiagnostic("validating max_retry_duration", errs[0].Error()))
+		return
+	}
+	if maxRetryDuration != "" {
+		// this has been validated above
+		p.clientBuilder.MaxRetryDuration, _ = time.ParseDuration(maxRetryDuration)
+	}
+
+	p.clientBuilder.MetadataCacheFile = getEnvStringIfValueAbsent(data.MetadataCacheFile, "ARM_METADATA_CACHE_FILE")
+	metadataCacheTTL := getEnvStringIfValueAbsent(data.MetadataCacheTTL, "ARM_METADATA_CACHE_TTL")
+	if _, errs := provider.ValidateDuration(metadataCacheTTL, "metadata_cache_ttl"); len(errs) > 0 {
+		diags.Append(diag.NewErrorDiagnostic("validating metadata_cache_ttl", errs[0].Error()))
+		return
+	}
+	if metadataCacheTTL != "" {
+		// this has been validated above
+		p.clientBuilder.MetadataCacheTTL, _ = time.ParseDuration(metadataCacheTTL)
+	}
+
+	// getEnvBoolOrDefault treats an unset Environment Variable as `true`, which must not be the case here
+	p.clientBuilder.ReadOnly = strings.EqualFold(os.Getenv("ARM_READ_ONLY"), "true") || os.Getenv("ARM_READ_ONLY") == "1"
+	if !data.ReadOnly.IsNull() && !data.ReadOnly.IsUnknown() {
+		p.clientBuilder.ReadOnly = data.ReadOnly.ValueBool()
+	}
 	p.clientBuilder.StorageUseAzureAD = getEnvBoolOrDefault(data.StorageUseAzureAD, "ARM_STORAGE_USE_AZUREAD", false)
 
 	f := providerfeatures.UserFeatures{}
@@ -468,9 +503,82 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 			f.RecoveryService.VMBackupStopProtectionAndRetainDataOnDestroy = false
 			f.RecoveryService.PurgeProtectedItemsFromVaultOnDestroy = false
 		}
+
+		if !features.StorageAccount.IsNull() && !features.StorageAccount.IsUnknown() {
+			var feature []StorageAccount
+			d := features.StorageAccount.ElementsAs(ctx, &feature, true)
+			diags.Append(d...)
+			if diags.HasError() {
+				return
+			}
+
+			f.StorageAccount.RecoverSoftDeleted = false
+			if !feature[0].RecoverSoftDeleted.IsNull() && !feature[0].RecoverSoftDeleted.IsUnknown() {
+				f.StorageAccount.RecoverSoftDeleted = feature[0].RecoverSoftDeleted.ValueBool()
+			}
+		} else {
+			f.StorageAccount.RecoverSoftDeleted = false
+		}
 	}
 
 	p.clientBuilder.Features = f
+
+	if !data.DefaultTags.IsNull() && !data.DefaultTags.IsUnknown() {
+		var defaultTags []DefaultTags
+		diags.Append(data.DefaultTags.ElementsAs(ctx, &defaultTags, true)...)
+		if diags.HasError() {
+			return
+		}
+
+		tags := make(map[string]string)
+		if len(defaultTags) > 0 && !defaultTags[0].Tags.IsNull() && !defaultTags[0].Tags.IsUnknown() {
+			diags.Append(defaultTags[0].Tags.ElementsAs(ctx, &tags, false)...)
+			if diags.HasError() {
+				return
+			}
+		}
+		p.clientBuilder.TagDefaults.DefaultTags = tags
+	}
+
+	if !data.IgnoreTags.IsNull() && !data.IgnoreTags.IsUnknown() {
+		var ignoreTags []IgnoreTags
+		diags.Append(data.IgnoreTags.ElementsAs(ctx, &ignoreTags, true)...)
+		if diags.HasError() {
+			return
+		}
+
+		keys := make([]string, 0)
+		keyPrefixes := make([]string, 0)
+		if len(ignoreTags) > 0 {
+			if !ignoreTags[0].Keys.IsNull() && !ignoreTags[0].Keys.IsUnknown() {
+				diags.Append(ignoreTags[0].Keys.ElementsAs(ctx, &keys, false)...)
+			}
+			if !ignoreTags[0].KeyPrefixes.IsNull() && !ignoreTags[0].KeyPrefixes.IsUnknown() {
+				diags.Append(ignoreTags[0].KeyPrefixes.ElementsAs(ctx, &keyPrefixes, false)...)
+			}
+			if diags.HasError() {
+				return
+			}
+		}
+		p.clientBuilder.TagDefaults.IgnoreKeys = keys
+		p.clientBuilder.TagDefaults.IgnoreKeyPrefixes = keyPrefixes
+	}
+
+	if !data.DefaultTimeouts.IsNull() && !data.DefaultTimeouts.IsUnknown() {
+		var defaultTimeouts []DefaultTimeouts
+		diags.Append(data.DefaultTimeouts.ElementsAs(ctx, &defaultTimeouts, true)...)
+		if diags.HasError() {
+			return
+		}
+
+		timeoutDefaults, err := expandDefaultTimeouts(defaultTimeouts)
+		if err != nil {
+			diags.Append(diag.NewErrorDiagnostic("parsing `default_timeouts`", err.Error()))
+			return
+		}
+		p.clientBuilder.TimeoutDefaults = *timeoutDefaults
+	}
+
 	p.clientBuilder.AuthConfig = authConfig
 	p.clientBuilder.CustomCorrelationRequestID = os.Getenv("ARM_CORRELATION_REQUEST_ID")
 	p.clientBuilder.TerraformVersion = tfVersion
@@ -521,6 +629,12 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 	ctx2, cancel := context.WithTimeout(ctx, 30*time.Minute)
 	defer cancel()
 
+	if p.clientBuilder.ReadOnly && len(requiredResourceProviders) > 0 {
+		// registering a Resource Provider is a write operation, so is skipped when the Provider is read-only
+		log.Printf("[DEBUG] Skipping the registration of Resource Providers since the Provider is configured to be read-only")
+		requiredResourceProviders = make(resourceproviders.ResourceProviders)
+	}
+
 	if err = resourceproviders.EnsureRegistered(ctx2, client.Resource.ResourceProvidersClient, subId, requiredResourceProviders); err != nil {
 		diags.AddError("registering resource providers", err.Error())
 		return
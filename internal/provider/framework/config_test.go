@@ -200,6 +200,10 @@ func TestProviderConfig_LoadDefault(t *testing.T) {
 	if features.RecoveryService.PurgeProtectedItemsFromVaultOnDestroy {
 		t.Errorf("expected recovery_service.PurgeProtectedItemsFromVaultOnDestroy to be false")
 	}
+
+	if features.StorageAccount.RecoverSoftDeleted {
+		t.Errorf("expected storage_account.recover_soft_deleted to be false")
+	}
 }
 
 // TODO - helper functions to make setting up test date more easily so we can add more configuration coverage
@@ -302,6 +306,11 @@ func defaultFeaturesList() types.List {
 	})
 	recoveryServicesVaultsList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(RecoveryServiceVaultsAttributes), []attr.Value{recoveryServicesVaults})
 
+	storageAccount, _ := basetypes.NewObjectValueFrom(context.Background(), StorageAccountAttributes, map[string]attr.Value{
+		"recover_soft_deleted": basetypes.NewBoolNull(),
+	})
+	storageAccountList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(StorageAccountAttributes), []attr.Value{storageAccount})
+
 	fData, d := basetypes.NewObjectValue(FeaturesAttributes, map[string]attr.Value{
 		"api_management":             apiManagementList,
 		"app_configuration":          appConfigurationList,
@@ -319,6 +328,7 @@ func defaultFeaturesList() types.List {
 		"machine_learning":           machineLearningList,
 		"recovery_service":           recoveryServicesList,
 		"recovery_services_vaults":   recoveryServicesVaultsList,
+		"storage_account":            storageAccountList,
 	})
 
 	fmt.Printf("%+v", d)
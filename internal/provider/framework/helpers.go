@@ -8,9 +8,12 @@ import (
 	"encoding/base64"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
 func decodeCertificate(clientCertificate string) ([]byte, error) {
@@ -172,6 +175,19 @@ func getEnvBoolOrDefault(val types.Bool, envVar string, def bool) bool {
 	return val.ValueBool()
 }
 
+// getEnvInt64OrDefault returns the value of the Int64Value if this is not Null / Unknown, otherwise the value of the
+// Environment Variable `envVar` if this is set to a valid integer - falling back to `def` in all other cases.
+func getEnvInt64OrDefault(val types.Int64, envVar string, def int64) int64 {
+	if val.IsNull() || val.IsUnknown() {
+		if v, err := strconv.ParseInt(os.Getenv(envVar), 10, 64); err == nil {
+			return v
+		}
+		return def
+	}
+
+	return val.ValueInt64()
+}
+
 // getEnvListOfStringsIfAbsent returns a []string for the types.List, or the contents of the supplied Environment
 // Variable `envVar` if set. If the separator is an empty string, then "," will be used as a default.
 func getEnvListOfStringsIfAbsent(val types.List, envVar string, separator string) []string {
@@ -191,3 +207,62 @@ func getEnvListOfStringsIfAbsent(val types.List, envVar string, separator string
 
 	return result
 }
+
+// expandDefaultTimeouts builds the default timeouts from the `default_timeouts` blocks, validating that at most one
+// block exists without a `resource_type` and at most one block exists for each resource type
+func expandDefaultTimeouts(input []DefaultTimeouts) (*timeouts.ProviderDefaults, error) {
+	output := timeouts.ProviderDefaults{
+		ResourceTypes: make(map[string]timeouts.Defaults),
+	}
+
+	hasGlobal := false
+	for _, v := range input {
+		parse := func(operation string, value types.String) (*time.Duration, error) {
+			if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+				return nil, nil
+			}
+
+			duration, err := time.ParseDuration(value.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("parsing the `%s` timeout %q within the `default_timeouts` block: %+v", operation, value.ValueString(), err)
+			}
+			if duration <= 0 {
+				return nil, fmt.Errorf("the `%s` timeout within the `default_timeouts` block must be a positive duration but got %q", operation, value.ValueString())
+			}
+
+			return &duration, nil
+		}
+
+		var defaults timeouts.Defaults
+		var err error
+		if defaults.Create, err = parse("create", v.Create); err != nil {
+			return nil, err
+		}
+		if defaults.Read, err = parse("read", v.Read); err != nil {
+			return nil, err
+		}
+		if defaults.Update, err = parse("update", v.Update); err != nil {
+			return nil, err
+		}
+		if defaults.Delete, err = parse("delete", v.Delete); err != nil {
+			return nil, err
+		}
+
+		resourceType := v.ResourceType.ValueString()
+		if resourceType == "" {
+			if hasGlobal {
+				return nil, fmt.Errorf("only one `default_timeouts` block can omit `resource_type`")
+			}
+			hasGlobal = true
+			output.Global = defaults
+			continue
+		}
+
+		if _, ok := output.ResourceTypes[resourceType]; ok {
+			return nil, fmt.Errorf("only one `default_timeouts` block can be specified for the resource type %q", resourceType)
+		}
+		output.ResourceTypes[resourceType] = defaults
+	}
+
+	return &output, nil
+}
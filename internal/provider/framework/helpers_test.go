@@ -7,6 +7,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -300,3 +301,42 @@ func Test_decodeCertificateExpectError(t *testing.T) {
 		t.Fatalf("did not get expected error, got '%v'", err)
 	}
 }
+
+func Test_expandDefaultTimeouts(t *testing.T) {
+	block := func(resourceType, create string) DefaultTimeouts {
+		return DefaultTimeouts{
+			ResourceType: basetypes.NewStringValue(resourceType),
+			Create:       basetypes.NewStringValue(create),
+			Read:         basetypes.NewStringNull(),
+			Update:       basetypes.NewStringNull(),
+			Delete:       basetypes.NewStringNull(),
+		}
+	}
+
+	actual, err := expandDefaultTimeouts([]DefaultTimeouts{
+		block("", "1h"),
+		block("azurerm_linux_virtual_machine", "90m"),
+	})
+	if err != nil {
+		t.Fatalf("expanding: %+v", err)
+	}
+	if actual.Global.Create == nil || *actual.Global.Create != time.Hour || actual.Global.Read != nil {
+		t.Fatalf("unexpected global defaults %+v", actual.Global)
+	}
+	if v := actual.ResourceTypes["azurerm_linux_virtual_machine"].Create; v == nil || *v != 90*time.Minute {
+		t.Fatalf("unexpected defaults for `azurerm_linux_virtual_machine`: %+v", actual.ResourceTypes["azurerm_linux_virtual_machine"])
+	}
+
+	invalid := map[string][]DefaultTimeouts{
+		"Multiple Global":       {block("", "1h"), block("", "2h")},
+		"Duplicate Type":        {block("azurerm_linux_virtual_machine", "1h"), block("azurerm_linux_virtual_machine", "2h")},
+		"Invalid Duration":      {block("", "an hour")},
+		"Non-Positive Duration": {block("", "0s")},
+	}
+	for name, input := range invalid {
+		t.Logf("[DEBUG] Testing %q", name)
+		if _, err := expandDefaultTimeouts(input); err == nil {
+			t.Fatalf("expected an error for %q", name)
+		}
+	}
+}
@@ -33,13 +33,55 @@ type ProviderModel struct {
 	PartnerId                     types.String `tfsdk:"partner_id"`
 	DisableCorrelationRequestId   types.Bool   `tfsdk:"disable_correlation_request_id"`
 	DisableTerraformPartnerId     types.Bool   `tfsdk:"disable_terraform_partner_id"`
+	MaxConcurrentRequests         types.Int64  `tfsdk:"max_concurrent_requests"`
+	MaxRetryDuration              types.String `tfsdk:"max_retry_duration"`
+	MetadataCacheFile             types.String `tfsdk:"metadata_cache_file"`
+	MetadataCacheTTL              types.String `tfsdk:"metadata_cache_ttl"`
+	ReadOnly                      types.Bool   `tfsdk:"read_only"`
 	StorageUseAzureAD             types.Bool   `tfsdk:"storage_use_azuread"`
 	Features                      types.List   `tfsdk:"features"`
+	DefaultTags                   types.List   `tfsdk:"default_tags"`
+	IgnoreTags                    types.List   `tfsdk:"ignore_tags"`
+	DefaultTimeouts               types.List   `tfsdk:"default_timeouts"`
 	SkipProviderRegistration      types.Bool   `tfsdk:"skip_provider_registration"` // TODO - Remove in 5.0
 	ResourceProviderRegistrations types.String `tfsdk:"resource_provider_registrations"`
 	ResourceProvidersToRegister   types.List   `tfsdk:"resource_providers_to_register"`
 }
 
+type DefaultTags struct {
+	Tags types.Map `tfsdk:"tags"`
+}
+
+var DefaultTagsAttributes = map[string]attr.Type{
+	"tags": types.MapType{}.WithElementType(types.StringType),
+}
+
+type IgnoreTags struct {
+	Keys        types.Set `tfsdk:"keys"`
+	KeyPrefixes types.Set `tfsdk:"key_prefixes"`
+}
+
+var IgnoreTagsAttributes = map[string]attr.Type{
+	"keys":         types.SetType{}.WithElementType(types.StringType),
+	"key_prefixes": types.SetType{}.WithElementType(types.StringType),
+}
+
+type DefaultTimeouts struct {
+	ResourceType types.String `tfsdk:"resource_type"`
+	Create       types.String `tfsdk:"create"`
+	Read         types.String `tfsdk:"read"`
+	Update       types.String `tfsdk:"update"`
+	Delete       types.String `tfsdk:"delete"`
+}
+
+var DefaultTimeoutsAttributes = map[string]attr.Type{
+	"resource_type": types.StringType,
+	"create":        types.StringType,
+	"read":          types.StringType,
+	"update":        types.StringType,
+	"delete":        types.StringType,
+}
+
 type Features struct {
 	APIManagement            types.List `tfsdk:"api_management"`
 	AppConfiguration         types.List `tfsdk:"app_configuration"`
@@ -57,6 +99,7 @@ type Features struct {
 	MachineLearning          types.List `tfsdk:"machine_learning"`
 	RecoveryService          types.List `tfsdk:"recovery_service"`
 	RecoveryServicesVaults   types.List `tfsdk:"recovery_services_vaults"`
+	StorageAccount           types.List `tfsdk:"storage_account"`
 }
 
 // FeaturesAttributes and the other block attribute vars are required for unit testing on the Load func
@@ -78,6 +121,7 @@ var FeaturesAttributes = map[string]attr.Type{
 	"machine_learning":           types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(MachineLearningAttributes)),
 	"recovery_service":           types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(RecoveryServiceAttributes)),
 	"recovery_services_vaults":   types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(RecoveryServiceVaultsAttributes)),
+	"storage_account":            types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(StorageAccountAttributes)),
 }
 
 type APIManagement struct {
@@ -245,3 +289,11 @@ type RecoveryServiceVaults struct {
 var RecoveryServiceVaultsAttributes = map[string]attr.Type{
 	"recover_soft_deleted_backup_protected_vm": types.BoolType,
 }
+
+type StorageAccount struct {
+	RecoverSoftDeleted types.Bool `tfsdk:"recover_soft_deleted"`
+}
+
+var StorageAccountAttributes = map[string]attr.Type{
+	"recover_soft_deleted": types.BoolType,
+}
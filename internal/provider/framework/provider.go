@@ -5,19 +5,25 @@ package framework
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	azurermprovider "github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	providerfunction "github.com/hashicorp/terraform-provider-azurerm/internal/provider/function"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 )
 
 type azureRmFrameworkProvider struct {
@@ -31,6 +37,7 @@ var _ provider.ProviderWithFunctions = &azureRmFrameworkProvider{}
 
 func (p *azureRmFrameworkProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
+		providerfunction.NewBuildResourceIDFunction,
 		providerfunction.NewNormaliseResourceIDFunction,
 		providerfunction.NewParseResourceIDFunction,
 	}
@@ -183,6 +190,31 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 				Description: "This will disable the Terraform Partner ID which is used if a custom `partner_id` isn't specified.",
 			},
 
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of concurrent requests sent to each Resource Provider within a Subscription. Defaults to `0`, meaning that this isn't limited.",
+			},
+
+			"max_retry_duration": schema.StringAttribute{
+				Optional:    true,
+				Description: "The maximum length of time (for example `5m`) that a request which has been throttled by Azure Resource Manager is retried for. Defaults to `5m`.",
+			},
+
+			"metadata_cache_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path to a file used to cache the Resource Providers available within the Subscription (and the Locations available within the Azure Environment) between runs. By default these aren't cached.",
+			},
+
+			"metadata_cache_ttl": schema.StringAttribute{
+				Optional:    true,
+				Description: "The length of time (for example `30m`) that an entry within the `metadata_cache_file` is used for before it's refreshed. Defaults to `1h`.",
+			},
+
+			"read_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Should the AzureRM Provider refuse to send any request which could modify a resource (for example when running `terraform plan`)?",
+			},
+
 			// Advanced feature flags
 			"skip_provider_registration": schema.BoolAttribute{
 				Optional:           true,
@@ -224,6 +256,79 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 		},
 
 		Blocks: map[string]schema.Block{
+			"default_tags": schema.ListNestedBlock{
+				Description: "A set of tags which should be applied to all resources which support tags.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tags": schema.MapAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.Map{
+								frameworkhelpers.WrappedMapValidator{
+									Func:         tags.Validate,
+									Desc:         "Validate checks that the number of tags and the length of each tag key and value are within the limits supported by Azure.",
+									MarkdownDesc: "Validate checks that the number of tags and the length of each tag key and value are within the limits supported by Azure.",
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"ignore_tags": schema.ListNestedBlock{
+				Description: "A set of tag keys and tag key prefixes which should be ignored when reading the tags of all resources.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Set{
+								setvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("key_prefixes")),
+								setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+							},
+						},
+
+						"key_prefixes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Set{
+								setvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("keys")),
+								setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+							},
+						},
+					},
+				},
+			},
+
+			"default_timeouts": schema.ListNestedBlock{
+				Description: "The default timeouts used for resources which don't specify a `timeouts` block, either for all resources or for a specific resource type.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_type": schema.StringAttribute{
+							Optional:    true,
+							Description: "The resource type (for example `azurerm_linux_virtual_machine`) these timeouts apply to. When omitted these timeouts apply to all resources.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+
+						"create": defaultTimeoutAttribute("create"),
+
+						"read": defaultTimeoutAttribute("read"),
+
+						"update": defaultTimeoutAttribute("update"),
+
+						"delete": defaultTimeoutAttribute("delete"),
+					},
+				},
+			},
+
 			"features": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeBetween(1, 1),
@@ -454,6 +559,15 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 								},
 							},
 						},
+						"storage_account": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"recover_soft_deleted": schema.BoolAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -477,17 +591,69 @@ func (p *azureRmFrameworkProvider) Configure(ctx context.Context, request provid
 	} else {
 		p.Load(ctx, &data, request.TerraformVersion, &response.Diagnostics)
 
-		response.DataSourceData = &p.ProviderConfig
-		response.ResourceData = &p.ProviderConfig
+		response.DataSourceData = p.ProviderConfig.Client
+		response.ResourceData = p.ProviderConfig.Client
 	}
 }
 
 func (p *azureRmFrameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	// We do not currently support any Native framework Data Sources
-	return nil
+	dataSources := make([]func() datasource.DataSource, 0)
+	registered := make(map[string]struct{})
+
+	for _, service := range azurermprovider.SupportedTypedServices() {
+		v, ok := service.(sdk.FrameworkTypedServiceRegistration)
+		if !ok {
+			continue
+		}
+
+		for _, ds := range v.FrameworkDataSources() {
+			key := ds.ResourceType()
+			if _, exists := registered[key]; exists {
+				panic(fmt.Sprintf("An existing Framework Data Source exists for %q", key))
+			}
+			registered[key] = struct{}{}
+
+			dataSources = append(dataSources, sdk.NewFrameworkDataSourceWrapper(ds))
+		}
+	}
+
+	return dataSources
 }
 
 func (p *azureRmFrameworkProvider) Resources(_ context.Context) []func() resource.Resource {
-	// We do not currently support any Native framework Resources
-	return nil
+	resources := make([]func() resource.Resource, 0)
+	registered := make(map[string]struct{})
+
+	for _, service := range azurermprovider.SupportedTypedServices() {
+		v, ok := service.(sdk.FrameworkTypedServiceRegistration)
+		if !ok {
+			continue
+		}
+
+		for _, r := range v.FrameworkResources() {
+			key := r.ResourceType()
+			if _, exists := registered[key]; exists {
+				panic(fmt.Sprintf("An existing Framework Resource exists for %q", key))
+			}
+			registered[key] = struct{}{}
+
+			resources = append(resources, sdk.NewFrameworkResourceWrapper(r))
+		}
+	}
+
+	return resources
+}
+
+func defaultTimeoutAttribute(operation string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: fmt.Sprintf("The default length of time (for example `90m`) allowed to %s a resource.", operation),
+		Validators: []validator.String{
+			frameworkhelpers.WrappedStringValidator{
+				Func:         azurermprovider.ValidateDuration,
+				Desc:         "ValidateDuration checks that a timeout within the default_timeouts block is a valid duration",
+				MarkdownDesc: "ValidateDuration checks that a timeout within the default_timeouts block is a valid duration",
+			},
+		},
+	}
 }
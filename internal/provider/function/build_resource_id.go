@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type BuildResourceIDFunction struct{}
+
+var _ function.Function = BuildResourceIDFunction{}
+
+func NewBuildResourceIDFunction() function.Function {
+	return &BuildResourceIDFunction{}
+}
+
+func (b BuildResourceIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "build_resource_id"
+}
+
+func (b BuildResourceIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "build_resource_id",
+		Description:         "Builds an Azure Resource Manager ID from a resource type and the user specified segments, the inverse of `parse_resource_id`",
+		MarkdownDescription: "Builds an Azure Resource Manager ID from a resource type and the user specified segments, the inverse of `parse_resource_id`",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "resource_type",
+				Description:         "Full Resource Type, e.g. `Microsoft.ApiManagement/service/gateways`",
+				MarkdownDescription: "Full Resource Type, e.g. `Microsoft.ApiManagement/service/gateways`",
+			},
+			function.MapParameter{
+				Name:                "parts",
+				ElementType:         types.StringType,
+				Description:         "The user specified segments of the Resource ID, using the same keys as the output of `parse_resource_id`",
+				MarkdownDescription: "The user specified segments of the Resource ID, using the same keys as the output of `parse_resource_id`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (b BuildResourceIDFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var resourceType string
+	var parts map[string]string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &resourceType, &parts))
+
+	if response.Error != nil {
+		return
+	}
+
+	if len(resourceType) == 0 {
+		response.Error = function.NewFuncError("Got empty resource type")
+		return
+	}
+
+	candidates := resourceIdTypesForFullResourceType(resourceType)
+	if len(candidates) == 0 {
+		response.Error = function.NewFuncError(fmt.Sprintf("could not find a resource ID type for %q, the resource type may be malformed or currently not supported in the provider", resourceType))
+		return
+	}
+
+	var idType resourceids.ResourceId
+	var partErrors []string
+	for _, candidate := range candidates {
+		missing, unexpected := comparePartsToSegments(candidate, parts)
+		if len(missing) == 0 && len(unexpected) == 0 {
+			idType = candidate
+			break
+		}
+
+		message := fmt.Sprintf("expected the parts [%s]", strings.Join(quoteAll(partNamesForSegments(candidate.Segments())), ", "))
+		if len(missing) > 0 {
+			message += fmt.Sprintf(", missing [%s]", strings.Join(quoteAll(missing), ", "))
+		}
+		if len(unexpected) > 0 {
+			message += fmt.Sprintf(", unexpected [%s]", strings.Join(quoteAll(unexpected), ", "))
+		}
+		partErrors = append(partErrors, message)
+	}
+
+	if idType == nil {
+		response.Error = function.NewFuncError(fmt.Sprintf("building Resource ID for %q: %s", resourceType, strings.Join(partErrors, ", or ")))
+		return
+	}
+
+	parsed := resourceids.ParseResult{
+		Parsed: map[string]string{},
+	}
+	segments := idType.Segments()
+	for k, v := range segments {
+		switch v.Type {
+		case resourceids.StaticSegmentType, resourceids.ResourceProviderSegmentType:
+			parsed.Parsed[v.Name] = pointer.From(v.FixedValue)
+
+		default:
+			parsed.Parsed[v.Name] = parts[partNameForSegment(segments, k)]
+		}
+	}
+
+	if err := idType.FromParseResult(parsed); err != nil {
+		response.Error = function.NewFuncError(fmt.Sprintf("Building Resource ID Error: %s", err))
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, types.StringValue(idType.ID())))
+}
+
+// resourceIdTypesForFullResourceType returns new instances of each of the registered Resource ID types matching
+// the specified full resource type (as output from `parse_resource_id`), sorted to keep the results deterministic
+func resourceIdTypesForFullResourceType(resourceType string) []resourceids.ResourceId {
+	keys := make([]string, 0)
+	for k, v := range recaser.KnownResourceIds() {
+		if strings.EqualFold(fullResourceTypeForSegments(v.Segments()), resourceType) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	output := make([]resourceids.ResourceId, 0, len(keys))
+	for _, k := range keys {
+		id := recaser.KnownResourceIds()[k]
+		output = append(output, reflect.New(reflect.TypeOf(id).Elem()).Interface().(resourceids.ResourceId))
+	}
+
+	return output
+}
+
+// fullResourceTypeForSegments returns the full resource type for the segments of a Resource ID, matching the
+// `full_resource_type` output from `parse_resource_id`
+func fullResourceTypeForSegments(segments []resourceids.Segment) string {
+	fullResourceType := ""
+	for k, v := range segments {
+		switch v.Type {
+		case resourceids.ResourceProviderSegmentType:
+			fullResourceType = pointer.From(v.FixedValue)
+
+		case resourceids.StaticSegmentType:
+			value := pointer.From(v.FixedValue)
+			if k == len(segments)-2 || (value != "subscriptions" && value != "resourceGroups" && value != "providers") {
+				fullResourceType = fmt.Sprintf("%s/%s", fullResourceType, value)
+			}
+		}
+	}
+
+	return fullResourceType
+}
+
+// partNameForSegment returns the key within `parts` for the user specified segment at the specified index,
+// matching the output from `parse_resource_id` - where parent resources are keyed by their resource type
+func partNameForSegment(segments []resourceids.Segment, index int) string {
+	switch segments[index].Type {
+	case resourceids.SubscriptionIdSegmentType:
+		return "subscription_id"
+
+	case resourceids.ResourceGroupSegmentType:
+		return "resource_group_name"
+
+	case resourceids.ScopeSegmentType:
+		return "resource_scope"
+	}
+
+	if index > 0 && index < len(segments)-1 {
+		return pointer.From(segments[index-1].FixedValue)
+	}
+
+	return "resource_name"
+}
+
+func partNamesForSegments(segments []resourceids.Segment) []string {
+	output := make([]string, 0)
+	for k, v := range segments {
+		if v.Type == resourceids.StaticSegmentType || v.Type == resourceids.ResourceProviderSegmentType {
+			continue
+		}
+		output = append(output, partNameForSegment(segments, k))
+	}
+
+	return output
+}
+
+// comparePartsToSegments returns the parts required by the Resource ID which haven't been specified, and the
+// parts which have been specified but aren't used by the Resource ID
+func comparePartsToSegments(id resourceids.ResourceId, parts map[string]string) (missing []string, unexpected []string) {
+	expected := make(map[string]struct{})
+	for _, v := range partNamesForSegments(id.Segments()) {
+		expected[v] = struct{}{}
+		if value, ok := parts[v]; !ok || value == "" {
+			missing = append(missing, v)
+		}
+	}
+
+	for k := range parts {
+		if _, ok := expected[k]; !ok {
+			unexpected = append(unexpected, k)
+		}
+	}
+	sort.Strings(unexpected)
+
+	return missing, unexpected
+}
+
+func quoteAll(input []string) []string {
+	output := make([]string, 0, len(input))
+	for _, v := range input {
+		output = append(output, fmt.Sprintf("%q", v))
+	}
+
+	return output
+}
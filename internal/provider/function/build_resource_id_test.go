@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionBuildResourceID_basic(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testBuildResourceIdOutput("microsoft.apimanagement/SERVICE/gateways/hostnameConfigurations", `
+    subscription_id     = "12345678-1234-9876-4563-123456789012"
+    resource_group_name = "resGroup1"
+    service             = "service1"
+    gateways            = "gateway1"
+    resource_name       = "config1"
+`),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("id", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/gateways/gateway1/hostnameConfigurations/config1"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionBuildResourceID_scoped(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testBuildResourceIdOutput("Microsoft.Chaos/targets", `
+    resource_scope = "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1"
+    resource_name  = "target1"
+`),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("id", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Chaos/targets/target1"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionBuildResourceID_roundTrip(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	id := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/gateways/gateway1/hostnameConfigurations/config1"
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testBuildResourceIdRoundTripOutput(id),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("id", id),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionBuildResourceID_unknownResourceType(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testBuildResourceIdOutput("Microsoft.Made/up", `
+    resource_name = "example"
+`),
+				ExpectError: regexp.MustCompile("could not find a resource ID type"),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionBuildResourceID_missingSegment(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testBuildResourceIdOutput("Microsoft.ApiManagement/service/gateways", `
+    subscription_id     = "12345678-1234-9876-4563-123456789012"
+    resource_group_name = "resGroup1"
+    resource_name       = "gateway1"
+`),
+				ExpectError: regexp.MustCompile(`missing \["service"\]`),
+			},
+		},
+	})
+}
+
+func testBuildResourceIdOutput(resourceType, parts string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+output "id" {
+  value = provider::azurerm::build_resource_id("%s", {
+%s
+  })
+}
+`, resourceType, parts)
+}
+
+func testBuildResourceIdRoundTripOutput(id string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+locals {
+  parsed_id = provider::azurerm::parse_resource_id("%s")
+}
+
+output "id" {
+  value = provider::azurerm::build_resource_id(local.parsed_id["full_resource_type"], merge(local.parsed_id["parent_resources"], {
+    subscription_id     = local.parsed_id["subscription_id"]
+    resource_group_name = local.parsed_id["resource_group_name"]
+    resource_name       = local.parsed_id["resource_name"]
+  }))
+}
+`, id)
+}
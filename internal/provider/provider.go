@@ -6,6 +6,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"log"
 	"os"
 	"strings"
 	"time"
@@ -17,9 +18,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -80,6 +83,28 @@ func ValidatePartnerID(i interface{}, k string) ([]string, []error) {
 	}
 }
 
+// ValidateDuration checks that a provider argument such as max_retry_duration is a valid, positive duration (e.g. `5m`)
+func ValidateDuration(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if v == "" {
+		return nil, nil
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		return nil, []error{fmt.Errorf("expected %q to be a valid duration (for example `5m`) but got %q", k, v)}
+	}
+	if duration <= 0 {
+		return nil, []error{fmt.Errorf("expected %q to be a positive duration but got %q", k, v)}
+	}
+
+	return nil, nil
+}
+
 func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 	dataSources := make(map[string]*schema.Resource)
 	resources := make(map[string]*schema.Resource)
@@ -139,6 +164,32 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		}
 	}
 
+	// apply the Provider `default_tags` and `ignore_tags` to every taggable resource, which also exposes `tags_all`
+	for _, resource := range resources {
+		sdk.EnableTagsAll(resource)
+	}
+
+	// locations are validated against those cached by the Provider, rather than those cached by go-azure-helpers
+	for _, resource := range resources {
+		sdk.EnableEnhancedLocationValidation(resource)
+	}
+	for _, dataSource := range dataSources {
+		sdk.EnableEnhancedLocationValidation(dataSource)
+	}
+
+	// the Resource Type of each untyped resource is used to validate that it's available in the specified location
+	for resourceType, azureResourceType := range untypedResourceAzureResourceTypes {
+		sdk.EnableResourceTypeLocationValidation(resources[resourceType], azureResourceType)
+	}
+
+	// each operation sends its own (child) correlation request ID, so that the requests for it can be identified
+	for resourceType, resource := range resources {
+		sdk.EnableOperationCorrelation(resourceType, resource)
+	}
+	for dataSourceType, dataSource := range dataSources {
+		sdk.EnableOperationCorrelation(dataSourceType, dataSource)
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -319,8 +370,52 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "This will disable the Terraform Partner ID which is used if a custom `partner_id` isn't specified.",
 			},
 
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of concurrent requests sent to each Resource Provider within a Subscription. Defaults to `0`, meaning that this isn't limited.",
+			},
+
+			"max_retry_duration": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_RETRY_DURATION", ""),
+				ValidateFunc: ValidateDuration,
+				Description:  "The maximum length of time (for example `5m`) that a request which has been throttled by Azure Resource Manager is retried for. Defaults to `5m`.",
+			},
+
+			"metadata_cache_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_METADATA_CACHE_FILE", ""),
+				Description: "The path to a file used to cache the Resource Providers available within the Subscription (and the Locations available within the Azure Environment) between runs. By default these aren't cached.",
+			},
+
+			"metadata_cache_ttl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_METADATA_CACHE_TTL", ""),
+				ValidateFunc: ValidateDuration,
+				Description:  "The length of time (for example `30m`) that an entry within the `metadata_cache_file` is used for before it's refreshed. Defaults to `1h`.",
+			},
+
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_READ_ONLY", false),
+				Description: "Should the AzureRM Provider refuse to send any request which could modify a resource (for example when running `terraform plan`)?",
+			},
+
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"default_tags": schemaDefaultTags(),
+
+			"ignore_tags": schemaIgnoreTags(),
+
+			"default_timeouts": schemaDefaultTimeouts(),
+
 			// Advanced feature flags
 			"resource_provider_registrations": {
 				Type:        schema.TypeString,
@@ -516,17 +611,49 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 		requiredResourceProviders.Merge(additionalProvidersToRegister)
 	}
 
+	var maxRetryDuration time.Duration
+	if v := d.Get("max_retry_duration").(string); v != "" {
+		maxRetryDuration, err = time.ParseDuration(v)
+		if err != nil {
+			return nil, diag.Errorf("parsing `max_retry_duration` %q: %+v", v, err)
+		}
+	}
+
+	var metadataCacheTTL time.Duration
+	if v := d.Get("metadata_cache_ttl").(string); v != "" {
+		metadataCacheTTL, err = time.ParseDuration(v)
+		if err != nil {
+			return nil, diag.Errorf("parsing `metadata_cache_ttl` %q: %+v", v, err)
+		}
+	}
+
+	timeoutDefaults, err := expandTimeoutDefaults(d.Get("default_timeouts").([]interface{}), p.ResourcesMap)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	// the default timeouts are resolved by Terraform when planning each Plugin SDK resource, so these must be applied
+	// up-front - whereas the Plugin Framework resources resolve these from the client when they're used
+	timeouts.ApplyProviderDefaults(p.ResourcesMap, *timeoutDefaults)
+
 	clientBuilder := clients.ClientBuilder{
 		AuthConfig:                  authConfig,
 		DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
 		DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
 		Features:                    expandFeatures(d.Get("features").([]interface{})),
+		HTTPInterceptor:             common.HTTPInterceptorFromContext(ctx),
+		MaxConcurrentRequests:       d.Get("max_concurrent_requests").(int),
+		MaxRetryDuration:            maxRetryDuration,
+		MetadataCacheFile:           d.Get("metadata_cache_file").(string),
+		MetadataCacheTTL:            metadataCacheTTL,
 		MetadataHost:                d.Get("metadata_host").(string),
 		PartnerID:                   d.Get("partner_id").(string),
+		ReadOnly:                    d.Get("read_only").(bool),
 		RegisteredResourceProviders: requiredResourceProviders,
 		StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
 		SubscriptionID:              d.Get("subscription_id").(string),
+		TagDefaults:                 expandTagDefaults(d.Get("default_tags").([]interface{}), d.Get("ignore_tags").([]interface{})),
 		TerraformVersion:            p.TerraformVersion,
+		TimeoutDefaults:             *timeoutDefaults,
 
 		// this field is intentionally not exposed in the provider block, since it's only used for
 		// platform level tracing
@@ -551,6 +678,12 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 	ctx2, cancel := context.WithTimeout(ctx, 30*time.Minute)
 	defer cancel()
 
+	if clientBuilder.ReadOnly && len(requiredResourceProviders) > 0 {
+		// registering a Resource Provider is a write operation, so is skipped when the Provider is read-only
+		log.Printf("[DEBUG] Skipping the registration of Resource Providers since the Provider is configured to be read-only")
+		requiredResourceProviders = make(resourceproviders.ResourceProviders)
+	}
+
 	if err = resourceproviders.EnsureRegistered(ctx2, client.Resource.ResourceProvidersClient, subscriptionId, requiredResourceProviders); err != nil {
 		return nil, diag.FromErr(err)
 
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// untypedResourceAzureResourceTypes are the Azure Resource Manager Resource Types for untyped resources, keyed by the
+// name of the resource - which are used to validate at plan time that the Resource Type is available in the `location`
+// specified for the resource, when Enhanced Validation is enabled.
+//
+// Typed resources should instead implement `sdk.ResourceWithAzureResourceType`.
+var untypedResourceAzureResourceTypes = map[string]string{
+	"azurerm_api_management":                    "Microsoft.ApiManagement/service",
+	"azurerm_app_service_plan":                  "Microsoft.Web/serverFarms",
+	"azurerm_application_gateway":               "Microsoft.Network/applicationGateways",
+	"azurerm_application_insights":              "Microsoft.Insights/components",
+	"azurerm_cognitive_account":                 "Microsoft.CognitiveServices/accounts",
+	"azurerm_container_registry":                "Microsoft.ContainerRegistry/registries",
+	"azurerm_cosmosdb_account":                  "Microsoft.DocumentDB/databaseAccounts",
+	"azurerm_data_factory":                      "Microsoft.DataFactory/factories",
+	"azurerm_eventhub_namespace":                "Microsoft.EventHub/namespaces",
+	"azurerm_firewall":                          "Microsoft.Network/azureFirewalls",
+	"azurerm_key_vault":                         "Microsoft.KeyVault/vaults",
+	"azurerm_kubernetes_cluster":                "Microsoft.ContainerService/managedClusters",
+	"azurerm_lb":                                "Microsoft.Network/loadBalancers",
+	"azurerm_linux_virtual_machine":             "Microsoft.Compute/virtualMachines",
+	"azurerm_linux_virtual_machine_scale_set":   "Microsoft.Compute/virtualMachineScaleSets",
+	"azurerm_log_analytics_workspace":           "Microsoft.OperationalInsights/workspaces",
+	"azurerm_machine_learning_workspace":        "Microsoft.MachineLearningServices/workspaces",
+	"azurerm_managed_disk":                      "Microsoft.Compute/disks",
+	"azurerm_mssql_elasticpool":                 "Microsoft.Sql/servers/elasticPools",
+	"azurerm_mssql_server":                      "Microsoft.Sql/servers",
+	"azurerm_mysql_flexible_server":             "Microsoft.DBforMySQL/flexibleServers",
+	"azurerm_nat_gateway":                       "Microsoft.Network/natGateways",
+	"azurerm_network_interface":                 "Microsoft.Network/networkInterfaces",
+	"azurerm_network_security_group":            "Microsoft.Network/networkSecurityGroups",
+	"azurerm_postgresql_flexible_server":        "Microsoft.DBforPostgreSQL/flexibleServers",
+	"azurerm_private_endpoint":                  "Microsoft.Network/privateEndpoints",
+	"azurerm_public_ip":                         "Microsoft.Network/publicIPAddresses",
+	"azurerm_redis_cache":                       "Microsoft.Cache/redis",
+	"azurerm_resource_group":                    "Microsoft.Resources/resourceGroups",
+	"azurerm_route_table":                       "Microsoft.Network/routeTables",
+	"azurerm_search_service":                    "Microsoft.Search/searchServices",
+	"azurerm_servicebus_namespace":              "Microsoft.ServiceBus/namespaces",
+	"azurerm_signalr_service":                   "Microsoft.SignalRService/signalR",
+	"azurerm_storage_account":                   "Microsoft.Storage/storageAccounts",
+	"azurerm_synapse_workspace":                 "Microsoft.Synapse/workspaces",
+	"azurerm_virtual_network":                   "Microsoft.Network/virtualNetworks",
+	"azurerm_virtual_network_gateway":           "Microsoft.Network/virtualNetworkGateways",
+	"azurerm_windows_virtual_machine":           "Microsoft.Compute/virtualMachines",
+	"azurerm_windows_virtual_machine_scale_set": "Microsoft.Compute/virtualMachineScaleSets",
+}
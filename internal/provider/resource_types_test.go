@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestUntypedResourceAzureResourceTypes(t *testing.T) {
+	untyped := make(map[string]struct{})
+	for _, service := range SupportedUntypedServices() {
+		for k := range service.SupportedResources() {
+			untyped[k] = struct{}{}
+		}
+	}
+
+	provider := TestAzureProvider()
+	for resourceType, azureResourceType := range untypedResourceAzureResourceTypes {
+		if _, ok := untyped[resourceType]; !ok {
+			t.Fatalf("%q (%s) isn't an untyped resource - typed resources should implement `sdk.ResourceWithAzureResourceType`", resourceType, azureResourceType)
+		}
+
+		resource, ok := provider.ResourcesMap[resourceType]
+		if !ok {
+			t.Fatalf("%q (%s) isn't registered", resourceType, azureResourceType)
+		}
+		if _, ok := resource.Schema["location"]; !ok {
+			t.Fatalf("%q (%s) doesn't contain a `location` field", resourceType, azureResourceType)
+		}
+		if resource.CustomizeDiff == nil {
+			t.Fatalf("%q (%s) doesn't validate the location of the Resource Type", resourceType, azureResourceType)
+		}
+	}
+}
@@ -125,9 +125,9 @@ func validateResourceTypeName(resourceType string) error {
 		return fmt.Errorf("the resource type must be all lower-case")
 	}
 
-	// Role Assignments should be named `azurerm_{type}_role_assignment` for consistency
-	if strings.Contains(resourceType, "role_assignment") && !strings.HasSuffix(resourceType, "role_assignment") {
-		return fmt.Errorf("role assignment resources should be named `azurerm_{type}_role_assignment`")
+	// Role Assignments should be named `azurerm_{type}_role_assignment` (or `azurerm_{type}_role_assignments` when listed) for consistency
+	if strings.Contains(resourceType, "role_assignment") && !strings.HasSuffix(resourceType, "role_assignment") && !strings.HasSuffix(resourceType, "role_assignments") {
+		return fmt.Errorf("role assignment resources should be named `azurerm_{type}_role_assignment` (or `azurerm_{type}_role_assignments` for list data sources)")
 	}
 
 	// Role Definitions should be named `azurerm_{type}_role_definition` for consistency
@@ -207,3 +207,45 @@ func TestTypedResourcesUsePointersForOptionalProperties(t *testing.T) {
 		t.Fatalf("schema properties found with incorrect types - `Optional` should be pointers, `Required` should not be pointers")
 	}
 }
+
+func TestFrameworkRegistrationsDontConflictWithPluginSDKRegistrations(t *testing.T) {
+	// the Plugin Framework and Plugin SDK Providers are muxed together, so each Resource/Data Source
+	// must be registered with only one of them
+	dataSources := make(map[string]struct{})
+	resources := make(map[string]struct{})
+	for _, service := range SupportedTypedServices() {
+		for _, ds := range service.DataSources() {
+			dataSources[ds.ResourceType()] = struct{}{}
+		}
+		for _, r := range service.Resources() {
+			resources[r.ResourceType()] = struct{}{}
+		}
+	}
+	for _, service := range SupportedUntypedServices() {
+		for k := range service.SupportedDataSources() {
+			dataSources[k] = struct{}{}
+		}
+		for k := range service.SupportedResources() {
+			resources[k] = struct{}{}
+		}
+	}
+
+	for _, service := range SupportedTypedServices() {
+		v, ok := service.(sdk.FrameworkTypedServiceRegistration)
+		if !ok {
+			continue
+		}
+
+		t.Logf("Service %q..", service.Name())
+		for _, ds := range v.FrameworkDataSources() {
+			if _, exists := dataSources[ds.ResourceType()]; exists {
+				t.Fatalf("the Framework Data Source %q is also registered as a Plugin SDK Data Source", ds.ResourceType())
+			}
+		}
+		for _, r := range v.FrameworkResources() {
+			if _, exists := resources[r.ResourceType()]; exists {
+				t.Fatalf("the Framework Resource %q is also registered as a Plugin SDK Resource", r.ResourceType())
+			}
+		}
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestExpandTagDefaults(t *testing.T) {
+	testData := []struct {
+		Name        string
+		DefaultTags []interface{}
+		IgnoreTags  []interface{}
+		Expected    tags.ProviderDefaults
+	}{
+		{
+			Name:        "Not Configured",
+			DefaultTags: []interface{}{},
+			IgnoreTags:  []interface{}{},
+			Expected: tags.ProviderDefaults{
+				DefaultTags:       map[string]string{},
+				IgnoreKeys:        []string{},
+				IgnoreKeyPrefixes: []string{},
+			},
+		},
+		{
+			Name: "Default Tags",
+			DefaultTags: []interface{}{
+				map[string]interface{}{
+					"tags": map[string]interface{}{
+						"environment": "production",
+						"team":        "platform",
+					},
+				},
+			},
+			IgnoreTags: []interface{}{},
+			Expected: tags.ProviderDefaults{
+				DefaultTags: map[string]string{
+					"environment": "production",
+					"team":        "platform",
+				},
+				IgnoreKeys:        []string{},
+				IgnoreKeyPrefixes: []string{},
+			},
+		},
+		{
+			Name:        "Ignore Tags",
+			DefaultTags: []interface{}{},
+			IgnoreTags: []interface{}{
+				map[string]interface{}{
+					"keys":         pluginsdk.NewSet(pluginsdk.HashString, []interface{}{"CreatedBy", "CreatedOn"}),
+					"key_prefixes": pluginsdk.NewSet(pluginsdk.HashString, []interface{}{"policy-"}),
+				},
+			},
+			Expected: tags.ProviderDefaults{
+				DefaultTags:       map[string]string{},
+				IgnoreKeys:        []string{"CreatedBy", "CreatedOn"},
+				IgnoreKeyPrefixes: []string{"policy-"},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q", v.Name)
+
+		actual := expandTagDefaults(v.DefaultTags, v.IgnoreTags)
+		sort.Strings(actual.IgnoreKeys)
+		sort.Strings(actual.IgnoreKeyPrefixes)
+
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func schemaDefaultTimeouts() *pluginsdk.Schema {
+	timeout := func(operation string) *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: ValidateDuration,
+			Description:  fmt.Sprintf("The default length of time (for example `90m`) allowed to %s a resource.", operation),
+		}
+	}
+
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		Description: "The default timeouts used for resources which don't specify a `timeouts` block, either for all resources or for a specific resource type.",
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"resource_type": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The resource type (for example `azurerm_linux_virtual_machine`) these timeouts apply to. When omitted these timeouts apply to all resources.",
+				},
+
+				"create": timeout("create"),
+
+				"read": timeout("read"),
+
+				"update": timeout("update"),
+
+				"delete": timeout("delete"),
+			},
+		},
+	}
+}
+
+// expandTimeoutDefaults builds the default timeouts from the `default_timeouts` blocks, validating that at most one
+// block exists without a `resource_type`, at most one block exists for each resource type and that each resource
+// type is supported by the Provider
+func expandTimeoutDefaults(input []interface{}, resources map[string]*pluginsdk.Resource) (*timeouts.ProviderDefaults, error) {
+	output := timeouts.ProviderDefaults{
+		ResourceTypes: make(map[string]timeouts.Defaults),
+	}
+
+	hasGlobal := false
+	for _, v := range input {
+		if v == nil {
+			// an empty block applies no defaults
+			continue
+		}
+
+		raw := v.(map[string]interface{})
+		defaults, err := expandTimeoutDefaultsBlock(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		resourceType := raw["resource_type"].(string)
+		if resourceType == "" {
+			if hasGlobal {
+				return nil, fmt.Errorf("only one `default_timeouts` block can omit `resource_type`")
+			}
+			hasGlobal = true
+			output.Global = *defaults
+			continue
+		}
+
+		if _, ok := output.ResourceTypes[resourceType]; ok {
+			return nil, fmt.Errorf("only one `default_timeouts` block can be specified for the resource type %q", resourceType)
+		}
+		if _, ok := resources[resourceType]; !ok && !isFrameworkResourceType(resourceType) {
+			return nil, fmt.Errorf("the `default_timeouts` block contains the resource type %q which isn't supported by this provider", resourceType)
+		}
+		output.ResourceTypes[resourceType] = *defaults
+	}
+
+	return &output, nil
+}
+
+func expandTimeoutDefaultsBlock(input map[string]interface{}) (*timeouts.Defaults, error) {
+	parse := func(operation string) (*time.Duration, error) {
+		value := input[operation].(string)
+		if value == "" {
+			return nil, nil
+		}
+
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("parsing the `%s` timeout %q within the `default_timeouts` block: %+v", operation, value, err)
+		}
+		if duration <= 0 {
+			return nil, fmt.Errorf("the `%s` timeout within the `default_timeouts` block must be a positive duration but got %q", operation, value)
+		}
+
+		return &duration, nil
+	}
+
+	var output timeouts.Defaults
+	var err error
+	if output.Create, err = parse("create"); err != nil {
+		return nil, err
+	}
+	if output.Read, err = parse("read"); err != nil {
+		return nil, err
+	}
+	if output.Update, err = parse("update"); err != nil {
+		return nil, err
+	}
+	if output.Delete, err = parse("delete"); err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+// isFrameworkResourceType returns whether the specified resource type is a Plugin Framework Resource, which is
+// supported by this provider despite not being present in the Plugin SDK's ResourcesMap
+func isFrameworkResourceType(resourceType string) bool {
+	for _, service := range SupportedTypedServices() {
+		v, ok := service.(sdk.FrameworkTypedServiceRegistration)
+		if !ok {
+			continue
+		}
+
+		for _, r := range v.FrameworkResources() {
+			if r.ResourceType() == resourceType {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestExpandTimeoutDefaults(t *testing.T) {
+	resources := map[string]*pluginsdk.Resource{
+		"azurerm_linux_virtual_machine": {},
+	}
+	block := func(resourceType, create string) interface{} {
+		return map[string]interface{}{
+			"resource_type": resourceType,
+			"create":        create,
+			"read":          "",
+			"update":        "",
+			"delete":        "",
+		}
+	}
+
+	actual, err := expandTimeoutDefaults([]interface{}{
+		block("", "1h"),
+		block("azurerm_linux_virtual_machine", "90m"),
+		nil,
+	}, resources)
+	if err != nil {
+		t.Fatalf("expanding: %+v", err)
+	}
+	if actual.Global.Create == nil || *actual.Global.Create != time.Hour || actual.Global.Read != nil {
+		t.Fatalf("unexpected global defaults %+v", actual.Global)
+	}
+	if v := actual.ResourceTypes["azurerm_linux_virtual_machine"].Create; v == nil || *v != 90*time.Minute {
+		t.Fatalf("unexpected defaults for `azurerm_linux_virtual_machine`: %+v", actual.ResourceTypes["azurerm_linux_virtual_machine"])
+	}
+
+	invalid := map[string][]interface{}{
+		"Multiple Global":       {block("", "1h"), block("", "2h")},
+		"Duplicate Type":        {block("azurerm_linux_virtual_machine", "1h"), block("azurerm_linux_virtual_machine", "2h")},
+		"Unsupported Type":      {block("azurerm_does_not_exist", "1h")},
+		"Invalid Duration":      {block("", "an hour")},
+		"Non-Positive Duration": {block("", "0s")},
+	}
+	for name, input := range invalid {
+		t.Logf("[DEBUG] Testing %q", name)
+		if _, err := expandTimeoutDefaults(input, resources); err == nil {
+			t.Fatalf("expected an error for %q", name)
+		}
+	}
+}
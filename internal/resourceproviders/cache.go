@@ -6,10 +6,13 @@ package resourceproviders
 import (
 	"context"
 	"fmt"
+	"log"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
 )
 
@@ -18,6 +21,13 @@ var cachedResourceProviders *[]string
 var registeredResourceProviders map[string]struct{}
 var unregisteredResourceProviders map[string]struct{}
 
+// cachedResourceProviderModels is the (un-indexed) contents of the cache, used when writing the cache file
+var cachedResourceProviderModels []cachedResourceProviderModel
+
+// resourceTypeLocations is a map of the Resource Type (e.g. `microsoft.web/sites`) to the normalized Locations
+// which it's available in - which can be (validly) nil, in which case the Locations can't be validated
+var resourceTypeLocations map[string]map[string]struct{}
+
 var cacheLock = &sync.Mutex{}
 
 // CacheSupportedProviders attempts to retrieve the supported Resource Providers from the Resource Manager API
@@ -40,6 +50,9 @@ func ClearCache() {
 	cachedResourceProviders = nil
 	registeredResourceProviders = nil
 	unregisteredResourceProviders = nil
+	resourceTypeLocations = nil
+	cachedResourceProviderModels = nil
+	cachedLocations = nil
 	cacheLock.Unlock()
 }
 
@@ -47,28 +60,102 @@ func populateCache(ctx context.Context, client *providers.ProvidersClient, subsc
 	cacheLock.Lock()
 	defer cacheLock.Unlock()
 
+	if cacheFile != nil {
+		if cached := cacheFile.read(subscriptionId.SubscriptionId, time.Now()); cached != nil {
+			setCache(cached)
+			return nil
+		}
+	}
+
 	providers, err := client.ListComplete(ctx, subscriptionId, providers.DefaultListOperationOptions())
 	if err != nil {
 		return fmt.Errorf("listing Resource Providers: %+v", err)
 	}
 
-	providerNames := make([]string, 0)
-	registeredResourceProviders = make(map[string]struct{})
-	unregisteredResourceProviders = make(map[string]struct{})
+	resourceProviders := make([]cachedResourceProviderModel, 0)
 	for _, provider := range providers.Items {
 		if provider.Namespace == nil {
 			continue
 		}
 
-		providerNames = append(providerNames, *provider.Namespace)
-		registered := provider.RegistrationState != nil && strings.EqualFold(*provider.RegistrationState, "registered")
-		if registered {
-			registeredResourceProviders[*provider.Namespace] = struct{}{}
+		resourceTypes := make([]cachedResourceTypeModel, 0)
+		if provider.ResourceTypes != nil {
+			for _, resourceType := range *provider.ResourceTypes {
+				if resourceType.ResourceType == nil || resourceType.Locations == nil {
+					continue
+				}
+				resourceTypes = append(resourceTypes, cachedResourceTypeModel{
+					ResourceType: *resourceType.ResourceType,
+					Locations:    *resourceType.Locations,
+				})
+			}
+		}
+
+		resourceProviders = append(resourceProviders, cachedResourceProviderModel{
+			Namespace:     *provider.Namespace,
+			Registered:    provider.RegistrationState != nil && strings.EqualFold(*provider.RegistrationState, "registered"),
+			ResourceTypes: resourceTypes,
+		})
+	}
+	setCache(resourceProviders)
+
+	if cacheFile != nil {
+		if err := cacheFile.write(subscriptionId.SubscriptionId, resourceProviders, time.Now()); err != nil {
+			log.Printf("[DEBUG] Unable to write the Resource Provider cache file %q: %+v", cacheFile.Path, err)
+		}
+	}
+
+	return nil
+}
+
+// markRegistered updates the cache (and the cache file, if configured) once the specified Resource Providers have
+// been registered, so that subsequent runs don't attempt to register these again
+func markRegistered(subscriptionId commonids.SubscriptionId, providerNames []string) {
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	if registeredResourceProviders == nil || unregisteredResourceProviders == nil {
+		return
+	}
+
+	for _, v := range providerNames {
+		registeredResourceProviders[v] = struct{}{}
+		delete(unregisteredResourceProviders, v)
+	}
+
+	if cacheFile != nil && cachedResourceProviderModels != nil {
+		resourceProviders := make([]cachedResourceProviderModel, 0, len(cachedResourceProviderModels))
+		for _, v := range cachedResourceProviderModels {
+			_, v.Registered = registeredResourceProviders[v.Namespace]
+			resourceProviders = append(resourceProviders, v)
+		}
+		if err := cacheFile.write(subscriptionId.SubscriptionId, resourceProviders, time.Now()); err != nil {
+			log.Printf("[DEBUG] Unable to write the Resource Provider cache file %q: %+v", cacheFile.Path, err)
+		}
+	}
+}
+
+func setCache(resourceProviders []cachedResourceProviderModel) {
+	providerNames := make([]string, 0, len(resourceProviders))
+	registeredResourceProviders = make(map[string]struct{})
+	unregisteredResourceProviders = make(map[string]struct{})
+	resourceTypeLocations = make(map[string]map[string]struct{})
+	for _, v := range resourceProviders {
+		providerNames = append(providerNames, v.Namespace)
+		for _, resourceType := range v.ResourceTypes {
+			locations := make(map[string]struct{}, len(resourceType.Locations))
+			for _, loc := range resourceType.Locations {
+				locations[location.Normalize(loc)] = struct{}{}
+			}
+			resourceTypeLocations[strings.ToLower(fmt.Sprintf("%s/%s", v.Namespace, resourceType.ResourceType))] = locations
+		}
+		if v.Registered {
+			registeredResourceProviders[v.Namespace] = struct{}{}
 		} else {
-			unregisteredResourceProviders[*provider.Namespace] = struct{}{}
+			unregisteredResourceProviders[v.Namespace] = struct{}{}
 		}
 	}
 
 	cachedResourceProviders = &providerNames
-	return nil
+	cachedResourceProviderModels = resourceProviders
 }
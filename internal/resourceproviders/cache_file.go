@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DefaultCacheFileTTL is the length of time an entry in the cache file is used for, when a TTL isn't specified
+const DefaultCacheFileTTL = time.Hour
+
+// cacheFileVersion is incremented when the format of the cache file changes, so that older files are ignored
+const cacheFileVersion = 3
+
+// CacheFileOptions configures the (opt-in) file used to persist the Resource Providers available within a
+// Subscription (and the Locations available within the Azure Environment) between runs of the Provider, rather
+// than retrieving these each time the Provider is initialized
+type CacheFileOptions struct {
+	// Path is the path to the cache file, which is created if it doesn't exist
+	Path string
+
+	// TTL is the length of time an entry within the cache file is used for, before it's refreshed
+	TTL time.Duration
+
+	// Environment is the name of the Azure Environment (e.g. `public`) used as a part of the key for an entry
+	Environment string
+
+	// TenantId is the Tenant ID used as a part of the key for an entry
+	TenantId string
+}
+
+// cacheFile can be (validly) nil, in which case the Resource Providers are always retrieved from the API
+var cacheFile *CacheFileOptions
+
+// ConfigureCacheFile configures the file used to persist the Resource Provider cache, or disables this when nil
+func ConfigureCacheFile(options *CacheFileOptions) {
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	if options != nil && options.Path == "" {
+		options = nil
+	}
+	if options != nil && options.TTL <= 0 {
+		options.TTL = DefaultCacheFileTTL
+	}
+	cacheFile = options
+}
+
+type cacheFileContents struct {
+	Version   int                                `json:"version"`
+	Entries   map[string]cacheFileEntryModel     `json:"entries"`
+	Locations map[string]cacheFileLocationsModel `json:"locations,omitempty"`
+}
+
+type cacheFileEntryModel struct {
+	Updated           time.Time                     `json:"updated"`
+	ResourceProviders []cachedResourceProviderModel `json:"resourceProviders"`
+}
+
+type cacheFileLocationsModel struct {
+	Updated   time.Time `json:"updated"`
+	Locations []string  `json:"locations"`
+}
+
+type cachedResourceProviderModel struct {
+	Namespace     string                    `json:"namespace"`
+	Registered    bool                      `json:"registered"`
+	ResourceTypes []cachedResourceTypeModel `json:"resourceTypes,omitempty"`
+}
+
+type cachedResourceTypeModel struct {
+	ResourceType string   `json:"resourceType"`
+	Locations    []string `json:"locations,omitempty"`
+}
+
+func (o CacheFileOptions) key(subscriptionId string) string {
+	return fmt.Sprintf("%s/%s/%s", o.Environment, o.TenantId, subscriptionId)
+}
+
+func (o CacheFileOptions) locationsKey(resourceManagerEndpoint string) string {
+	return fmt.Sprintf("%s/%s", o.Environment, strings.TrimSuffix(strings.ToLower(resourceManagerEndpoint), "/"))
+}
+
+func (o CacheFileOptions) expired(updated time.Time, now time.Time) bool {
+	return updated.After(now) || now.Sub(updated) > o.TTL
+}
+
+// read returns the Resource Providers for the specified Subscription from the cache file - returning nil when the
+// file doesn't exist, can't be parsed, or the entry is missing or has expired, in which case these should be
+// retrieved from the API instead
+func (o CacheFileOptions) read(subscriptionId string, now time.Time) []cachedResourceProviderModel {
+	contents, err := o.load()
+	if err != nil {
+		log.Printf("[DEBUG] Ignoring the Resource Provider cache file %q: %+v", o.Path, err)
+		return nil
+	}
+
+	entry, ok := contents.Entries[o.key(subscriptionId)]
+	if !ok {
+		log.Printf("[DEBUG] The Resource Provider cache file %q contains no entry for Subscription %q", o.Path, subscriptionId)
+		return nil
+	}
+	if o.expired(entry.Updated, now) {
+		log.Printf("[DEBUG] The entry for Subscription %q in the Resource Provider cache file %q has expired", subscriptionId, o.Path)
+		return nil
+	}
+	if len(entry.ResourceProviders) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Using the Resource Providers for Subscription %q from the cache file %q (updated %s)", subscriptionId, o.Path, entry.Updated.Format(time.RFC3339))
+	return entry.ResourceProviders
+}
+
+// write persists the Resource Providers for the specified Subscription into the cache file
+func (o CacheFileOptions) write(subscriptionId string, resourceProviders []cachedResourceProviderModel, now time.Time) error {
+	return o.update(now, func(contents *cacheFileContents) {
+		contents.Entries[o.key(subscriptionId)] = cacheFileEntryModel{
+			Updated:           now.UTC(),
+			ResourceProviders: resourceProviders,
+		}
+	})
+}
+
+// readLocations returns the Locations available on the specified Resource Manager endpoint from the cache file -
+// returning nil when the file doesn't exist, can't be parsed, or the entry is missing or has expired, in which case
+// these should be retrieved from the Azure MetaData Service instead
+func (o CacheFileOptions) readLocations(resourceManagerEndpoint string, now time.Time) []string {
+	contents, err := o.load()
+	if err != nil {
+		log.Printf("[DEBUG] Ignoring the Resource Provider cache file %q: %+v", o.Path, err)
+		return nil
+	}
+
+	entry, ok := contents.Locations[o.locationsKey(resourceManagerEndpoint)]
+	if !ok || o.expired(entry.Updated, now) || len(entry.Locations) == 0 {
+		log.Printf("[DEBUG] The Resource Provider cache file %q contains no (current) Locations for %q", o.Path, resourceManagerEndpoint)
+		return nil
+	}
+
+	log.Printf("[DEBUG] Using the Locations for %q from the cache file %q (updated %s)", resourceManagerEndpoint, o.Path, entry.Updated.Format(time.RFC3339))
+	return entry.Locations
+}
+
+// writeLocations persists the Locations available on the specified Resource Manager endpoint into the cache file
+func (o CacheFileOptions) writeLocations(resourceManagerEndpoint string, locations []string, now time.Time) error {
+	return o.update(now, func(contents *cacheFileContents) {
+		contents.Locations[o.locationsKey(resourceManagerEndpoint)] = cacheFileLocationsModel{
+			Updated:   now.UTC(),
+			Locations: locations,
+		}
+	})
+}
+
+// update applies the specified change to the cache file, removing any expired entries. Since this cache is
+// best-effort, concurrent writers can overwrite one another.
+func (o CacheFileOptions) update(now time.Time, change func(contents *cacheFileContents)) error {
+	contents, err := o.load()
+	if err != nil {
+		// the existing file is replaced if it's corrupt, or in an older format
+		contents = &cacheFileContents{}
+	}
+	contents.Version = cacheFileVersion
+	if contents.Entries == nil {
+		contents.Entries = make(map[string]cacheFileEntryModel)
+	}
+	if contents.Locations == nil {
+		contents.Locations = make(map[string]cacheFileLocationsModel)
+	}
+
+	for k, v := range contents.Entries {
+		if now.Sub(v.Updated) > o.TTL {
+			delete(contents.Entries, k)
+		}
+	}
+	for k, v := range contents.Locations {
+		if now.Sub(v.Updated) > o.TTL {
+			delete(contents.Locations, k)
+		}
+	}
+	change(contents)
+
+	data, err := json.MarshalIndent(contents, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling: %+v", err)
+	}
+
+	// write to a temporary file which is then renamed, so that the cache file is never partially written
+	dir := filepath.Dir(o.Path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("creating directory %q: %+v", dir, err)
+	}
+	temp, err := os.CreateTemp(dir, filepath.Base(o.Path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("creating temporary file: %+v", err)
+	}
+	defer os.Remove(temp.Name())
+
+	if _, err := temp.Write(data); err != nil {
+		temp.Close()
+		return fmt.Errorf("writing temporary file %q: %+v", temp.Name(), err)
+	}
+	if err := temp.Close(); err != nil {
+		return fmt.Errorf("closing temporary file %q: %+v", temp.Name(), err)
+	}
+	if err := os.Rename(temp.Name(), o.Path); err != nil {
+		return fmt.Errorf("renaming %q to %q: %+v", temp.Name(), o.Path, err)
+	}
+
+	return nil
+}
+
+func (o CacheFileOptions) load() (*cacheFileContents, error) {
+	data, err := os.ReadFile(o.Path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return &cacheFileContents{}, nil
+		}
+		return nil, fmt.Errorf("reading: %+v", err)
+	}
+
+	var contents cacheFileContents
+	if err := json.Unmarshal(data, &contents); err != nil {
+		return nil, fmt.Errorf("parsing: %+v", err)
+	}
+	if contents.Version != cacheFileVersion {
+		return nil, fmt.Errorf("expected version %d but got %d", cacheFileVersion, contents.Version)
+	}
+
+	return &contents, nil
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+)
+
+func TestCacheFile_ReadWrite(t *testing.T) {
+	options := CacheFileOptions{
+		Path:        filepath.Join(t.TempDir(), "nested", "cache.json"),
+		TTL:         time.Hour,
+		Environment: "public",
+		TenantId:    "00000000-0000-0000-0000-000000000000",
+	}
+	now := time.Now()
+	resourceProviders := []cachedResourceProviderModel{
+		{Namespace: "Microsoft.Compute", Registered: true},
+		{Namespace: "Microsoft.Web", Registered: false},
+	}
+
+	if got := options.read("11111111-1111-1111-1111-111111111111", now); got != nil {
+		t.Fatalf("expected no entry when the file doesn't exist but got %+v", got)
+	}
+
+	if err := options.write("11111111-1111-1111-1111-111111111111", resourceProviders, now); err != nil {
+		t.Fatalf("writing: %+v", err)
+	}
+
+	if got := options.read("11111111-1111-1111-1111-111111111111", now.Add(time.Minute)); !reflect.DeepEqual(got, resourceProviders) {
+		t.Fatalf("expected %+v but got %+v", resourceProviders, got)
+	}
+
+	if got := options.read("22222222-2222-2222-2222-222222222222", now); got != nil {
+		t.Fatalf("expected no entry for a different Subscription but got %+v", got)
+	}
+
+	otherTenant := options
+	otherTenant.TenantId = "33333333-3333-3333-3333-333333333333"
+	if got := otherTenant.read("11111111-1111-1111-1111-111111111111", now); got != nil {
+		t.Fatalf("expected no entry for a different Tenant but got %+v", got)
+	}
+
+	if got := options.read("11111111-1111-1111-1111-111111111111", now.Add(2*time.Hour)); got != nil {
+		t.Fatalf("expected no entry once the TTL has elapsed but got %+v", got)
+	}
+
+	// writing a second Subscription once the first has expired removes the expired entry
+	if err := options.write("22222222-2222-2222-2222-222222222222", resourceProviders, now.Add(2*time.Hour)); err != nil {
+		t.Fatalf("writing: %+v", err)
+	}
+	contents, err := options.load()
+	if err != nil {
+		t.Fatalf("loading: %+v", err)
+	}
+	if len(contents.Entries) != 1 {
+		t.Fatalf("expected 1 entry but got %d", len(contents.Entries))
+	}
+}
+
+func TestCacheFile_Invalid(t *testing.T) {
+	testCases := map[string]string{
+		"corrupt":       `{"version": 1, "entries": {`,
+		"wrong version": `{"version": 0, "entries": {"public/tenant/subscription": {"updated": "2100-01-01T00:00:00Z", "resourceProviders": [{"namespace": "Microsoft.Compute", "registered": true}]}}}`,
+		"future entry":  `{"version": 1, "entries": {"public/tenant/subscription": {"updated": "2100-01-01T00:00:00Z", "resourceProviders": [{"namespace": "Microsoft.Compute", "registered": true}]}}}`,
+		"empty entry":   `{"version": 1, "entries": {"public/tenant/subscription": {"updated": "2024-01-01T00:00:00Z", "resourceProviders": []}}}`,
+	}
+
+	for name, content := range testCases {
+		t.Run(name, func(t *testing.T) {
+			options := CacheFileOptions{
+				Path:        filepath.Join(t.TempDir(), "cache.json"),
+				TTL:         time.Hour,
+				Environment: "public",
+				TenantId:    "tenant",
+			}
+			if err := os.WriteFile(options.Path, []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := options.read("subscription", time.Date(2024, 1, 1, 0, 1, 0, 0, time.UTC)); got != nil {
+				t.Fatalf("expected no entry but got %+v", got)
+			}
+
+			// the file is replaced when it's next written
+			if err := options.write("subscription", []cachedResourceProviderModel{{Namespace: "Microsoft.Web"}}, time.Now()); err != nil {
+				t.Fatalf("writing: %+v", err)
+			}
+			if got := options.read("subscription", time.Now()); len(got) != 1 {
+				t.Fatalf("expected 1 Resource Provider but got %+v", got)
+			}
+		})
+	}
+}
+
+func TestPopulateCache_FromCacheFile(t *testing.T) {
+	options := &CacheFileOptions{
+		Path:        filepath.Join(t.TempDir(), "cache.json"),
+		Environment: "public",
+		TenantId:    "tenant",
+	}
+	subscriptionId := commonids.NewSubscriptionID("11111111-1111-1111-1111-111111111111")
+
+	ConfigureCacheFile(options)
+	defer func() {
+		ConfigureCacheFile(nil)
+		ClearCache()
+	}()
+	if options.TTL != DefaultCacheFileTTL {
+		t.Fatalf("expected the TTL to default to %s but got %s", DefaultCacheFileTTL, options.TTL)
+	}
+
+	resourceProviders := []cachedResourceProviderModel{
+		{Namespace: "Microsoft.Compute", Registered: true},
+		{Namespace: "Microsoft.Web", Registered: false},
+	}
+	if err := options.write(subscriptionId.SubscriptionId, resourceProviders, time.Now()); err != nil {
+		t.Fatalf("writing: %+v", err)
+	}
+
+	// a fresh entry in the cache file means the (nil) client isn't used
+	if err := populateCache(context.TODO(), nil, subscriptionId); err != nil {
+		t.Fatalf("populating cache: %+v", err)
+	}
+	if !reflect.DeepEqual(*cachedResourceProviders, []string{"Microsoft.Compute", "Microsoft.Web"}) {
+		t.Fatalf("unexpected cached Resource Providers: %+v", *cachedResourceProviders)
+	}
+
+	requiringRegistration, err := DetermineWhichRequiredResourceProvidersRequireRegistration(ResourceProviders{
+		"Microsoft.Compute": {},
+		"Microsoft.Web":     {},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(*requiringRegistration, []string{"Microsoft.Web"}) {
+		t.Fatalf("expected only `Microsoft.Web` to require registration but got %+v", *requiringRegistration)
+	}
+
+	markRegistered(subscriptionId, []string{"Microsoft.Web"})
+	got := options.read(subscriptionId.SubscriptionId, time.Now())
+	expected := []cachedResourceProviderModel{
+		{Namespace: "Microsoft.Compute", Registered: true},
+		{Namespace: "Microsoft.Web", Registered: true},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %+v once registered but got %+v", expected, got)
+	}
+}
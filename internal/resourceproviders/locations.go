@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+)
+
+// cachedLocations can be (validly) nil, in which case Locations are validated using location.EnhancedValidate
+var cachedLocations *[]string
+
+// this is only here to aid testing
+var metadataClient = &http.Client{
+	Transport: &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+	},
+}
+
+// CacheSupportedLocations attempts to retrieve the Locations supported on the specified Resource Manager endpoint,
+// from the cache file when configured or otherwise from the Azure MetaData Service, and caches them for use in
+// enhanced validation
+func CacheSupportedLocations(ctx context.Context, resourceManagerEndpoint string) {
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	if cacheFile != nil {
+		if cached := cacheFile.readLocations(resourceManagerEndpoint, time.Now()); cached != nil {
+			cachedLocations = &cached
+			return
+		}
+	}
+
+	locations, err := availableLocations(ctx, resourceManagerEndpoint)
+	if err != nil {
+		log.Printf("[DEBUG] error retrieving locations: %s. Enhanced validation will be unavailable", err)
+		return
+	}
+	cachedLocations = &locations
+
+	if cacheFile != nil {
+		if err := cacheFile.writeLocations(resourceManagerEndpoint, locations, time.Now()); err != nil {
+			log.Printf("[DEBUG] Unable to write the Locations to the cache file %q: %+v", cacheFile.Path, err)
+		}
+	}
+}
+
+type metadataCloudEndpoint struct {
+	Endpoint  string   `json:"endpoint"`
+	Locations []string `json:"locations"`
+}
+
+type metadataResponse struct {
+	CloudEndpoint map[string]metadataCloudEndpoint `json:"cloudEndpoint"`
+}
+
+// availableLocations returns the Locations available on the specified Resource Manager endpoint, in the same
+// manner as location.CacheSupportedLocations
+func availableLocations(ctx context.Context, resourceManagerEndpoint string) ([]string, error) {
+	// e.g. https://management.azure.com/ but we need management.azure.com
+	endpoint := strings.TrimPrefix(resourceManagerEndpoint, "https://")
+	endpoint = strings.TrimSuffix(endpoint, "/")
+
+	uri := fmt.Sprintf("https://%s//metadata/endpoints?api-version=2018-01-01", endpoint)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := metadataClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving supported locations from Azure MetaData service: %+v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("retrieving supported locations from Azure MetaData service: unexpected status %d", resp.StatusCode)
+	}
+
+	var out metadataResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("deserializing JSON from Azure MetaData service: %+v", err)
+	}
+
+	var locations []string
+	for _, v := range out.CloudEndpoint {
+		// one of the endpoints on this endpoint should reference itself
+		if strings.EqualFold(v.Endpoint, endpoint) {
+			locations = v.Locations
+		}
+	}
+	if len(locations) == 0 {
+		return nil, fmt.Errorf("the Azure MetaData service returned no locations for %q", endpoint)
+	}
+
+	// the Azure API returns the india locations the wrong way around (e.g. 'southindia' is returned as 'indiasouth')
+	replacements := map[string]string{
+		"indiacentral": "centralindia",
+		"indiasouth":   "southindia",
+		"indiawest":    "westindia",
+	}
+	for i, v := range locations {
+		if replacement, ok := replacements[v]; ok {
+			locations[i] = replacement
+		}
+	}
+
+	return locations, nil
+}
+
+// EnhancedValidateLocation validates the Location against the Locations supported by this Azure Environment, as
+// cached by CacheSupportedLocations (and persisted in the cache file, when configured).
+//
+// NOTE: this is best-effort - when these Locations aren't available this falls back to location.EnhancedValidate
+func EnhancedValidateLocation(i interface{}, k string) ([]string, []error) {
+	cacheLock.Lock()
+	locations := cachedLocations
+	cacheLock.Unlock()
+
+	if !enhancedEnabled || locations == nil {
+		return location.EnhancedValidate(i, k)
+	}
+
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	normalizedUserInput := location.Normalize(v)
+	if normalizedUserInput == "" {
+		return nil, []error{fmt.Errorf("%q must not be empty", k)}
+	}
+
+	for _, loc := range *locations {
+		if normalizedUserInput == location.Normalize(loc) {
+			return nil, nil
+		}
+	}
+
+	// Some resources use a location named "global".
+	if normalizedUserInput == "global" {
+		return nil, nil
+	}
+
+	return nil, []error{
+		fmt.Errorf("%q was not found in the list of supported Azure Locations: %q", normalizedUserInput, strings.Join(*locations, ",")),
+	}
+}
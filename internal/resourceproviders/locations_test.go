@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+)
+
+func TestCacheSupportedLocations_CacheFile(t *testing.T) {
+	requests := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "//metadata/endpoints" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		endpoint := strings.TrimPrefix(r.Host, "https://")
+		fmt.Fprintf(w, `{"cloudEndpoint": {"public": {"endpoint": %q, "locations": ["westeurope", "indiasouth"]}, "other": {"endpoint": "management.example.com", "locations": ["otherlocation"]}}}`, endpoint)
+	}))
+	defer server.Close()
+
+	originalClient := metadataClient
+	metadataClient = server.Client()
+	options := &CacheFileOptions{
+		Path:        filepath.Join(t.TempDir(), "cache.json"),
+		Environment: "public",
+		TenantId:    "tenant",
+	}
+	ConfigureCacheFile(options)
+	defer func() {
+		metadataClient = originalClient
+		ConfigureCacheFile(nil)
+		ClearCache()
+	}()
+
+	expected := []string{"westeurope", "southindia"}
+	CacheSupportedLocations(context.TODO(), server.URL+"/")
+	if cachedLocations == nil || !reflect.DeepEqual(*cachedLocations, expected) {
+		t.Fatalf("expected the Locations %+v to be cached but got %+v", expected, cachedLocations)
+	}
+	if got := options.readLocations(server.URL+"/", time.Now()); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected the Locations %+v to be written to the cache file but got %+v", expected, got)
+	}
+
+	// a fresh entry in the cache file means the Azure MetaData Service isn't called
+	ClearCache()
+	server.Close()
+	CacheSupportedLocations(context.TODO(), server.URL+"/")
+	if cachedLocations == nil || !reflect.DeepEqual(*cachedLocations, expected) {
+		t.Fatalf("expected the Locations %+v to be read from the cache file but got %+v", expected, cachedLocations)
+	}
+	if requests != 1 {
+		t.Fatalf("expected 1 request to the Azure MetaData Service but got %d", requests)
+	}
+
+	// the Resource Providers within the same file are unaffected
+	if err := options.write("subscription", []cachedResourceProviderModel{{Namespace: "Microsoft.Web"}}, time.Now()); err != nil {
+		t.Fatalf("writing: %+v", err)
+	}
+	if got := options.readLocations(server.URL+"/", time.Now()); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected the Locations %+v to be retained but got %+v", expected, got)
+	}
+	if got := options.readLocations(server.URL+"/", time.Now().Add(2*time.Hour)); got != nil {
+		t.Fatalf("expected no Locations once the TTL has elapsed but got %+v", got)
+	}
+}
+
+func TestCacheSupportedLocations_Unavailable(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	originalClient := metadataClient
+	metadataClient = server.Client()
+	defer func() {
+		metadataClient = originalClient
+		ClearCache()
+	}()
+
+	CacheSupportedLocations(context.TODO(), server.URL)
+	if cachedLocations != nil {
+		t.Fatalf("expected no Locations to be cached but got %+v", *cachedLocations)
+	}
+}
+
+func TestEnhancedValidateLocation(t *testing.T) {
+	testCases := []struct {
+		input   string
+		enabled bool
+		cached  bool
+		valid   bool
+	}{
+		{
+			input:   "",
+			enabled: true,
+			cached:  true,
+			valid:   false,
+		},
+		{
+			input:   "westeurope",
+			enabled: true,
+			cached:  true,
+			valid:   true,
+		},
+		{
+			input:   "West Europe",
+			enabled: true,
+			cached:  true,
+			valid:   true,
+		},
+		{
+			input:   "global",
+			enabled: true,
+			cached:  true,
+			valid:   true,
+		},
+		{
+			input:   "westus",
+			enabled: true,
+			cached:  true,
+			valid:   false,
+		},
+		{
+			input:   "westus",
+			enabled: false,
+			cached:  true,
+			valid:   true,
+		},
+		{
+			input:   "westus",
+			enabled: true,
+			cached:  false,
+			valid:   true,
+		},
+		{
+			input:   "",
+			enabled: true,
+			cached:  false,
+			valid:   false,
+		},
+	}
+	defer func() {
+		enhancedEnabled = features.EnhancedValidationEnabled()
+		ClearCache()
+	}()
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %q (enabled %t, cached %t)..", testCase.input, testCase.enabled, testCase.cached)
+
+		enhancedEnabled = testCase.enabled
+		cachedLocations = nil
+		if testCase.cached {
+			cachedLocations = &[]string{"westeurope", "northeurope"}
+		}
+
+		warnings, errors := EnhancedValidateLocation(testCase.input, "location")
+		valid := len(warnings) == 0 && len(errors) == 0
+		if testCase.valid != valid {
+			t.Errorf("Expected %t but got %t (%v)", testCase.valid, valid, errors)
+		}
+	}
+}
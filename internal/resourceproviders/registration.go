@@ -50,6 +50,7 @@ func EnsureRegistered(ctx context.Context, client *providers.ProvidersClient, su
 	if err = registerForSubscription(ctx, client, subscriptionId, *providersToRegister); err != nil {
 		return userError(err)
 	}
+	markRegistered(subscriptionId, *providersToRegister)
 
 	return nil
 }
@@ -5,8 +5,10 @@ package resourceproviders
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
@@ -58,3 +60,37 @@ func enhancedValidation(i interface{}, k string) ([]string, []error) {
 
 	return nil, nil
 }
+
+// ValidateResourceTypeLocation validates that the Resource Type (e.g. `Microsoft.Web/sites`) is available in the
+// specified Location, using the Resource Types and Locations returned when listing the Resource Providers.
+//
+// NOTE: this is best-effort - if enhanced validation is disabled, or the Resource Type (or its Locations) aren't
+// known, then this isn't validated
+func ValidateResourceTypeLocation(resourceType string, loc string) error {
+	if !enhancedEnabled || loc == "" {
+		return nil
+	}
+
+	cacheLock.Lock()
+	locations, ok := resourceTypeLocations[strings.ToLower(resourceType)]
+	cacheLock.Unlock()
+	if !ok || len(locations) == 0 {
+		return nil
+	}
+
+	// Resource Types which aren't tied to a region are returned as being available in `global`
+	if _, ok := locations["global"]; ok {
+		return nil
+	}
+
+	if _, ok := locations[location.Normalize(loc)]; ok {
+		return nil
+	}
+
+	supported := make([]string, 0, len(locations))
+	for k := range locations {
+		supported = append(supported, k)
+	}
+	sort.Strings(supported)
+	return fmt.Errorf("the Resource Type %q is not available in the Location %q, the Locations available in this Subscription are: %s", resourceType, loc, strings.Join(supported, ", "))
+}
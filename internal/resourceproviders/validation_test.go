@@ -87,3 +87,87 @@ func TestEnhancedValidationEnabled(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateResourceTypeLocation(t *testing.T) {
+	testCases := []struct {
+		resourceType string
+		location     string
+		enabled      bool
+		valid        bool
+	}{
+		{
+			resourceType: "Microsoft.Web/sites",
+			location:     "westeurope",
+			enabled:      true,
+			valid:        true,
+		},
+		{
+			resourceType: "microsoft.web/Sites",
+			location:     "West Europe",
+			enabled:      true,
+			valid:        true,
+		},
+		{
+			resourceType: "Microsoft.Web/sites",
+			location:     "westus",
+			enabled:      true,
+			valid:        false,
+		},
+		{
+			resourceType: "Microsoft.Web/sites",
+			location:     "westus",
+			enabled:      false,
+			valid:        true,
+		},
+		{
+			// the Location may not be known at plan time
+			resourceType: "Microsoft.Web/sites",
+			location:     "",
+			enabled:      true,
+			valid:        true,
+		},
+		{
+			resourceType: "Microsoft.Web/certificates",
+			location:     "westus",
+			enabled:      true,
+			valid:        true,
+		},
+		{
+			resourceType: "Microsoft.Web/unknown",
+			location:     "westus",
+			enabled:      true,
+			valid:        true,
+		},
+	}
+
+	setCache([]cachedResourceProviderModel{
+		{
+			Namespace:  "Microsoft.Web",
+			Registered: true,
+			ResourceTypes: []cachedResourceTypeModel{
+				{
+					ResourceType: "sites",
+					Locations:    []string{"West Europe", "North Europe"},
+				},
+				{
+					ResourceType: "certificates",
+					Locations:    []string{"global"},
+				},
+			},
+		},
+	})
+	defer func() {
+		enhancedEnabled = features.EnhancedValidationEnabled()
+		ClearCache()
+	}()
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %q in %q..", testCase.resourceType, testCase.location)
+
+		enhancedEnabled = testCase.enabled
+		err := ValidateResourceTypeLocation(testCase.resourceType, testCase.location)
+		if valid := err == nil; testCase.valid != valid {
+			t.Errorf("Expected %t but got %t (%v)", testCase.valid, valid, err)
+		}
+	}
+}
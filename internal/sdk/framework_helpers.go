@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+const frameworkTimeoutsBlockName = "timeouts"
+
+// frameworkResourceTimeoutsBlock returns the `timeouts` block for a FrameworkResource, matching the
+// `timeouts` block exposed by the Plugin SDK for a Resource
+func frameworkResourceTimeoutsBlock(supportsUpdate bool) resourceschema.Block {
+	attributes := map[string]resourceschema.Attribute{
+		"create": resourceschema.StringAttribute{
+			Optional: true,
+		},
+		"read": resourceschema.StringAttribute{
+			Optional: true,
+		},
+		"delete": resourceschema.StringAttribute{
+			Optional: true,
+		},
+	}
+	if supportsUpdate {
+		attributes["update"] = resourceschema.StringAttribute{
+			Optional: true,
+		}
+	}
+
+	return resourceschema.SingleNestedBlock{
+		Attributes: attributes,
+	}
+}
+
+// frameworkDataSourceTimeoutsBlock returns the `timeouts` block for a FrameworkDataSource, matching the
+// `timeouts` block exposed by the Plugin SDK for a Data Source
+func frameworkDataSourceTimeoutsBlock() datasourceschema.Block {
+	return datasourceschema.SingleNestedBlock{
+		Attributes: map[string]datasourceschema.Attribute{
+			"read": datasourceschema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+type frameworkAttributeGetter func(ctx context.Context, path path.Path, target interface{}) error
+
+// frameworkTimeout returns the timeout configured by the user in the `timeouts` block for the specified
+// operation, falling back to the `default_timeouts` configured in the Provider block for the Resource Type
+// (when client is set) and then to the default timeout defined by the Resource/Data Source
+func frameworkTimeout(ctx context.Context, getter frameworkAttributeGetter, client *clients.Client, resourceType string, operation string, defaultTimeout time.Duration) (time.Duration, error) {
+	var value types.String
+	if err := getter(ctx, path.Root(frameworkTimeoutsBlockName).AtName(operation), &value); err != nil {
+		return 0, fmt.Errorf("retrieving the %q timeout: %+v", operation, err)
+	}
+
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		if client != nil {
+			return client.TimeoutDefaults.ResolveOperation(resourceType, operation, defaultTimeout), nil
+		}
+		return defaultTimeout, nil
+	}
+
+	timeout, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		return 0, fmt.Errorf("parsing the %q timeout %q: %+v", operation, value.ValueString(), err)
+	}
+
+	return timeout, nil
+}
+
+// withoutTimeouts returns the specified object value without the `timeouts` block, as the specified type
+func withoutTimeouts(input tftypes.Value, objectType tftypes.Type) (tftypes.Value, error) {
+	if input.IsNull() || !input.IsKnown() {
+		return tftypes.NewValue(objectType, nil), nil
+	}
+
+	existing := make(map[string]tftypes.Value)
+	if err := input.As(&existing); err != nil {
+		return tftypes.Value{}, fmt.Errorf("converting value to an object: %+v", err)
+	}
+
+	// NOTE: the map returned from `As` is shared with the input value, so must be copied rather than modified
+	attributes := make(map[string]tftypes.Value, len(existing))
+	for k, v := range existing {
+		if k != frameworkTimeoutsBlockName {
+			attributes[k] = v
+		}
+	}
+
+	return tftypes.NewValue(objectType, attributes), nil
+}
+
+// withTimeouts returns the specified object value with the `timeouts` block taken from `timeoutsSource`,
+// as the specified type (which must contain the `timeouts` block)
+func withTimeouts(input tftypes.Value, timeoutsSource tftypes.Value, objectType tftypes.Type) (tftypes.Value, error) {
+	object, ok := objectType.(tftypes.Object)
+	if !ok {
+		return tftypes.Value{}, fmt.Errorf("internal-error: expected an object type but got %s", objectType)
+	}
+
+	existing := make(map[string]tftypes.Value)
+	if err := input.As(&existing); err != nil {
+		return tftypes.Value{}, fmt.Errorf("converting value to an object: %+v", err)
+	}
+
+	attributes := make(map[string]tftypes.Value, len(existing)+1)
+	for k, v := range existing {
+		attributes[k] = v
+	}
+
+	timeouts := tftypes.NewValue(object.AttributeTypes[frameworkTimeoutsBlockName], nil)
+	if !timeoutsSource.IsNull() && timeoutsSource.IsKnown() {
+		source := make(map[string]tftypes.Value)
+		if err := timeoutsSource.As(&source); err != nil {
+			return tftypes.Value{}, fmt.Errorf("converting value to an object: %+v", err)
+		}
+		if v, ok := source[frameworkTimeoutsBlockName]; ok {
+			timeouts = v
+		}
+	}
+	attributes[frameworkTimeoutsBlockName] = timeouts
+
+	return tftypes.NewValue(objectType, attributes), nil
+}
+
+// retainTimeouts sets the `timeouts` block in the State to the value from the Config/Plan/prior State, since this is
+// managed by the wrapper rather than the FrameworkResource/FrameworkDataSource
+func retainTimeouts(ctx context.Context, state *tfsdk.State, source tftypes.Value, diags *diag.Diagnostics) {
+	// the resource has been removed from the State, e.g. as it no longer exists
+	if state.Raw.IsNull() {
+		return
+	}
+
+	value, err := withTimeouts(state.Raw, source, state.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		diags.AddError("setting `timeouts`", err.Error())
+		return
+	}
+	state.Raw = value
+}
+
+func planGetter(plan tfsdk.Plan) frameworkAttributeGetter {
+	return func(ctx context.Context, path path.Path, target interface{}) error {
+		return diagnosticsToError(plan.GetAttribute(ctx, path, target))
+	}
+}
+
+func stateGetter(state tfsdk.State) frameworkAttributeGetter {
+	return func(ctx context.Context, path path.Path, target interface{}) error {
+		return diagnosticsToError(state.GetAttribute(ctx, path, target))
+	}
+}
+
+func configGetter(config tfsdk.Config) frameworkAttributeGetter {
+	return func(ctx context.Context, path path.Path, target interface{}) error {
+		return diagnosticsToError(config.GetAttribute(ctx, path, target))
+	}
+}
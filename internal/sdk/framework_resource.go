@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// A FrameworkDataSource is a Data Source built natively on the Terraform Plugin Framework, rather than
+// on top of the Plugin SDK - allowing the use of Framework-only features such as Nested Attributes
+type FrameworkDataSource interface {
+	// ResourceType is the exposed name of this Data Source (e.g. `azurerm_example`)
+	ResourceType() string
+
+	// Schema returns the Plugin Framework Schema for this Data Source
+	// NOTE: the `timeouts` block is added automatically and shouldn't be defined here, nor in the Model
+	Schema(ctx context.Context) datasourceschema.Schema
+
+	// Read is a FrameworkResourceFunc which looks up and sets field values into the Terraform State
+	Read() FrameworkResourceFunc
+}
+
+// A FrameworkResource is a Resource built natively on the Terraform Plugin Framework, rather than
+// on top of the Plugin SDK - allowing the use of Framework-only features such as Nested Attributes
+// and Plan Modifiers
+//
+// The `timeouts` block, the Client and importing using the `id` field are handled by the wrapper,
+// in the same way as for a Resource
+type FrameworkResource interface {
+	// ResourceType is the exposed name of this resource (e.g. `azurerm_example`)
+	ResourceType() string
+
+	// Schema returns the Plugin Framework Schema for this Resource, which must contain an `id` attribute
+	// NOTE: the `timeouts` block is added automatically and shouldn't be defined here, nor in the Model
+	Schema(ctx context.Context) resourceschema.Schema
+
+	// Create will provision this resource using the information from the Terraform Plan
+	// NOTE: the wrapper will automatically call the Read function once this has been created
+	Create() FrameworkResourceFunc
+
+	// Read retrieves the latest values for this object and saves them into Terraform's State
+	Read() FrameworkResourceFunc
+
+	// Delete will remove an existing resource using the information available in Terraform's State
+	Delete() FrameworkResourceFunc
+
+	// IDValidationFunc returns the SchemaValidateFunc used to validate the ID is valid during
+	// `terraform import` - ensuring users don't inadvertently specify the incorrect Resource ID
+	IDValidationFunc() pluginsdk.SchemaValidateFunc
+}
+
+// FrameworkResourceWithUpdate is an optional interface
+//
+// Notably FrameworkResources not implementing this interface must mark every Argument
+// as requiring replacement using a Plan Modifier
+type FrameworkResourceWithUpdate interface {
+	FrameworkResource
+
+	// Update will make changes to this resource using the information from the Terraform Plan
+	// NOTE: the wrapper will automatically call the Read function once this has been updated
+	Update() FrameworkResourceFunc
+}
+
+// FrameworkResourceRunFunc is the function which can be run
+// ctx provides a Context instance with the user-provided timeout
+// metadata is a reference to an object containing the Client, Config/Plan/State and a Logger
+type FrameworkResourceRunFunc func(ctx context.Context, metadata FrameworkResourceMetaData) error
+
+type FrameworkResourceFunc struct {
+	// Func is the function which should be called for this Resource Func
+	// for example, during Read this is the Read function, during Update this is the Update function
+	Func FrameworkResourceRunFunc
+
+	// Timeout is the default timeout, which can be overridden by users
+	// for this method - in-turn used for the Azure API
+	Timeout time.Duration
+}
+
+type FrameworkResourceMetaData struct {
+	// Client is a reference to the Azure Providers Client - providing a typed reference to this object
+	Client *clients.Client
+
+	// Logger provides a logger for debug purposes
+	Logger Logger
+
+	// Config is the Terraform Configuration for this Resource/Data Source
+	Config tfsdk.Config
+
+	// Plan is the Terraform Plan for this Resource, which is only populated during Create and Update
+	Plan tfsdk.Plan
+
+	// State is the Terraform State for this Resource/Data Source, which is written to by `Encode`
+	State *tfsdk.State
+
+	// model contains the Schema defined by the Resource/Data Source, which excludes the `timeouts` block
+	model tfsdk.State
+}
+
+// Decode decodes the Plan (during Create/Update), the State (during Read/Delete) or the Config (for Data Sources)
+// into the specified Model, which must contain a `tfsdk` tag for every field in the Schema, excluding `timeouts`
+//
+// Alternatively the Model can use `tfschema` tags in the same manner as a Resource, allowing the same Model
+// to be used when a Resource is moved to the Plugin Framework (see TypedSchema)
+func (m FrameworkResourceMetaData) Decode(ctx context.Context, target interface{}) error {
+	raw := m.Config.Raw
+	if !m.Plan.Raw.IsNull() {
+		raw = m.Plan.Raw
+	} else if m.State != nil && !m.State.Raw.IsNull() {
+		raw = m.State.Raw
+	}
+
+	value, err := withoutTimeouts(raw, m.model.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		return err
+	}
+
+	if usesTFSchemaTags(target) {
+		retriever, err := newFrameworkStateRetriever(ctx, m.model.Schema.Type(), value)
+		if err != nil {
+			return fmt.Errorf("converting the value: %+v", err)
+		}
+		return decodeReflectedType(target, retriever, NullLogger{})
+	}
+
+	model := tfsdk.State{
+		Raw:    value,
+		Schema: m.model.Schema,
+	}
+	return diagnosticsToError(model.Get(ctx, target))
+}
+
+// Encode encodes the specified Model, using either `tfsdk` or `tfschema` tags, into the Terraform State
+func (m FrameworkResourceMetaData) Encode(ctx context.Context, input interface{}) error {
+	if m.State == nil {
+		return fmt.Errorf("internal-error: the State is not available to be encoded into")
+	}
+
+	model := tfsdk.State{
+		Raw:    tftypes.NewValue(m.model.Schema.Type().TerraformType(ctx), nil),
+		Schema: m.model.Schema,
+	}
+	if usesTFSchemaTags(input) {
+		serialized, err := recurse(reflect.TypeOf(input).Elem(), reflect.ValueOf(input).Elem(), NullLogger{})
+		if err != nil {
+			return err
+		}
+
+		value, err := frameworkValueFromPluginSdk(ctx, m.model.Schema.Type(), serialized)
+		if err != nil {
+			return fmt.Errorf("converting the value: %+v", err)
+		}
+		model.Raw = value
+	} else if err := diagnosticsToError(model.Set(ctx, input)); err != nil {
+		return err
+	}
+
+	// the `timeouts` block is managed by the wrapper, so any existing value is retained
+	value, err := withTimeouts(model.Raw, m.State.Raw, m.State.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		return err
+	}
+
+	m.State.Raw = value
+	return nil
+}
+
+// SetID sets the ID of this resource into the Terraform State, which is used by Read once this has been Created
+func (m FrameworkResourceMetaData) SetID(ctx context.Context, formatter resourceids.Id) error {
+	if m.State == nil {
+		return fmt.Errorf("internal-error: the State is not available to set the ID into")
+	}
+
+	return diagnosticsToError(m.State.SetAttribute(ctx, path.Root("id"), formatter.ID()))
+}
+
+// MarkAsGone marks this resource as removed in the Remote API, so this is no longer available
+func (m FrameworkResourceMetaData) MarkAsGone(ctx context.Context, idFormatter resourceids.Id) error {
+	m.Logger.Infof("[DEBUG] %s was not found - removing from state", idFormatter)
+	m.State.RemoveResource(ctx)
+	return nil
+}
+
+// ResourceRequiresImport returns an error saying that this resource must be imported with instructions
+// on how to do this (namely, using `terraform import`
+func (m FrameworkResourceMetaData) ResourceRequiresImport(resourceName string, idFormatter resourceids.Id) error {
+	resourceId := idFormatter.ID()
+	return tf.ImportAsExistsError(resourceName, resourceId)
+}
+
+func diagnosticsToError(diags diag.Diagnostics) error {
+	if !diags.HasError() {
+		return nil
+	}
+
+	errors := make([]string, 0)
+	for _, v := range diags.Errors() {
+		errors = append(errors, fmt.Sprintf("%s: %s", v.Summary(), v.Detail()))
+	}
+
+	return fmt.Errorf("%s", strings.Join(errors, "\n"))
+}
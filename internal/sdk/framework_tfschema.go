@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// This file allows Models using `tfschema` struct tags to be decoded from/encoded into Plugin Framework values,
+// by converting these values to/from the same representation used by the Plugin SDK - meaning that the same
+// Model can be used with both a Resource and a FrameworkResource.
+
+var _ stateRetriever = frameworkStateRetriever{}
+
+// frameworkStateRetriever exposes the top-level fields within a Plugin Framework value in the same
+// representation as the Plugin SDK, so that this can be decoded using decodeReflectedType
+type frameworkStateRetriever struct {
+	values map[string]interface{}
+}
+
+func newFrameworkStateRetriever(ctx context.Context, objectType attr.Type, input tftypes.Value) (*frameworkStateRetriever, error) {
+	values := make(map[string]interface{})
+
+	value, err := frameworkValueToPluginSdk(ctx, objectType, input)
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := value.(map[string]interface{}); ok {
+		values = v
+	}
+
+	return &frameworkStateRetriever{
+		values: values,
+	}, nil
+}
+
+func (f frameworkStateRetriever) Get(key string) interface{} {
+	return f.values[key]
+}
+
+func (f frameworkStateRetriever) GetOk(key string) (interface{}, bool) {
+	v, ok := f.GetOkExists(key)
+	if !ok {
+		return nil, false
+	}
+
+	return v, !reflect.ValueOf(v).IsZero()
+}
+
+func (f frameworkStateRetriever) GetOkExists(key string) (interface{}, bool) {
+	// nested values are looked up using the same path as the Plugin SDK (e.g. `block.0.field`)
+	var v interface{} = f.values
+	for _, segment := range strings.Split(key, ".") {
+		switch t := v.(type) {
+		case map[string]interface{}:
+			v = t[segment]
+		case []interface{}:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(t) {
+				return nil, false
+			}
+			v = t[index]
+		default:
+			return nil, false
+		}
+	}
+	return v, v != nil
+}
+
+// frameworkValueToPluginSdk converts the Plugin Framework value into the representation used by the Plugin SDK,
+// for example a List Nested Block becomes a []interface{} containing a map[string]interface{} for each item
+func frameworkValueToPluginSdk(ctx context.Context, valueType attr.Type, input tftypes.Value) (interface{}, error) {
+	if input.IsNull() || !input.IsKnown() {
+		return nil, nil
+	}
+
+	switch t := valueType.(type) {
+	case basetypes.BoolType:
+		var v bool
+		if err := input.As(&v); err != nil {
+			return nil, err
+		}
+		return v, nil
+
+	case basetypes.Float64Type:
+		var v big.Float
+		if err := input.As(&v); err != nil {
+			return nil, err
+		}
+		f, _ := v.Float64()
+		return f, nil
+
+	case basetypes.Int64Type:
+		var v big.Float
+		if err := input.As(&v); err != nil {
+			return nil, err
+		}
+		// the Plugin SDK exposes integers as an `int`
+		i, _ := v.Int64()
+		return int(i), nil
+
+	case basetypes.StringType:
+		var v string
+		if err := input.As(&v); err != nil {
+			return nil, err
+		}
+		return v, nil
+
+	case basetypes.ListType, basetypes.SetType:
+		elementType := t.(attr.TypeWithElementType).ElementType()
+		items := make([]tftypes.Value, 0)
+		if err := input.As(&items); err != nil {
+			return nil, err
+		}
+
+		out := make([]interface{}, 0, len(items))
+		for i, item := range items {
+			v, err := frameworkValueToPluginSdk(ctx, elementType, item)
+			if err != nil {
+				return nil, fmt.Errorf("converting item %d: %+v", i, err)
+			}
+			out = append(out, v)
+		}
+		return out, nil
+
+	case basetypes.MapType:
+		items := make(map[string]tftypes.Value)
+		if err := input.As(&items); err != nil {
+			return nil, err
+		}
+
+		out := make(map[string]interface{}, len(items))
+		for k, item := range items {
+			v, err := frameworkValueToPluginSdk(ctx, t.ElemType, item)
+			if err != nil {
+				return nil, fmt.Errorf("converting the key %q: %+v", k, err)
+			}
+			out[k] = v
+		}
+		return out, nil
+
+	case basetypes.ObjectType:
+		fields := make(map[string]tftypes.Value)
+		if err := input.As(&fields); err != nil {
+			return nil, err
+		}
+
+		out := make(map[string]interface{}, len(fields))
+		for k, fieldType := range t.AttrTypes {
+			field, ok := fields[k]
+			if !ok {
+				continue
+			}
+			v, err := frameworkValueToPluginSdk(ctx, fieldType, field)
+			if err != nil {
+				return nil, fmt.Errorf("converting %q: %+v", k, err)
+			}
+			out[k] = v
+		}
+		return out, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", valueType)
+}
+
+// frameworkValueFromPluginSdk converts the representation used by the Plugin SDK (as output from `recurse`)
+// into a Plugin Framework value of the specified type
+func frameworkValueFromPluginSdk(ctx context.Context, valueType attr.Type, input interface{}) (tftypes.Value, error) {
+	terraformType := valueType.TerraformType(ctx)
+	if input == nil {
+		return tftypes.NewValue(terraformType, nil), nil
+	}
+
+	switch t := valueType.(type) {
+	case basetypes.BoolType:
+		v, ok := input.(bool)
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected a bool but got %T", input)
+		}
+		return tftypes.NewValue(terraformType, v), nil
+
+	case basetypes.Float64Type:
+		v, ok := input.(float64)
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected a float64 but got %T", input)
+		}
+		return tftypes.NewValue(terraformType, big.NewFloat(v)), nil
+
+	case basetypes.Int64Type:
+		switch v := input.(type) {
+		case int:
+			return tftypes.NewValue(terraformType, new(big.Float).SetInt64(int64(v))), nil
+		case int64:
+			return tftypes.NewValue(terraformType, new(big.Float).SetInt64(v)), nil
+		}
+		return tftypes.Value{}, fmt.Errorf("expected an int64 but got %T", input)
+
+	case basetypes.StringType:
+		v, ok := input.(string)
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected a string but got %T", input)
+		}
+		return tftypes.NewValue(terraformType, v), nil
+
+	case basetypes.ListType, basetypes.SetType:
+		elementType := t.(attr.TypeWithElementType).ElementType()
+		items := reflect.ValueOf(input)
+		if items.Kind() != reflect.Slice {
+			return tftypes.Value{}, fmt.Errorf("expected a slice but got %T", input)
+		}
+
+		out := make([]tftypes.Value, 0, items.Len())
+		for i := 0; i < items.Len(); i++ {
+			v, err := frameworkValueFromPluginSdk(ctx, elementType, items.Index(i).Interface())
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("converting item %d: %+v", i, err)
+			}
+			out = append(out, v)
+		}
+		return tftypes.NewValue(terraformType, out), nil
+
+	case basetypes.MapType:
+		items := reflect.ValueOf(input)
+		if items.Kind() != reflect.Map {
+			return tftypes.Value{}, fmt.Errorf("expected a map but got %T", input)
+		}
+
+		out := make(map[string]tftypes.Value, items.Len())
+		iter := items.MapRange()
+		for iter.Next() {
+			key := iter.Key().String()
+			v, err := frameworkValueFromPluginSdk(ctx, t.ElemType, iter.Value().Interface())
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("converting the key %q: %+v", key, err)
+			}
+			out[key] = v
+		}
+		return tftypes.NewValue(terraformType, out), nil
+
+	case basetypes.ObjectType:
+		fields, ok := input.(map[string]interface{})
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected a map[string]interface{} but got %T", input)
+		}
+
+		for k := range fields {
+			if _, ok := t.AttrTypes[k]; !ok {
+				return tftypes.Value{}, fmt.Errorf("%q was not found in the schema", k)
+			}
+		}
+
+		out := make(map[string]tftypes.Value, len(t.AttrTypes))
+		for k, fieldType := range t.AttrTypes {
+			v, err := frameworkValueFromPluginSdk(ctx, fieldType, fields[k])
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("converting %q: %+v", k, err)
+			}
+			out[k] = v
+		}
+		return tftypes.NewValue(terraformType, out), nil
+	}
+
+	return tftypes.Value{}, fmt.Errorf("unsupported type %s", valueType)
+}
+
+// usesTFSchemaTags determines whether the specified Model uses `tfschema` struct tags (rather than the
+// `tfsdk` struct tags used by the Plugin Framework)
+func usesTFSchemaTags(input interface{}) bool {
+	objType := reflect.TypeOf(input)
+	if objType == nil || objType.Kind() != reflect.Ptr || objType.Elem().Kind() != reflect.Struct {
+		return false
+	}
+
+	objType = objType.Elem()
+	for i := 0; i < objType.NumField(); i++ {
+		if _, ok := objType.Field(i).Tag.Lookup("tfschema"); ok {
+			return true
+		}
+	}
+
+	return false
+}
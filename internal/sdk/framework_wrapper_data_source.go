@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// FrameworkDataSourceWrapper is a wrapper for converting a FrameworkDataSource implementation
+// into the object used by the Terraform Plugin Framework
+type FrameworkDataSourceWrapper struct {
+	client     *clients.Client
+	dataSource FrameworkDataSource
+}
+
+var (
+	_ datasource.DataSource              = &FrameworkDataSourceWrapper{}
+	_ datasource.DataSourceWithConfigure = &FrameworkDataSourceWrapper{}
+)
+
+// NewFrameworkDataSourceWrapper returns a function returning a FrameworkDataSourceWrapper for this
+// FrameworkDataSource implementation, as used by the Terraform Plugin Framework
+func NewFrameworkDataSourceWrapper(ds FrameworkDataSource) func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &FrameworkDataSourceWrapper{
+			dataSource: ds,
+		}
+	}
+}
+
+func (dw *FrameworkDataSourceWrapper) Metadata(_ context.Context, _ datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = dw.dataSource.ResourceType()
+}
+
+func (dw *FrameworkDataSourceWrapper) Schema(ctx context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	dataSourceSchema := dw.dataSource.Schema(ctx)
+
+	if _, ok := dataSourceSchema.Attributes[frameworkTimeoutsBlockName]; ok {
+		response.Diagnostics.AddError("building Schema", fmt.Sprintf("Data Source %q must not define a `timeouts` attribute since this is added automatically", dw.dataSource.ResourceType()))
+		return
+	}
+	if _, ok := dataSourceSchema.Blocks[frameworkTimeoutsBlockName]; ok {
+		response.Diagnostics.AddError("building Schema", fmt.Sprintf("Data Source %q must not define a `timeouts` block since this is added automatically", dw.dataSource.ResourceType()))
+		return
+	}
+
+	blocks := make(map[string]schema.Block, len(dataSourceSchema.Blocks)+1)
+	for k, v := range dataSourceSchema.Blocks {
+		blocks[k] = v
+	}
+	blocks[frameworkTimeoutsBlockName] = frameworkDataSourceTimeoutsBlock()
+	dataSourceSchema.Blocks = blocks
+
+	response.Schema = dataSourceSchema
+}
+
+func (dw *FrameworkDataSourceWrapper) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	// the Provider hasn't been configured yet, e.g. during validation
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*clients.Client)
+	if !ok || client == nil {
+		response.Diagnostics.AddError("configuring Data Source", fmt.Sprintf("internal-error: expected the Provider Data to be a *clients.Client but got %T", request.ProviderData))
+		return
+	}
+
+	dw.client = client
+}
+
+func (dw *FrameworkDataSourceWrapper) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	ctx, client, done := frameworkOperation(ctx, dw.client, dw.dataSource.ResourceType(), "read")
+	defer func() { done(response.State, response.Diagnostics) }()
+
+	// the `default_timeouts` configured in the Provider block only apply to Resources
+	timeout, err := frameworkTimeout(ctx, configGetter(request.Config), nil, dw.dataSource.ResourceType(), "read", dw.dataSource.Read().Timeout)
+	if err != nil {
+		response.Diagnostics.AddError("reading", err.Error())
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	metadata := FrameworkResourceMetaData{
+		Client: client,
+		Logger: &FrameworkDiagnosticsLogger{
+			diagnostics: &response.Diagnostics,
+		},
+		Config: request.Config,
+		State:  &response.State,
+		model: tfsdk.State{
+			Schema: dw.dataSource.Schema(ctx),
+		},
+	}
+	if err := dw.dataSource.Read().Func(ctx, metadata); err != nil {
+		response.Diagnostics.AddError("reading", err.Error())
+		return
+	}
+
+	retainTimeouts(ctx, &response.State, request.Config.Raw, &response.Diagnostics)
+}
@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// FrameworkResourceWrapper is a wrapper for converting a FrameworkResource implementation
+// into the object used by the Terraform Plugin Framework
+type FrameworkResourceWrapper struct {
+	client   *clients.Client
+	resource FrameworkResource
+}
+
+var (
+	_ resource.Resource                = &FrameworkResourceWrapper{}
+	_ resource.ResourceWithConfigure   = &FrameworkResourceWrapper{}
+	_ resource.ResourceWithImportState = &FrameworkResourceWrapper{}
+)
+
+// NewFrameworkResourceWrapper returns a function returning a FrameworkResourceWrapper for this
+// FrameworkResource implementation, as used by the Terraform Plugin Framework
+func NewFrameworkResourceWrapper(r FrameworkResource) func() resource.Resource {
+	return func() resource.Resource {
+		return &FrameworkResourceWrapper{
+			resource: r,
+		}
+	}
+}
+
+func (rw *FrameworkResourceWrapper) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = rw.resource.ResourceType()
+}
+
+func (rw *FrameworkResourceWrapper) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	resourceSchema := rw.resource.Schema(ctx)
+
+	if _, ok := resourceSchema.Attributes["id"]; !ok {
+		response.Diagnostics.AddError("building Schema", fmt.Sprintf("Resource %q must define an `id` attribute", rw.resource.ResourceType()))
+		return
+	}
+	if _, ok := resourceSchema.Attributes[frameworkTimeoutsBlockName]; ok {
+		response.Diagnostics.AddError("building Schema", fmt.Sprintf("Resource %q must not define a `timeouts` attribute since this is added automatically", rw.resource.ResourceType()))
+		return
+	}
+	if _, ok := resourceSchema.Blocks[frameworkTimeoutsBlockName]; ok {
+		response.Diagnostics.AddError("building Schema", fmt.Sprintf("Resource %q must not define a `timeouts` block since this is added automatically", rw.resource.ResourceType()))
+		return
+	}
+
+	blocks := make(map[string]schema.Block, len(resourceSchema.Blocks)+1)
+	for k, v := range resourceSchema.Blocks {
+		blocks[k] = v
+	}
+	_, supportsUpdate := rw.resource.(FrameworkResourceWithUpdate)
+	blocks[frameworkTimeoutsBlockName] = frameworkResourceTimeoutsBlock(supportsUpdate)
+	resourceSchema.Blocks = blocks
+
+	response.Schema = resourceSchema
+}
+
+func (rw *FrameworkResourceWrapper) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	// the Provider hasn't been configured yet, e.g. during validation
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*clients.Client)
+	if !ok || client == nil {
+		response.Diagnostics.AddError("configuring Resource", fmt.Sprintf("internal-error: expected the Provider Data to be a *clients.Client but got %T", request.ProviderData))
+		return
+	}
+
+	rw.client = client
+}
+
+func (rw *FrameworkResourceWrapper) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	ctx, client, done := frameworkOperation(ctx, rw.client, rw.resource.ResourceType(), "create")
+	defer func() { done(response.State, response.Diagnostics) }()
+
+	timeout, err := frameworkTimeout(ctx, planGetter(request.Plan), rw.client, rw.resource.ResourceType(), "create", rw.resource.Create().Timeout)
+	if err != nil {
+		response.Diagnostics.AddError("creating", err.Error())
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	metadata := rw.metadata(ctx, client, request.Config, request.Plan, &response.State, &response.Diagnostics)
+	if err := rw.resource.Create().Func(ctx, metadata); err != nil {
+		response.Diagnostics.AddError("creating", err.Error())
+		return
+	}
+
+	// NOTE: whilst this may look like we should use the Read
+	// functions timeout here, we're still /technically/ in the
+	// Create function so reusing that timeout should be sufficient
+	metadata.Plan = tfsdk.Plan{}
+	if err := rw.resource.Read().Func(ctx, metadata); err != nil {
+		response.Diagnostics.AddError("reading", err.Error())
+		return
+	}
+
+	retainTimeouts(ctx, &response.State, request.Plan.Raw, &response.Diagnostics)
+}
+
+func (rw *FrameworkResourceWrapper) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	ctx, client, done := frameworkOperation(ctx, rw.client, rw.resource.ResourceType(), "read")
+	defer func() { done(request.State, response.Diagnostics) }()
+
+	timeout, err := frameworkTimeout(ctx, stateGetter(request.State), rw.client, rw.resource.ResourceType(), "read", rw.resource.Read().Timeout)
+	if err != nil {
+		response.Diagnostics.AddError("reading", err.Error())
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	metadata := rw.metadata(ctx, client, tfsdk.Config{}, tfsdk.Plan{}, &response.State, &response.Diagnostics)
+	if err := rw.resource.Read().Func(ctx, metadata); err != nil {
+		response.Diagnostics.AddError("reading", err.Error())
+		return
+	}
+
+	retainTimeouts(ctx, &response.State, request.State.Raw, &response.Diagnostics)
+}
+
+func (rw *FrameworkResourceWrapper) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	v, ok := rw.resource.(FrameworkResourceWithUpdate)
+	if !ok {
+		response.Diagnostics.AddError("updating", fmt.Sprintf("internal-error: Resource %q doesn't support Update - every Argument should require replacement", rw.resource.ResourceType()))
+		return
+	}
+
+	ctx, client, done := frameworkOperation(ctx, rw.client, rw.resource.ResourceType(), "update")
+	defer func() { done(request.State, response.Diagnostics) }()
+
+	timeout, err := frameworkTimeout(ctx, planGetter(request.Plan), rw.client, rw.resource.ResourceType(), "update", v.Update().Timeout)
+	if err != nil {
+		response.Diagnostics.AddError("updating", err.Error())
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	metadata := rw.metadata(ctx, client, request.Config, request.Plan, &response.State, &response.Diagnostics)
+	if err := v.Update().Func(ctx, metadata); err != nil {
+		response.Diagnostics.AddError("updating", err.Error())
+		return
+	}
+
+	// whilst this may look like we should use the Update timeout here
+	// we're still "technically" in the update method, so reusing the
+	// Update's timeout should be fine
+	metadata.Plan = tfsdk.Plan{}
+	if err := rw.resource.Read().Func(ctx, metadata); err != nil {
+		response.Diagnostics.AddError("reading", err.Error())
+		return
+	}
+
+	retainTimeouts(ctx, &response.State, request.Plan.Raw, &response.Diagnostics)
+}
+
+func (rw *FrameworkResourceWrapper) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	ctx, client, done := frameworkOperation(ctx, rw.client, rw.resource.ResourceType(), "delete")
+	defer func() { done(request.State, response.Diagnostics) }()
+
+	timeout, err := frameworkTimeout(ctx, stateGetter(request.State), rw.client, rw.resource.ResourceType(), "delete", rw.resource.Delete().Timeout)
+	if err != nil {
+		response.Diagnostics.AddError("deleting", err.Error())
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	state := request.State
+	metadata := rw.metadata(ctx, client, tfsdk.Config{}, tfsdk.Plan{}, &state, &response.Diagnostics)
+	if err := rw.resource.Delete().Func(ctx, metadata); err != nil {
+		response.Diagnostics.AddError("deleting", err.Error())
+	}
+}
+
+func (rw *FrameworkResourceWrapper) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	warnings, errors := rw.resource.IDValidationFunc()(request.ID, "id")
+	for _, warning := range warnings {
+		response.Diagnostics.AddWarning("importing", warning)
+	}
+	for _, err := range errors {
+		response.Diagnostics.AddError("importing", err.Error())
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
+func (rw *FrameworkResourceWrapper) metadata(ctx context.Context, client *clients.Client, config tfsdk.Config, plan tfsdk.Plan, state *tfsdk.State, diags *diag.Diagnostics) FrameworkResourceMetaData {
+	return FrameworkResourceMetaData{
+		Client: client,
+		Logger: &FrameworkDiagnosticsLogger{
+			diagnostics: diags,
+		},
+		Config: config,
+		Plan:   plan,
+		State:  state,
+		model: tfsdk.State{
+			Schema: rw.resource.Schema(ctx),
+		},
+	}
+}
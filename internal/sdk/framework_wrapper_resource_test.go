@@ -0,0 +1,353 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+type testFrameworkResourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+type testFrameworkResource struct {
+	exists bool
+}
+
+var _ FrameworkResource = &testFrameworkResource{}
+
+func (r *testFrameworkResource) ResourceType() string {
+	return "azurerm_framework_example"
+}
+
+func (r *testFrameworkResource) Schema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *testFrameworkResource) Create() FrameworkResourceFunc {
+	return FrameworkResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata FrameworkResourceMetaData) error {
+			if metadata.Client == nil {
+				return fmt.Errorf("expected the Client to be configured")
+			}
+			if deadline, ok := ctx.Deadline(); !ok || time.Until(deadline) > 10*time.Minute {
+				return fmt.Errorf("expected the `create` timeout of 10m to be used")
+			}
+
+			var model testFrameworkResourceModel
+			if err := metadata.Decode(ctx, &model); err != nil {
+				return err
+			}
+
+			r.exists = true
+			return metadata.SetID(ctx, commonids.NewResourceGroupID("12345678-1234-9876-4563-123456789012", model.Name.ValueString()))
+		},
+	}
+}
+
+func (r *testFrameworkResource) Read() FrameworkResourceFunc {
+	return FrameworkResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata FrameworkResourceMetaData) error {
+			var model testFrameworkResourceModel
+			if err := metadata.Decode(ctx, &model); err != nil {
+				return err
+			}
+
+			id, err := commonids.ParseResourceGroupID(model.ID.ValueString())
+			if err != nil {
+				return err
+			}
+
+			if !r.exists {
+				return metadata.MarkAsGone(ctx, id)
+			}
+
+			return metadata.Encode(ctx, &testFrameworkResourceModel{
+				ID:   types.StringValue(id.ID()),
+				Name: types.StringValue(id.ResourceGroupName),
+			})
+		},
+	}
+}
+
+func (r *testFrameworkResource) Delete() FrameworkResourceFunc {
+	return FrameworkResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata FrameworkResourceMetaData) error {
+			r.exists = false
+			return nil
+		},
+	}
+}
+
+func (r *testFrameworkResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateResourceGroupID
+}
+
+func testFrameworkResourceWrapper(t *testing.T, r FrameworkResource) (resource.Resource, schema.Schema) {
+	return testFrameworkResourceWrapperWithClient(t, r, &clients.Client{})
+}
+
+func testFrameworkResourceWrapperWithClient(t *testing.T, r FrameworkResource, client *clients.Client) (resource.Resource, schema.Schema) {
+	ctx := context.TODO()
+	wrapper := NewFrameworkResourceWrapper(r)()
+
+	configureResponse := resource.ConfigureResponse{}
+	wrapper.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: client}, &configureResponse)
+	if configureResponse.Diagnostics.HasError() {
+		t.Fatalf("configuring: %+v", configureResponse.Diagnostics)
+	}
+
+	schemaResponse := resource.SchemaResponse{}
+	wrapper.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("building schema: %+v", schemaResponse.Diagnostics)
+	}
+
+	return wrapper, schemaResponse.Schema
+}
+
+func TestFrameworkResourceWrapperSchema(t *testing.T) {
+	_, resourceSchema := testFrameworkResourceWrapper(t, &testFrameworkResource{})
+
+	block, ok := resourceSchema.Blocks["timeouts"].(schema.SingleNestedBlock)
+	if !ok {
+		t.Fatalf("expected a `timeouts` block to be added to the schema")
+	}
+
+	for _, v := range []string{"create", "read", "delete"} {
+		if _, ok := block.Attributes[v]; !ok {
+			t.Fatalf("expected the `timeouts` block to contain %q", v)
+		}
+	}
+	if _, ok := block.Attributes["update"]; ok {
+		t.Fatalf("expected the `timeouts` block not to contain `update` for a Resource which doesn't support Update")
+	}
+}
+
+func TestFrameworkResourceWrapperCreateAndDelete(t *testing.T) {
+	ctx := context.TODO()
+	r := &testFrameworkResource{}
+	wrapper, resourceSchema := testFrameworkResourceWrapper(t, r)
+
+	objectType := resourceSchema.Type().TerraformType(ctx).(tftypes.Object)
+	timeoutsType := objectType.AttributeTypes["timeouts"].(tftypes.Object)
+	plan := tfsdk.Plan{
+		Schema: resourceSchema,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"name": tftypes.NewValue(tftypes.String, "example"),
+			"timeouts": tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+				"create": tftypes.NewValue(tftypes.String, "10m"),
+				"read":   tftypes.NewValue(tftypes.String, nil),
+				"delete": tftypes.NewValue(tftypes.String, nil),
+			}),
+		}),
+	}
+
+	createResponse := resource.CreateResponse{
+		State: tfsdk.State{
+			Schema: resourceSchema,
+			Raw:    tftypes.NewValue(objectType, nil),
+		},
+	}
+	wrapper.Create(ctx, resource.CreateRequest{Plan: plan}, &createResponse)
+	if createResponse.Diagnostics.HasError() {
+		t.Fatalf("creating: %+v", createResponse.Diagnostics)
+	}
+
+	var id types.String
+	createResponse.State.GetAttribute(ctx, path.Root("id"), &id)
+	if expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example"; id.ValueString() != expected {
+		t.Fatalf("expected the `id` to be %q but got %q", expected, id.ValueString())
+	}
+
+	var createTimeout types.String
+	createResponse.State.GetAttribute(ctx, path.Root("timeouts").AtName("create"), &createTimeout)
+	if createTimeout.ValueString() != "10m" {
+		t.Fatalf("expected the `create` timeout to be retained in the state but got %q", createTimeout.ValueString())
+	}
+
+	deleteResponse := resource.DeleteResponse{}
+	wrapper.Delete(ctx, resource.DeleteRequest{State: createResponse.State}, &deleteResponse)
+	if deleteResponse.Diagnostics.HasError() {
+		t.Fatalf("deleting: %+v", deleteResponse.Diagnostics)
+	}
+
+	readResponse := resource.ReadResponse{State: createResponse.State}
+	wrapper.Read(ctx, resource.ReadRequest{State: createResponse.State}, &readResponse)
+	if readResponse.Diagnostics.HasError() {
+		t.Fatalf("reading: %+v", readResponse.Diagnostics)
+	}
+	if !readResponse.State.Raw.IsNull() {
+		t.Fatalf("expected the resource to be removed from the state once deleted")
+	}
+}
+
+func TestFrameworkResourceWrapperProviderDefaultTimeouts(t *testing.T) {
+	ctx := context.TODO()
+	createTimeout := 10 * time.Minute
+	client := &clients.Client{
+		TimeoutDefaults: timeouts.ProviderDefaults{
+			ResourceTypes: map[string]timeouts.Defaults{
+				"azurerm_framework_example": {
+					Create: &createTimeout,
+				},
+			},
+		},
+	}
+	wrapper, resourceSchema := testFrameworkResourceWrapperWithClient(t, &testFrameworkResource{}, client)
+
+	// the `default_timeouts` from the Provider block are used when the `timeouts` block doesn't specify a timeout
+	objectType := resourceSchema.Type().TerraformType(ctx).(tftypes.Object)
+	plan := tfsdk.Plan{
+		Schema: resourceSchema,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"name":     tftypes.NewValue(tftypes.String, "example"),
+			"timeouts": tftypes.NewValue(objectType.AttributeTypes["timeouts"], nil),
+		}),
+	}
+
+	createResponse := resource.CreateResponse{
+		State: tfsdk.State{
+			Schema: resourceSchema,
+			Raw:    tftypes.NewValue(objectType, nil),
+		},
+	}
+	wrapper.Create(ctx, resource.CreateRequest{Plan: plan}, &createResponse)
+	if createResponse.Diagnostics.HasError() {
+		t.Fatalf("creating: %+v", createResponse.Diagnostics)
+	}
+}
+
+type testFrameworkCorrelationResource struct {
+	testFrameworkResource
+	contextId     string
+	stopContextId string
+}
+
+func (r *testFrameworkCorrelationResource) Create() FrameworkResourceFunc {
+	return FrameworkResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata FrameworkResourceMetaData) error {
+			r.contextId = common.OperationCorrelationRequestID(ctx)
+			r.stopContextId = common.OperationCorrelationRequestID(metadata.Client.StopContext)
+			return fmt.Errorf("creating example")
+		},
+	}
+}
+
+func TestFrameworkResourceWrapperOperationCorrelation(t *testing.T) {
+	ctx := context.TODO()
+	r := &testFrameworkCorrelationResource{}
+	client := testOperationCorrelationMeta()
+	wrapper, resourceSchema := testFrameworkResourceWrapperWithClient(t, r, client)
+
+	objectType := resourceSchema.Type().TerraformType(ctx).(tftypes.Object)
+	plan := tfsdk.Plan{
+		Schema: resourceSchema,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"name":     tftypes.NewValue(tftypes.String, "example"),
+			"timeouts": tftypes.NewValue(objectType.AttributeTypes["timeouts"], nil),
+		}),
+	}
+
+	createResponse := resource.CreateResponse{
+		State: tfsdk.State{
+			Schema: resourceSchema,
+			Raw:    tftypes.NewValue(objectType, nil),
+		},
+	}
+	wrapper.Create(ctx, resource.CreateRequest{Plan: plan}, &createResponse)
+	if !createResponse.Diagnostics.HasError() {
+		t.Fatalf("expected an error but got none")
+	}
+
+	if r.contextId == "" || r.contextId == client.RootCorrelationRequestID || r.contextId != r.stopContextId {
+		t.Fatalf("expected the context and the StopContext to contain the same child correlation request ID but got %q and %q", r.contextId, r.stopContextId)
+	}
+	if common.OperationCorrelationRequestID(client.StopContext) != "" {
+		t.Fatalf("expected the StopContext for the provider to be unchanged")
+	}
+	if expected := fmt.Sprintf("creating example\n\nCorrelation Request ID: %s", r.contextId); createResponse.Diagnostics[0].Detail() != expected {
+		t.Fatalf("expected the detail %q but got %q", expected, createResponse.Diagnostics[0].Detail())
+	}
+}
+
+func TestFrameworkResourceWrapperInvalidTimeout(t *testing.T) {
+	ctx := context.TODO()
+	wrapper, resourceSchema := testFrameworkResourceWrapper(t, &testFrameworkResource{})
+
+	objectType := resourceSchema.Type().TerraformType(ctx).(tftypes.Object)
+	timeoutsType := objectType.AttributeTypes["timeouts"].(tftypes.Object)
+	plan := tfsdk.Plan{
+		Schema: resourceSchema,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"name": tftypes.NewValue(tftypes.String, "example"),
+			"timeouts": tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+				"create": tftypes.NewValue(tftypes.String, "ten minutes"),
+				"read":   tftypes.NewValue(tftypes.String, nil),
+				"delete": tftypes.NewValue(tftypes.String, nil),
+			}),
+		}),
+	}
+
+	createResponse := resource.CreateResponse{
+		State: tfsdk.State{
+			Schema: resourceSchema,
+			Raw:    tftypes.NewValue(objectType, nil),
+		},
+	}
+	wrapper.Create(ctx, resource.CreateRequest{Plan: plan}, &createResponse)
+	if !createResponse.Diagnostics.HasError() {
+		t.Fatalf("expected an error for an invalid `create` timeout but didn't get one")
+	}
+}
+
+func TestFrameworkResourceWrapperImportValidatesID(t *testing.T) {
+	ctx := context.TODO()
+	wrapper, resourceSchema := testFrameworkResourceWrapper(t, &testFrameworkResource{})
+
+	objectType := resourceSchema.Type().TerraformType(ctx)
+	response := resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: resourceSchema,
+			Raw:    tftypes.NewValue(objectType, nil),
+		},
+	}
+	wrapper.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: "/subscriptions/12345678-1234-9876-4563-123456789012"}, &response)
+	if !response.Diagnostics.HasError() {
+		t.Fatalf("expected an error when importing an invalid ID but didn't get one")
+	}
+}
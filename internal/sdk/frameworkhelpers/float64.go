@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkhelpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// WrappedFloat64Validator provides a wrapper for legacy SDKv2 type validations to ease migration to Framework Native
+// The provided function is tested against the value in the configuration and populates the diagnostics accordingly.
+type WrappedFloat64Validator struct {
+	Func         func(v interface{}, k string) (warnings []string, errors []error)
+	Desc         string
+	MarkdownDesc string
+}
+
+func (w WrappedFloat64Validator) Description(_ context.Context) string {
+	return w.Desc
+}
+
+func (w WrappedFloat64Validator) MarkdownDescription(_ context.Context) string {
+	return w.MarkdownDesc
+}
+
+func (w WrappedFloat64Validator) ValidateFloat64(_ context.Context, request validator.Float64Request, response *validator.Float64Response) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueFloat64()
+	path := request.Path.String()
+	warnings, err := w.Func(value, path)
+
+	if len(err) > 0 {
+		response.Diagnostics.AddError(fmt.Sprintf("invalid value for %s", path), fmt.Sprintf("%+v", err))
+		return
+	}
+
+	for _, v := range warnings {
+		response.Diagnostics.Append(diag.NewWarningDiagnostic(fmt.Sprintf("validating %s", path), v))
+	}
+}
+
+var _ validator.Float64 = &WrappedFloat64Validator{}
+
+type WrappedFloat64Default struct {
+	Desc     *string
+	Markdown *string
+	Value    float64
+}
+
+var _ defaults.Float64 = WrappedFloat64Default{}
+
+// NewWrappedFloat64Default is a helper function to return a new defaults.Float64 implementation for any type that
+// implements the Go float64 type.
+func NewWrappedFloat64Default[T ~float64](value T) WrappedFloat64Default {
+	return WrappedFloat64Default{
+		Value: float64(value),
+	}
+}
+
+func (w WrappedFloat64Default) Description(_ context.Context) string {
+	return pointer.From(w.Desc)
+}
+
+func (w WrappedFloat64Default) MarkdownDescription(_ context.Context) string {
+	return pointer.From(w.Markdown)
+}
+
+func (w WrappedFloat64Default) DefaultFloat64(_ context.Context, _ defaults.Float64Request, response *defaults.Float64Response) {
+	response.PlanValue = basetypes.NewFloat64Value(w.Value)
+}
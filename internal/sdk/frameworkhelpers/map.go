@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkhelpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// WrappedMapValidator provides a wrapper for legacy SDKv2 type validations to ease migration to Framework Native
+// The provided function is tested against the whole map of strings, this simulates the SDKv2 behaviour of defining
+// the validation on a `TypeMap` property.
+type WrappedMapValidator struct {
+	Func         func(v interface{}, k string) (warnings []string, errors []error)
+	Desc         string
+	MarkdownDesc string
+}
+
+func (w WrappedMapValidator) Description(_ context.Context) string {
+	return w.Desc
+}
+
+func (w WrappedMapValidator) MarkdownDescription(_ context.Context) string {
+	return w.MarkdownDesc
+}
+
+func (w WrappedMapValidator) ValidateMap(ctx context.Context, request validator.MapRequest, response *validator.MapResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	path := request.Path.String()
+
+	items := make(map[string]string)
+	if diags := request.ConfigValue.ElementsAs(ctx, &items, false); diags.HasError() {
+		response.Diagnostics.AddError(fmt.Sprintf("unsupported map validation wrapper type for %s", path), fmt.Sprintf("%+v", request.ConfigValue))
+		return
+	}
+
+	input := make(map[string]interface{}, len(items))
+	for k, v := range items {
+		input[k] = v
+	}
+
+	_, errors := w.Func(input, path)
+	if len(errors) > 0 {
+		response.Diagnostics.AddError(fmt.Sprintf("invalid value for %s", path), fmt.Sprintf("%+v", errors[0]))
+	}
+}
+
+var _ validator.Map = &WrappedMapValidator{}
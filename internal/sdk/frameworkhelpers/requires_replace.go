@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkhelpers
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// RequiresReplace is a Plan Modifier which marks the resource as requiring replacement when the value of the
+// attribute changes, simulating the SDKv2 behaviour of setting `ForceNew` on a property.
+type RequiresReplace struct{}
+
+var (
+	_ planmodifier.Bool    = RequiresReplace{}
+	_ planmodifier.Float64 = RequiresReplace{}
+	_ planmodifier.Int64   = RequiresReplace{}
+	_ planmodifier.List    = RequiresReplace{}
+	_ planmodifier.Map     = RequiresReplace{}
+	_ planmodifier.Set     = RequiresReplace{}
+	_ planmodifier.String  = RequiresReplace{}
+)
+
+func (r RequiresReplace) Description(_ context.Context) string {
+	return "changing this value will force the resource to be replaced"
+}
+
+func (r RequiresReplace) MarkdownDescription(ctx context.Context) string {
+	return r.Description(ctx)
+}
+
+func (r RequiresReplace) PlanModifyBool(_ context.Context, request planmodifier.BoolRequest, response *planmodifier.BoolResponse) {
+	response.RequiresReplace = requiresReplace(request.State, request.Plan, request.StateValue, request.PlanValue)
+}
+
+func (r RequiresReplace) PlanModifyFloat64(_ context.Context, request planmodifier.Float64Request, response *planmodifier.Float64Response) {
+	response.RequiresReplace = requiresReplace(request.State, request.Plan, request.StateValue, request.PlanValue)
+}
+
+func (r RequiresReplace) PlanModifyInt64(_ context.Context, request planmodifier.Int64Request, response *planmodifier.Int64Response) {
+	response.RequiresReplace = requiresReplace(request.State, request.Plan, request.StateValue, request.PlanValue)
+}
+
+func (r RequiresReplace) PlanModifyList(_ context.Context, request planmodifier.ListRequest, response *planmodifier.ListResponse) {
+	response.RequiresReplace = requiresReplace(request.State, request.Plan, request.StateValue, request.PlanValue)
+}
+
+func (r RequiresReplace) PlanModifyMap(_ context.Context, request planmodifier.MapRequest, response *planmodifier.MapResponse) {
+	response.RequiresReplace = requiresReplace(request.State, request.Plan, request.StateValue, request.PlanValue)
+}
+
+func (r RequiresReplace) PlanModifySet(_ context.Context, request planmodifier.SetRequest, response *planmodifier.SetResponse) {
+	response.RequiresReplace = requiresReplace(request.State, request.Plan, request.StateValue, request.PlanValue)
+}
+
+func (r RequiresReplace) PlanModifyString(_ context.Context, request planmodifier.StringRequest, response *planmodifier.StringResponse) {
+	response.RequiresReplace = requiresReplace(request.State, request.Plan, request.StateValue, request.PlanValue)
+}
+
+func requiresReplace(state tfsdk.State, plan tfsdk.Plan, stateValue attr.Value, planValue attr.Value) bool {
+	// the resource is being created or destroyed, so there's nothing to replace
+	if state.Raw.IsNull() || plan.Raw.IsNull() {
+		return false
+	}
+
+	// the value isn't known until apply, so we can't determine whether this has changed
+	if planValue.IsUnknown() {
+		return false
+	}
+
+	return !planValue.Equal(stateValue)
+}
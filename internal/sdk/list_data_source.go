@@ -0,0 +1,310 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// A ListDataSource is a Data Source which lists all of the resources of a given type within a scope, for
+// example all of the DNS Zones within a Subscription or Resource Group.
+//
+// This only has to define how to list the items - NewListDataSource adds the `name`, `name_regex` and
+// `required_tags` (when the items contain `tags`) filters, the `max_results` limit, a deterministic
+// ordering of the items and a stable ID.
+type ListDataSource interface {
+	// Arguments is a list of user-configurable arguments used to scope the list (e.g. `resource_group_name`)
+	// NOTE: the `name`, `name_regex`, `required_tags` and `max_results` arguments are added automatically
+	Arguments() map[string]*pluginsdk.Schema
+
+	// ItemAttributes is the Schema for each item within the list, all of which must be Computed
+	ItemAttributes() map[string]*pluginsdk.Schema
+
+	// ItemModelObject is an instance of the object each item is encoded from, which must contain the
+	// fields `id` and `name` (and optionally `tags`, as a map[string]string) - used to filter the items
+	ItemModelObject() interface{}
+
+	// ItemsAttribute is the name of the attribute containing the items (e.g. `dns_zones`)
+	ItemsAttribute() string
+
+	// ResourceType is the exposed name of this Data Source (e.g. `azurerm_dns_zones`)
+	ResourceType() string
+
+	// List is a ListFunc which retrieves the items within the scope defined by the Arguments
+	List() ListFunc
+}
+
+// ListPageFunc is called with each page of items (instances of, or pointers to, the ItemModelObject)
+type ListPageFunc func(items ...interface{}) error
+
+// ListFunc lists the items for a ListDataSource, passing each page of items to addPage
+type ListFunc struct {
+	// Func lists the items, where the arguments can be retrieved using `metadata.Decode`
+	Func func(ctx context.Context, metadata ResourceMetaData, addPage ListPageFunc) error
+
+	// Timeout is the default timeout, which can be overridden by users
+	Timeout time.Duration
+}
+
+// DataSourceWithItemModelObject is a DataSource returning a list of items (see NewListDataSource), which
+// doesn't define a ModelObject since each item is encoded individually from the ItemModelObject
+type DataSourceWithItemModelObject interface {
+	DataSource
+
+	// ItemModelObject is an instance of the object each item is encoded from
+	ItemModelObject() interface{}
+}
+
+var _ DataSourceWithItemModelObject = listDataSource{}
+
+type listDataSource struct {
+	dataSource ListDataSource
+}
+
+// NewListDataSource returns a DataSource for the specified ListDataSource, which can be registered
+// in the same manner as any other DataSource
+func NewListDataSource(dataSource ListDataSource) DataSource {
+	return listDataSource{
+		dataSource: dataSource,
+	}
+}
+
+func (l listDataSource) Arguments() map[string]*pluginsdk.Schema {
+	arguments := map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"name_regex": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+		},
+
+		"max_results": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+	}
+
+	// not every type of resource supports tags
+	if _, ok := l.dataSource.ItemAttributes()["tags"]; ok {
+		arguments["required_tags"] = &pluginsdk.Schema{
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		}
+	}
+
+	for k, v := range l.dataSource.Arguments() {
+		arguments[k] = v
+	}
+
+	return arguments
+}
+
+func (l listDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		l.dataSource.ItemsAttribute(): {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: l.dataSource.ItemAttributes(),
+			},
+		},
+	}
+}
+
+func (l listDataSource) ModelObject() interface{} {
+	// the arguments are decoded by the List function, and the items are encoded individually
+	return nil
+}
+
+func (l listDataSource) ItemModelObject() interface{} {
+	return l.dataSource.ItemModelObject()
+}
+
+func (l listDataSource) ResourceType() string {
+	return l.dataSource.ResourceType()
+}
+
+func (l listDataSource) Read() ResourceFunc {
+	list := l.dataSource.List()
+	return ResourceFunc{
+		Timeout: list.Timeout,
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			filter, err := listFilterFromResourceData(metadata.ResourceData)
+			if err != nil {
+				return err
+			}
+
+			itemType := reflect.TypeOf(l.dataSource.ItemModelObject())
+			if itemType.Kind() == reflect.Pointer {
+				itemType = itemType.Elem()
+			}
+
+			items := make([]map[string]interface{}, 0)
+			addPage := func(page ...interface{}) error {
+				for _, v := range page {
+					item, err := encodeListItem(itemType, v, metadata.serializationDebugLogger)
+					if err != nil {
+						return err
+					}
+					if filter.matches(item) {
+						items = append(items, item)
+					}
+				}
+				return nil
+			}
+
+			if err := list.Func(ctx, metadata, addPage); err != nil {
+				return err
+			}
+
+			// the API doesn't guarantee an ordering - so the items are sorted by ID (and then truncated), so that
+			// the same items are returned in the same order regardless of the order they were returned in
+			sort.SliceStable(items, func(i, j int) bool {
+				return strings.ToLower(items[i]["id"].(string)) < strings.ToLower(items[j]["id"].(string))
+			})
+			if filter.maxResults > 0 && len(items) > filter.maxResults {
+				items = items[:filter.maxResults]
+			}
+
+			output := make([]interface{}, 0, len(items))
+			for _, v := range items {
+				output = append(output, v)
+			}
+			if err := metadata.ResourceData.Set(l.dataSource.ItemsAttribute(), output); err != nil {
+				return fmt.Errorf("setting `%s`: %+v", l.dataSource.ItemsAttribute(), err)
+			}
+
+			metadata.ResourceData.SetId(l.id(metadata))
+			return nil
+		},
+	}
+}
+
+// id returns a stable ID for this Data Source, based on the Subscription and the arguments specified
+func (l listDataSource) id(metadata ResourceMetaData) string {
+	keys := make([]string, 0)
+	for k := range l.Arguments() {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	arguments := make([]string, 0, len(keys))
+	for _, k := range keys {
+		v := metadata.ResourceData.Get(k)
+		if m, ok := v.(map[string]interface{}); ok {
+			tagKeys := make([]string, 0, len(m))
+			for tk := range m {
+				tagKeys = append(tagKeys, tk)
+			}
+			sort.Strings(tagKeys)
+
+			values := make([]string, 0, len(tagKeys))
+			for _, tk := range tagKeys {
+				values = append(values, fmt.Sprintf("%s:%v", tk, m[tk]))
+			}
+			v = strings.Join(values, ",")
+		}
+		arguments = append(arguments, fmt.Sprintf("%s=%v", k, v))
+	}
+
+	subscriptionId := ""
+	if metadata.Client != nil && metadata.Client.Account != nil {
+		subscriptionId = metadata.Client.Account.SubscriptionId
+	}
+
+	id := fmt.Sprintf("%s/subscriptions/%s/%s", l.dataSource.ResourceType(), subscriptionId, strings.Join(arguments, ";"))
+	return base64.StdEncoding.EncodeToString([]byte(id))
+}
+
+type listFilter struct {
+	name         string
+	nameRegex    *regexp.Regexp
+	requiredTags map[string]interface{}
+	maxResults   int
+}
+
+func listFilterFromResourceData(d *pluginsdk.ResourceData) (*listFilter, error) {
+	filter := listFilter{
+		name:       d.Get("name").(string),
+		maxResults: d.Get("max_results").(int),
+	}
+	if v, ok := d.GetOk("required_tags"); ok {
+		filter.requiredTags = v.(map[string]interface{})
+	}
+
+	if v := d.Get("name_regex").(string); v != "" {
+		r, err := regexp.Compile(v)
+		if err != nil {
+			return nil, fmt.Errorf("compiling `name_regex` %q: %+v", v, err)
+		}
+		filter.nameRegex = r
+	}
+
+	return &filter, nil
+}
+
+// matches returns whether the encoded item matches the name, name regex and required tags
+func (f listFilter) matches(item map[string]interface{}) bool {
+	name, _ := item["name"].(string)
+	if f.name != "" && !strings.EqualFold(f.name, name) {
+		return false
+	}
+	if f.nameRegex != nil && !f.nameRegex.MatchString(name) {
+		return false
+	}
+
+	if len(f.requiredTags) > 0 {
+		tags, _ := item["tags"].(map[string]interface{})
+		for k, v := range f.requiredTags {
+			if value, ok := tags[k]; !ok || value != v {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// encodeListItem encodes a single item using the `tfschema` tags of the ItemModelObject
+func encodeListItem(itemType reflect.Type, input interface{}, debugLogger Logger) (map[string]interface{}, error) {
+	v := reflect.ValueOf(input)
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil, fmt.Errorf("encoding item: expected a %s but got nil", itemType)
+		}
+		v = v.Elem()
+	}
+	if v.Type() != itemType {
+		return nil, fmt.Errorf("encoding item: expected a %s but got %s", itemType, v.Type())
+	}
+
+	item, err := recurse(itemType, v, debugLogger)
+	if err != nil {
+		return nil, fmt.Errorf("encoding item: %+v", err)
+	}
+	if _, ok := item["id"].(string); !ok {
+		return nil, fmt.Errorf("encoding item: the %s must contain a string field with the `tfschema` tag `id`", itemType)
+	}
+
+	return item, nil
+}
@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type testListDataSourceArgs struct {
+	ResourceGroupName string `tfschema:"resource_group_name"`
+}
+
+type testListDataSourceItem struct {
+	Id   string            `tfschema:"id"`
+	Name string            `tfschema:"name"`
+	Tags map[string]string `tfschema:"tags"`
+}
+
+type testListDataSource struct {
+	pages [][]interface{}
+}
+
+var _ ListDataSource = testListDataSource{}
+
+func (testListDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"resource_group_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+func (testListDataSource) ItemAttributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (testListDataSource) ItemModelObject() interface{} {
+	return &testListDataSourceItem{}
+}
+
+func (testListDataSource) ItemsAttribute() string {
+	return "things"
+}
+
+func (testListDataSource) ResourceType() string {
+	return "azurerm_things"
+}
+
+func (t testListDataSource) List() ListFunc {
+	return ListFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata ResourceMetaData, addPage ListPageFunc) error {
+			var args testListDataSourceArgs
+			if err := metadata.Decode(&args); err != nil {
+				return err
+			}
+
+			for _, page := range t.pages {
+				items := make([]interface{}, 0)
+				for _, item := range page {
+					id := ""
+					switch v := item.(type) {
+					case testListDataSourceItem:
+						id = v.Id
+					case *testListDataSourceItem:
+						id = v.Id
+					}
+					if args.ResourceGroupName == "" || strings.HasPrefix(id, args.ResourceGroupName+"/") {
+						items = append(items, item)
+					}
+				}
+				if err := addPage(items...); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func TestListDataSource_Read(t *testing.T) {
+	pages := [][]interface{}{
+		{
+			testListDataSourceItem{Id: "rg2/thing-c", Name: "thing-c", Tags: map[string]string{"env": "prod"}},
+			testListDataSourceItem{Id: "rg1/Thing-B", Name: "Thing-B", Tags: map[string]string{"env": "test"}},
+		},
+		{
+			&testListDataSourceItem{Id: "rg1/thing-a", Name: "thing-a", Tags: map[string]string{"env": "prod", "team": "a"}},
+			testListDataSourceItem{Id: "rg3/other", Name: "other"},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		config   map[string]interface{}
+		expected []string
+	}{
+		{
+			name:     "all",
+			config:   map[string]interface{}{},
+			expected: []string{"rg1/thing-a", "rg1/Thing-B", "rg2/thing-c", "rg3/other"},
+		},
+		{
+			name: "scoped",
+			config: map[string]interface{}{
+				"resource_group_name": "rg1",
+			},
+			expected: []string{"rg1/thing-a", "rg1/Thing-B"},
+		},
+		{
+			name: "name is case-insensitive",
+			config: map[string]interface{}{
+				"name": "THING-B",
+			},
+			expected: []string{"rg1/Thing-B"},
+		},
+		{
+			name: "name regex",
+			config: map[string]interface{}{
+				"name_regex": "^thing-",
+			},
+			expected: []string{"rg1/thing-a", "rg2/thing-c"},
+		},
+		{
+			name: "required tags",
+			config: map[string]interface{}{
+				"required_tags": map[string]interface{}{
+					"env": "prod",
+				},
+			},
+			expected: []string{"rg1/thing-a", "rg2/thing-c"},
+		},
+		{
+			name: "max results is applied after sorting",
+			config: map[string]interface{}{
+				"max_results": 2,
+			},
+			expected: []string{"rg1/thing-a", "rg1/Thing-B"},
+		},
+	}
+
+	dataSource := listDataSource{
+		dataSource: testListDataSource{
+			pages: pages,
+		},
+	}
+	resourceSchema, err := combineSchema(dataSource.Arguments(), dataSource.Attributes())
+	if err != nil {
+		t.Fatalf("building schema: %+v", err)
+	}
+
+	ids := make(map[string]string)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, *resourceSchema, tc.config)
+			metadata := ResourceMetaData{
+				ResourceData:             d,
+				serializationDebugLogger: NullLogger{},
+			}
+			if err := dataSource.Read().Func(context.TODO(), metadata); err != nil {
+				t.Fatalf("reading: %+v", err)
+			}
+
+			actual := make([]string, 0)
+			for _, v := range d.Get("things").([]interface{}) {
+				actual = append(actual, v.(map[string]interface{})["id"].(string))
+			}
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Fatalf("expected %+v but got %+v", tc.expected, actual)
+			}
+
+			if d.Id() == "" {
+				t.Fatalf("expected an ID to be set")
+			}
+			for name, id := range ids {
+				if id == d.Id() {
+					t.Fatalf("expected the ID to differ from %q but got %q", name, id)
+				}
+			}
+			ids[tc.name] = d.Id()
+
+			// the ID is stable across reads
+			d2 := schema.TestResourceDataRaw(t, *resourceSchema, tc.config)
+			metadata.ResourceData = d2
+			if err := dataSource.Read().Func(context.TODO(), metadata); err != nil {
+				t.Fatalf("reading: %+v", err)
+			}
+			if d2.Id() != d.Id() {
+				t.Fatalf("expected the ID %q to be stable but got %q", d.Id(), d2.Id())
+			}
+		})
+	}
+}
+
+func TestListDataSource_InvalidItem(t *testing.T) {
+	dataSource := listDataSource{
+		dataSource: testListDataSource{
+			pages: [][]interface{}{
+				{testListDataSourceArgs{ResourceGroupName: "rg1"}},
+			},
+		},
+	}
+	resourceSchema, err := combineSchema(dataSource.Arguments(), dataSource.Attributes())
+	if err != nil {
+		t.Fatalf("building schema: %+v", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, *resourceSchema, map[string]interface{}{})
+	metadata := ResourceMetaData{
+		ResourceData:             d,
+		serializationDebugLogger: NullLogger{},
+	}
+	if err := dataSource.Read().Func(context.TODO(), metadata); err == nil {
+		t.Fatalf("expected an error for an item of the wrong type")
+	}
+}
+
+func TestListDataSource_RequiredTagsOnlyWhenItemsHaveTags(t *testing.T) {
+	dataSource := listDataSource{
+		dataSource: testListDataSource{},
+	}
+	if _, ok := dataSource.Arguments()["required_tags"]; !ok {
+		t.Fatalf("expected `required_tags` to be present when the items contain `tags`")
+	}
+
+	dataSource.dataSource = testListDataSourceWithoutTags{}
+	if _, ok := dataSource.Arguments()["required_tags"]; ok {
+		t.Fatalf("expected `required_tags` not to be present when the items don't contain `tags`")
+	}
+}
+
+type testListDataSourceWithoutTags struct {
+	testListDataSource
+}
+
+func (testListDataSourceWithoutTags) ItemAttributes() map[string]*pluginsdk.Schema {
+	attributes := testListDataSource{}.ItemAttributes()
+	delete(attributes, "tags")
+	return attributes
+}
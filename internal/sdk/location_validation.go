@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"reflect"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// enhancedValidateLocation is the location validation from go-azure-helpers (used by `commonschema.Location()` and
+// `commonschema.LocationWithoutForceNew()`) - functions can't be compared, so this is identified by its address
+var enhancedValidateLocation = reflect.ValueOf(location.EnhancedValidate).Pointer()
+
+// EnableEnhancedLocationValidation replaces the location validation from go-azure-helpers within the schema of a
+// Resource (or Data Source) with `resourceproviders.EnhancedValidateLocation` - which validates the location against
+// the Locations cached by the Provider (including those read from the `metadata_cache_file`).
+func EnableEnhancedLocationValidation(resource *pluginsdk.Resource) {
+	if resource == nil {
+		return
+	}
+
+	for _, v := range resource.Schema {
+		if v == nil {
+			continue
+		}
+
+		if v.ValidateFunc != nil && reflect.ValueOf(v.ValidateFunc).Pointer() == enhancedValidateLocation {
+			v.ValidateFunc = resourceproviders.EnhancedValidateLocation
+		}
+
+		if elem, ok := v.Elem.(*pluginsdk.Resource); ok {
+			EnableEnhancedLocationValidation(elem)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func TestEnableEnhancedLocationValidation(t *testing.T) {
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"location": commonschema.Location(),
+			"replica": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"location": commonschema.LocationWithoutForceNew(),
+					},
+				},
+			},
+		},
+	}
+
+	EnableEnhancedLocationValidation(resource)
+
+	expected := reflect.ValueOf(resourceproviders.EnhancedValidateLocation).Pointer()
+	for name, s := range map[string]*pluginsdk.Schema{
+		"location":         resource.Schema["location"],
+		"replica.location": resource.Schema["replica"].Elem.(*pluginsdk.Resource).Schema["location"],
+	} {
+		if reflect.ValueOf(s.ValidateFunc).Pointer() != expected {
+			t.Fatalf("expected the validation for %q to be replaced", name)
+		}
+	}
+	if reflect.ValueOf(resource.Schema["name"].ValidateFunc).Pointer() != reflect.ValueOf(validation.StringIsNotEmpty).Pointer() {
+		t.Fatalf("expected the validation for `name` to be unchanged")
+	}
+}
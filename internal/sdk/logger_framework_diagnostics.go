@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+var _ Logger = &FrameworkDiagnosticsLogger{}
+
+// FrameworkDiagnosticsLogger is a Logger which surfaces Warnings as Plugin Framework Diagnostics
+type FrameworkDiagnosticsLogger struct {
+	diagnostics *diag.Diagnostics
+}
+
+func (d *FrameworkDiagnosticsLogger) Info(message string) {
+	log.Printf("[INFO] %s", message)
+}
+
+func (d *FrameworkDiagnosticsLogger) Infof(format string, args ...interface{}) {
+	log.Printf("[INFO] "+format, args...)
+}
+
+func (d *FrameworkDiagnosticsLogger) Warn(message string) {
+	d.diagnostics.AddWarning(message, message)
+}
+
+func (d *FrameworkDiagnosticsLogger) Warnf(format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	d.diagnostics.AddWarning(message, message)
+}
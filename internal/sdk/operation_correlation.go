@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	frameworkdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// EnableOperationCorrelation wraps the Create, Read, Update and Delete functions of a Resource (or the Read function
+// of a Data Source) so that each operation sends its own (child) correlation request ID, which is logged alongside
+// the root correlation request ID and included in the diagnostics for any error.
+//
+// Untyped Resources build the context for their requests from the StopContext on the client rather than the context
+// passed to the operation, so the client passed to each operation is a copy whose StopContext contains the ID too.
+// Functions using the legacy signature are converted to the context-aware signature in the process.
+func EnableOperationCorrelation(resourceType string, resource *pluginsdk.Resource) {
+	if resource == nil {
+		return
+	}
+
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+	resource.CreateContext = wrapWithOperationCorrelation(resourceType, "create", resource.CreateContext, resource.Create) //nolint:staticcheck
+	resource.CreateWithoutTimeout = wrapWithOperationCorrelation(resourceType, "create", resource.CreateWithoutTimeout, nil)
+	resource.Create = nil //nolint:staticcheck
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+	resource.ReadContext = wrapWithOperationCorrelation(resourceType, "read", resource.ReadContext, resource.Read) //nolint:staticcheck
+	resource.ReadWithoutTimeout = wrapWithOperationCorrelation(resourceType, "read", resource.ReadWithoutTimeout, nil)
+	resource.Read = nil //nolint:staticcheck
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+	resource.UpdateContext = wrapWithOperationCorrelation(resourceType, "update", resource.UpdateContext, resource.Update) //nolint:staticcheck
+	resource.UpdateWithoutTimeout = wrapWithOperationCorrelation(resourceType, "update", resource.UpdateWithoutTimeout, nil)
+	resource.Update = nil //nolint:staticcheck
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+	resource.DeleteContext = wrapWithOperationCorrelation(resourceType, "delete", resource.DeleteContext, resource.Delete) //nolint:staticcheck
+	resource.DeleteWithoutTimeout = wrapWithOperationCorrelation(resourceType, "delete", resource.DeleteWithoutTimeout, nil)
+	resource.Delete = nil //nolint:staticcheck
+}
+
+func wrapWithOperationCorrelation(resourceType, operation string, in func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics, legacy func(d *pluginsdk.ResourceData, meta interface{}) error) func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	if in == nil && legacy != nil {
+		in = func(_ context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(legacy(d, meta))
+		}
+	}
+	if in == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+		client, ok := meta.(*clients.Client)
+		if !ok || client == nil {
+			return in(ctx, d, meta)
+		}
+
+		root := client.RootCorrelationRequestID
+		ctx, correlationRequestId := common.WithOperationCorrelationRequestID(ctx, root)
+		if correlationRequestId == "" {
+			return in(ctx, d, meta)
+		}
+
+		if client.StopContext != nil {
+			operationClient := *client
+			operationClient.StopContext = common.ContextWithOperationCorrelationRequestID(client.StopContext, correlationRequestId)
+			meta = &operationClient
+		}
+
+		diags := in(ctx, d, meta)
+		common.LogOperationCorrelationRequestID(root, correlationRequestId, operation, resourceType, d.Id())
+
+		for i := range diags {
+			if diags[i].Severity != diag.Error {
+				continue
+			}
+
+			detail := diags[i].Detail
+			if detail == "" {
+				detail = diags[i].Summary
+			}
+			diags[i].Detail = fmt.Sprintf("%s\n\nCorrelation Request ID: %s", detail, correlationRequestId)
+		}
+
+		return diags
+	}
+}
+
+// frameworkOperation prepares the context and client for an operation of a FrameworkResource or FrameworkDataSource
+// in the same manner as for the Plugin SDK - the locks acquired during the operation are owned by it (as done by
+// diagnosticsWrapper) and the operation sends its own (child) correlation request ID (as done by
+// EnableOperationCorrelation). The returned function must be called once the operation has completed, which logs
+// the correlation request ID and includes it in the diagnostics for any error.
+func frameworkOperation(ctx context.Context, client *clients.Client, resourceType, operation string) (context.Context, *clients.Client, func(state tfsdk.State, diags frameworkdiag.Diagnostics)) {
+	ctx = locks.WithOwner(ctx)
+
+	noop := func(tfsdk.State, frameworkdiag.Diagnostics) {}
+	if client == nil {
+		return ctx, client, noop
+	}
+
+	root := client.RootCorrelationRequestID
+	ctx, correlationRequestId := common.WithOperationCorrelationRequestID(ctx, root)
+	if correlationRequestId == "" {
+		return ctx, client, noop
+	}
+
+	if client.StopContext != nil {
+		operationClient := *client
+		operationClient.StopContext = common.ContextWithOperationCorrelationRequestID(client.StopContext, correlationRequestId)
+		client = &operationClient
+	}
+
+	return ctx, client, func(state tfsdk.State, diags frameworkdiag.Diagnostics) {
+		common.LogOperationCorrelationRequestID(root, correlationRequestId, operation, resourceType, frameworkResourceId(ctx, state))
+
+		for i, v := range diags {
+			if v.Severity() != frameworkdiag.SeverityError {
+				continue
+			}
+
+			detail := v.Detail()
+			if detail == "" {
+				detail = v.Summary()
+			}
+			var replacement frameworkdiag.Diagnostic = frameworkdiag.NewErrorDiagnostic(v.Summary(), fmt.Sprintf("%s\n\nCorrelation Request ID: %s", detail, correlationRequestId))
+			if withPath, ok := v.(frameworkdiag.DiagnosticWithPath); ok {
+				replacement = frameworkdiag.WithPath(withPath.Path(), replacement)
+			}
+			diags[i] = replacement
+		}
+	}
+}
+
+// frameworkResourceId returns the `id` from the specified state, or an empty string when it's unavailable
+func frameworkResourceId(ctx context.Context, state tfsdk.State) string {
+	if state.Raw.IsNull() || !state.Raw.IsKnown() {
+		return ""
+	}
+
+	var id types.String
+	if diags := state.GetAttribute(ctx, path.Root("id"), &id); diags.HasError() {
+		return ""
+	}
+
+	return id.ValueString()
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func testOperationCorrelationMeta() *clients.Client {
+	return &clients.Client{
+		StopContext:              context.Background(),
+		RootCorrelationRequestID: "00000000-0000-0000-0000-000000000000",
+	}
+}
+
+func TestEnableOperationCorrelationLegacyFunctions(t *testing.T) {
+	var stopContextId string
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+		},
+		// emulate an untyped Resource, which builds its context from the StopContext
+		Create: func(_ *pluginsdk.ResourceData, meta interface{}) error {
+			stopContextId = common.OperationCorrelationRequestID(meta.(*clients.Client).StopContext)
+			return fmt.Errorf("creating example")
+		},
+		Read: func(_ *pluginsdk.ResourceData, _ interface{}) error {
+			return nil
+		},
+		Delete: func(_ *pluginsdk.ResourceData, _ interface{}) error {
+			return nil
+		},
+	}
+	EnableOperationCorrelation("azurerm_example", resource)
+
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+	if resource.Create != nil || resource.Read != nil || resource.Delete != nil { //nolint:staticcheck
+		t.Fatalf("expected the legacy functions to be replaced")
+	}
+
+	meta := testOperationCorrelationMeta()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"name": "example",
+	})
+	diags := resource.CreateContext(context.TODO(), d, meta)
+	if !diags.HasError() {
+		t.Fatalf("expected an error but got none")
+	}
+
+	if stopContextId == "" || stopContextId == meta.RootCorrelationRequestID {
+		t.Fatalf("expected the StopContext to contain a child correlation request ID but got %q", stopContextId)
+	}
+	if common.OperationCorrelationRequestID(meta.StopContext) != "" {
+		t.Fatalf("expected the StopContext for the provider to be unchanged")
+	}
+	if expected := fmt.Sprintf("creating example\n\nCorrelation Request ID: %s", stopContextId); diags[0].Detail != expected {
+		t.Fatalf("expected the detail %q but got %q", expected, diags[0].Detail)
+	}
+}
+
+func TestEnableOperationCorrelationContextFunctions(t *testing.T) {
+	var contextId, stopContextId string
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+		},
+		ReadContext: func(ctx context.Context, _ *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+			contextId = common.OperationCorrelationRequestID(ctx)
+			stopContextId = common.OperationCorrelationRequestID(meta.(*clients.Client).StopContext)
+			return nil
+		},
+	}
+	EnableOperationCorrelation("azurerm_example", resource)
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"name": "example",
+	})
+	if diags := resource.ReadContext(context.TODO(), d, testOperationCorrelationMeta()); diags.HasError() {
+		t.Fatalf("reading: %+v", diags)
+	}
+
+	if contextId == "" || contextId != stopContextId {
+		t.Fatalf("expected the context and the StopContext to contain the same correlation request ID but got %q and %q", contextId, stopContextId)
+	}
+}
+
+func TestEnableOperationCorrelationDisabled(t *testing.T) {
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+		},
+		ReadContext: func(ctx context.Context, _ *pluginsdk.ResourceData, _ interface{}) diag.Diagnostics {
+			if id := common.OperationCorrelationRequestID(ctx); id != "" {
+				return diag.Errorf("expected no correlation request ID but got %q", id)
+			}
+			return diag.Errorf("reading example")
+		},
+	}
+	EnableOperationCorrelation("azurerm_example", resource)
+
+	// an empty root correlation request ID means that they're disabled
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"name": "example",
+	})
+	diags := resource.ReadContext(context.TODO(), d, &clients.Client{StopContext: context.Background()})
+	if len(diags) != 1 || diags[0].Summary != "reading example" || strings.Contains(diags[0].Detail, "Correlation Request ID") {
+		t.Fatalf("expected the diagnostics to be unchanged but got %+v", diags)
+	}
+}
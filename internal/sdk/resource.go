@@ -27,9 +27,9 @@ type resourceWithPluginSdkSchema interface {
 
 type resourceBase interface {
 	// resourceWithPluginSdkSchema ensure that the Arguments and Attributes are sourced
-	// from Plugin SDKv2 for now - longer term we'll likely introduce a `Typed Schema`
-	// which will cross-compile down to both the Plugin SDKv2 and Plugin Framework, but
-	// that's a story for another day.
+	// from Plugin SDKv2 - these can either be defined directly, or compiled from a
+	// TypedSchema (using PluginSdkArguments and PluginSdkAttributes) which can also be
+	// compiled down to the Plugin Framework for use by a FrameworkResource.
 	resourceWithPluginSdkSchema
 
 	// ModelObject is an instance of the object the Schema is decoded/encoded into
@@ -99,7 +99,8 @@ type StateUpgradeData struct {
 	Upgraders     map[int]pluginsdk.StateUpgrade
 }
 
-// TODO: a generic state migration for updating ID's
+// NOTE: a State Upgrade which only rewrites the Resource ID (and any other ID-valued fields)
+// from one Resource ID type to another can use ResourceIDStateUpgrade
 
 type ResourceWithCustomImporter interface {
 	Resource
@@ -156,6 +157,18 @@ type ResourceWithCustomizeDiff interface {
 	CustomizeDiff() ResourceFunc
 }
 
+// ResourceWithAzureResourceType is an optional interface
+//
+// Resources implementing this interface (and which contain a `location` field) have the Location
+// validated at plan time against the Locations the Resource Type is available in, when Enhanced
+// Validation is enabled.
+type ResourceWithAzureResourceType interface {
+	Resource
+
+	// AzureResourceType returns the Azure Resource Manager Resource Type for this resource (e.g. `Microsoft.Web/sites`)
+	AzureResourceType() string
+}
+
 // ResourceRunFunc is the function which can be run
 // ctx provides a Context instance with the user-provided timeout
 // metadata is a reference to an object containing the Client, ResourceData and a Logger
@@ -190,6 +203,10 @@ type ResourceMetaData struct {
 
 	// serializationDebugLogger is used for testing purposes
 	serializationDebugLogger Logger
+
+	// tagsAll specifies whether the `default_tags` and `ignore_tags` from the Provider block apply to the
+	// `tags` field of this Resource when it's Decoded or Encoded (see EnableTagsAll)
+	tagsAll bool
 }
 
 // MarkAsGone marks this resource as removed in the Remote API, so this is no longer available
@@ -6,12 +6,17 @@ package sdk
 import (
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // Decode will decode the Terraform Schema into the specified object consisting of Supported Go native Types
-// These are: int64, float64, string, bool, as well as lists and maps of these base types.
+// These are: int64, float64, string (including named string types, such as SDK constants), bool and time.Time
+// (as an RFC3339 string), pointers to these types, lists and maps of these base types - as well as nested
+// objects, either as a list of structs or a single struct (for blocks with `MaxItems: 1`).
+// Pointer fields are left as nil when the value isn't set, rather than being set to the zero value.
+// For Resources supporting `tags_all` the `default_tags` from the Provider block are merged into the `tags` field.
 // NOTE: this object must be passed by value - and must contain `tfschema`
 // struct tags for all fields
 //
@@ -27,7 +32,14 @@ func (rmd ResourceMetaData) Decode(input interface{}) error {
 	if rmd.ResourceData == nil {
 		return fmt.Errorf("ResourceData was nil")
 	}
-	return decodeReflectedType(input, rmd.ResourceData, rmd.serializationDebugLogger)
+	if err := decodeReflectedType(input, rmd.ResourceData, rmd.serializationDebugLogger); err != nil {
+		return err
+	}
+
+	if rmd.tagsAll {
+		mergeDefaultTagsIntoModel(input, tagDefaultsFromMeta(rmd.Client))
+	}
+	return nil
 }
 
 // DecodeDiff decodes the Terraform Schema into the specified object in the
@@ -71,7 +83,7 @@ func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debug
 			debugLogger.Infof("TFSchemaValue: %+v", tfschemaValue)
 			debugLogger.Infof("Input Type: %+v", reflect.ValueOf(input).Elem().Field(i).Type())
 
-			if err := setValue(input, tfschemaValue, i, field.Name, debugLogger); err != nil {
+			if err := setValue(input, tfschemaValue, i, field.Name, structTags.hclPath, stateRetriever, debugLogger); err != nil {
 				return fmt.Errorf("while setting value %+v of model field %q: %+v", tfschemaValue, field.Name, err)
 			}
 		}
@@ -79,7 +91,10 @@ func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debug
 	return nil
 }
 
-func setValue(input, tfschemaValue interface{}, index int, fieldName string, debugLogger Logger) (errOut error) {
+// setValue sets the value of the field at the specified index within input, where path is the full path to this
+// field within the schema (e.g. `block.0.field`) - which is used to check whether a nested value exists, unless
+// stateRetriever is nil (as is the case within a Set, where the index isn't a part of the path)
+func setValue(input, tfschemaValue interface{}, index int, fieldName string, path string, stateRetriever stateRetriever, debugLogger Logger) (errOut error) {
 	debugLogger.Infof("setting value for %q..", fieldName)
 	defer func() {
 		if r := recover(); r != nil {
@@ -95,6 +110,9 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 
 	if v, ok := tfschemaValue.(string); ok {
 		n := reflect.ValueOf(input).Elem().Field(index)
+		if isTimeType(n.Type()) {
+			return setTimeValue(n, v, debugLogger)
+		}
 		if n.Kind() == reflect.Pointer {
 			debugLogger.Infof("*[String] Decode %+v", v)
 			tmp := reflect.New(n.Type().Elem())
@@ -152,7 +170,7 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 	}
 
 	if v, ok := tfschemaValue.(*schema.Set); ok {
-		return setListValue(input, index, fieldName, v.List(), debugLogger)
+		return setListValue(input, index, fieldName, v.List(), path, nil, debugLogger)
 	}
 
 	if mapConfig, ok := tfschemaValue.(map[string]interface{}); ok {
@@ -168,7 +186,7 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 					mapOutput.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(int64(t)))
 
 				default:
-					mapOutput.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(val))
+					mapOutput.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(val).Convert(mapOutput.Type().Elem()))
 				}
 			}
 
@@ -182,7 +200,7 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 				case int:
 					mapOutput.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(int64(t)))
 				default:
-					mapOutput.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(val))
+					mapOutput.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(val).Convert(mapOutput.Type().Elem()))
 				}
 			}
 
@@ -209,7 +227,7 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 					mapOutput.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(val))
 				}
 			default:
-				mapOutput.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(val))
+				mapOutput.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(val).Convert(mapOutput.Type().Elem()))
 			}
 		}
 
@@ -221,14 +239,24 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 	}
 
 	if v, ok := tfschemaValue.([]interface{}); ok {
-		return setListValue(input, index, fieldName, v, debugLogger)
+		return setListValue(input, index, fieldName, v, path, stateRetriever, debugLogger)
 	}
 
 	return nil
 }
 
-func setListValue(input interface{}, index int, fieldName string, v []interface{}, debugLogger Logger) error {
+func setListValue(input interface{}, index int, fieldName string, v []interface{}, path string, stateRetriever stateRetriever, debugLogger Logger) error {
 	fieldType := reflect.ValueOf(input).Elem().Field(index).Type()
+
+	// a single nested object (or a pointer to one) is used for blocks with `MaxItems: 1`
+	objType := fieldType
+	if objType.Kind() == reflect.Pointer {
+		objType = objType.Elem()
+	}
+	if objType.Kind() == reflect.Struct {
+		return setNestedObjectValue(input, index, v, path, stateRetriever, debugLogger)
+	}
+
 	var slice reflect.Value
 	if reflect.TypeOf(input).Elem().Field(index).Type.Kind() != reflect.Ptr {
 		slice = reflect.MakeSlice(reflect.TypeOf(input).Elem().Field(index).Type, len(v), len(v))
@@ -309,25 +337,12 @@ func setListValue(input interface{}, index int, fieldName string, v []interface{
 		if n.Kind() == reflect.Pointer {
 			tmp := reflect.New(fieldType.Elem())
 			valueToSet := reflect.MakeSlice(tmp.Elem().Type(), 0, 0)
-			for _, mapVal := range v {
+			for i, mapVal := range v {
 				if test, ok := mapVal.(map[string]interface{}); ok && test != nil {
 					elem := reflect.New(fieldType.Elem().Elem())
 					debugLogger.Infof("element %s", elem.String())
-					for j := 0; j < elem.Type().Elem().NumField(); j++ {
-						nestedField := elem.Type().Elem().Field(j)
-						debugLogger.Infof("nestedField Name: '%s', Tags: '%+v'", nestedField.Name, nestedField.Tag)
-
-						structTags, err := parseStructTags(nestedField.Tag)
-						if err != nil {
-							return fmt.Errorf("parsing struct tags for nested field `%s`: %+v", nestedField.Name, err)
-						}
-
-						if structTags != nil {
-							nestedTFSchemaValue := test[structTags.hclPath]
-							if err := setValue(elem.Interface(), nestedTFSchemaValue, j, fieldName, debugLogger); err != nil {
-								return err
-							}
-						}
+					if err := setNestedFields(elem, test, listItemPath(path, i, stateRetriever), stateRetriever, debugLogger); err != nil {
+						return err
 					}
 
 					if !elem.CanSet() {
@@ -350,25 +365,12 @@ func setListValue(input interface{}, index int, fieldName string, v []interface{
 			valueToSet := reflect.MakeSlice(n.Type(), 0, 0)
 			debugLogger.Infof("List Type '%s'", valueToSet.Type().String())
 
-			for _, mapVal := range v {
+			for i, mapVal := range v {
 				if test, ok := mapVal.(map[string]interface{}); ok && test != nil {
 					elem := reflect.New(fieldType.Elem())
 					debugLogger.Infof("element '%s'", elem.String())
-					for j := 0; j < elem.Type().Elem().NumField(); j++ {
-						nestedField := elem.Type().Elem().Field(j)
-						debugLogger.Infof("nestedField Name '%s', Tags: '%+v'", nestedField.Name, nestedField.Tag)
-
-						structTags, err := parseStructTags(nestedField.Tag)
-						if err != nil {
-							return fmt.Errorf("parsing struct tags for nested field '%s': %+v", nestedField.Name, err)
-						}
-
-						if structTags != nil {
-							nestedTFSchemaValue := test[structTags.hclPath]
-							if err := setValue(elem.Interface(), nestedTFSchemaValue, j, nestedField.Name, debugLogger); err != nil {
-								return err
-							}
-						}
+					if err := setNestedFields(elem, test, listItemPath(path, i, stateRetriever), stateRetriever, debugLogger); err != nil {
+						return err
 					}
 
 					if !elem.CanSet() {
@@ -393,3 +395,122 @@ func setListValue(input interface{}, index int, fieldName string, v []interface{
 
 	return nil
 }
+
+// setNestedObjectValue sets a field containing a single nested object (or a pointer to one) from the first item
+// within the list, as used for blocks with `MaxItems: 1` - the field is left unset when the list is empty
+func setNestedObjectValue(input interface{}, index int, v []interface{}, path string, stateRetriever stateRetriever, debugLogger Logger) error {
+	n := reflect.ValueOf(input).Elem().Field(index)
+	if len(v) == 0 {
+		return nil
+	}
+	values, ok := v[0].(map[string]interface{})
+	if !ok || values == nil {
+		return nil
+	}
+
+	objType := n.Type()
+	if objType.Kind() == reflect.Pointer {
+		objType = objType.Elem()
+	}
+	elem := reflect.New(objType)
+	debugLogger.Infof("[Object] Decode %s", elem.String())
+	if err := setNestedFields(elem, values, listItemPath(path, 0, stateRetriever), stateRetriever, debugLogger); err != nil {
+		return err
+	}
+
+	if n.Kind() == reflect.Pointer {
+		n.Set(elem)
+	} else {
+		n.Set(elem.Elem())
+	}
+	return nil
+}
+
+// setNestedFields sets the fields of the nested object elem (a pointer to a struct) from the values of a single
+// item within a list or set, where path is the path to this item (e.g. `block.0`)
+func setNestedFields(elem reflect.Value, values map[string]interface{}, path string, stateRetriever stateRetriever, debugLogger Logger) error {
+	for j := 0; j < elem.Type().Elem().NumField(); j++ {
+		nestedField := elem.Type().Elem().Field(j)
+		debugLogger.Infof("nestedField Name '%s', Tags: '%+v'", nestedField.Name, nestedField.Tag)
+
+		structTags, err := parseStructTags(nestedField.Tag)
+		if err != nil {
+			return fmt.Errorf("parsing struct tags for nested field '%s': %+v", nestedField.Name, err)
+		}
+		if structTags == nil {
+			continue
+		}
+
+		nestedPath := fmt.Sprintf("%s.%s", path, structTags.hclPath)
+		nestedTFSchemaValue := values[structTags.hclPath]
+
+		// nested values are returned as the zero value when they're unset, as such the state is checked to
+		// be able to leave a pointer field as nil when the value isn't set, rather than the zero value
+		if nestedField.Type.Kind() == reflect.Pointer && stateRetriever != nil && isZeroPrimitive(nestedTFSchemaValue) {
+			if _, exists := stateRetriever.GetOkExists(nestedPath); !exists {
+				debugLogger.Infof("nestedField '%s' isn't set - skipping", nestedPath)
+				continue
+			}
+		}
+
+		if err := setValue(elem.Interface(), nestedTFSchemaValue, j, nestedField.Name, nestedPath, stateRetriever, debugLogger); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// listItemPath returns the path to the item at the specified index within a list - items within a Set are
+// addressed by their hash, as such the path isn't available (and so the state isn't checked) for these
+func listItemPath(path string, index int, stateRetriever stateRetriever) string {
+	if stateRetriever == nil {
+		return path
+	}
+	return fmt.Sprintf("%s.%d", path, index)
+}
+
+func isZeroPrimitive(input interface{}) bool {
+	switch v := input.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	}
+	return false
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func isTimeType(input reflect.Type) bool {
+	if input.Kind() == reflect.Pointer {
+		input = input.Elem()
+	}
+	return input == timeType
+}
+
+// setTimeValue sets a time.Time field (or a pointer to one) from an RFC3339 string, an empty string is left unset
+func setTimeValue(n reflect.Value, v string, debugLogger Logger) error {
+	if v == "" {
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return fmt.Errorf("parsing %q as an RFC3339 time: %+v", v, err)
+	}
+
+	debugLogger.Infof("[Time] Decode %+v", v)
+	if n.Kind() == reflect.Pointer {
+		n.Set(reflect.ValueOf(&t))
+	} else {
+		n.Set(reflect.ValueOf(t))
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ package sdk
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -1035,6 +1036,179 @@ func TestResourceDecode_NestedThreeLevelsDeepMultipleOptionalItems(t *testing.T)
 	}.test(t)
 }
 
+type decodeTestEnum string
+
+const (
+	decodeTestEnumFirst  decodeTestEnum = "First"
+	decodeTestEnumSecond decodeTestEnum = "Second"
+)
+
+func TestResourceDecode_NamedTypesAndTimes(t *testing.T) {
+	type Type struct {
+		Enum         decodeTestEnum            `tfschema:"enum"`
+		OptionalEnum *decodeTestEnum           `tfschema:"optional_enum"`
+		ListOfEnums  []decodeTestEnum          `tfschema:"list_of_enums"`
+		MapOfEnums   map[string]decodeTestEnum `tfschema:"map_of_enums"`
+		Time         time.Time                 `tfschema:"time"`
+		OptionalTime *time.Time                `tfschema:"optional_time"`
+		EmptyTime    time.Time                 `tfschema:"empty_time"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"enum":          "First",
+			"optional_enum": "Second",
+			"list_of_enums": []interface{}{"First", "Second"},
+			"map_of_enums": map[string]interface{}{
+				"hello": "Second",
+			},
+			"time":          "2024-01-02T03:04:05Z",
+			"optional_time": "2024-01-02T03:04:05+01:00",
+			"empty_time":    "",
+		},
+		Input: &Type{},
+		Expected: &Type{
+			Enum:         decodeTestEnumFirst,
+			OptionalEnum: pointer.To(decodeTestEnumSecond),
+			ListOfEnums:  []decodeTestEnum{decodeTestEnumFirst, decodeTestEnumSecond},
+			MapOfEnums: map[string]decodeTestEnum{
+				"hello": decodeTestEnumSecond,
+			},
+			Time:         time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			OptionalTime: pointer.To(time.Date(2024, 1, 2, 3, 4, 5, 0, time.FixedZone("", 3600))),
+		},
+	}.test(t)
+}
+
+func TestResourceDecode_InvalidTime(t *testing.T) {
+	type Type struct {
+		Time time.Time `tfschema:"time"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"time": "02/01/2024",
+		},
+		Input:       &Type{},
+		ExpectError: true,
+	}.test(t)
+}
+
+func TestResourceDecode_NestedObject(t *testing.T) {
+	type Inner struct {
+		Value  string  `tfschema:"value"`
+		Number *int64  `tfschema:"number"`
+		Name   *string `tfschema:"name"`
+	}
+	type Type struct {
+		Object         Inner    `tfschema:"object"`
+		OptionalObject *Inner   `tfschema:"optional_object"`
+		EmptyObject    *Inner   `tfschema:"empty_object"`
+		ListOfObjects  *[]Inner `tfschema:"list_of_objects"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"object": []interface{}{
+				map[string]interface{}{
+					"value":  "first",
+					"number": 0,
+					"name":   "",
+				},
+			},
+			// the zero values for `number` and `name` have been explicitly set, so these are kept
+			"object.0.number": 0,
+			"object.0.name":   "",
+			"optional_object": []interface{}{
+				map[string]interface{}{
+					"value":  "second",
+					"number": 0,
+					"name":   "",
+				},
+			},
+			"empty_object": []interface{}{},
+			"list_of_objects": []interface{}{
+				map[string]interface{}{
+					"value":  "third",
+					"number": 3,
+					"name":   "",
+				},
+				map[string]interface{}{
+					"value":  "fourth",
+					"number": 0,
+					"name":   "",
+				},
+			},
+			"list_of_objects.1.name": "",
+		},
+		Input: &Type{},
+		Expected: &Type{
+			Object: Inner{
+				Value:  "first",
+				Number: pointer.To(int64(0)),
+				Name:   pointer.To(""),
+			},
+			OptionalObject: &Inner{
+				Value: "second",
+			},
+			ListOfObjects: &[]Inner{
+				{
+					Value:  "third",
+					Number: pointer.To(int64(3)),
+				},
+				{
+					Value: "fourth",
+					Name:  pointer.To(""),
+				},
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceDecode_FrameworkNestedPointers(t *testing.T) {
+	type Inner struct {
+		Value  *string `tfschema:"value"`
+		Number *int64  `tfschema:"number"`
+	}
+	type Type struct {
+		Object *Inner  `tfschema:"object"`
+		List   []Inner `tfschema:"list"`
+	}
+
+	// null values within the Plugin Framework are represented as nil, so the zero values are kept
+	state := frameworkStateRetriever{
+		values: map[string]interface{}{
+			"object": []interface{}{
+				map[string]interface{}{
+					"value":  "",
+					"number": nil,
+				},
+			},
+			"list": []interface{}{
+				map[string]interface{}{
+					"value":  nil,
+					"number": 0,
+				},
+			},
+		},
+	}
+	expected := &Type{
+		Object: &Inner{
+			Value: pointer.To(""),
+		},
+		List: []Inner{
+			{
+				Number: pointer.To(int64(0)),
+			},
+		},
+	}
+
+	input := &Type{}
+	if err := decodeReflectedType(input, state, NullLogger{}); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if diff := cmp.Diff(input, expected); diff != "" {
+		t.Fatalf("Output mismatch, diff:\n\n %s", diff)
+	}
+}
+
 func (testData decodeTestData) test(t *testing.T) {
 	debugLogger := ConsoleLogger{}
 	state := testData.stateWrapper()
@@ -6,6 +6,7 @@ package sdk
 import (
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 )
@@ -13,6 +14,10 @@ import (
 // Encode will encode the specified object into the Terraform State
 // NOTE: this requires that the object passed in is a pointer and
 // all fields contain `tfschema` struct tags
+//
+// Named string types are encoded as a string, time.Time as an RFC3339 string
+// and a single nested struct as a list containing one item (for blocks with `MaxItems: 1`).
+// For Resources supporting `tags_all` any tags matching the `ignore_tags` are removed from the `tags` field.
 func (rmd ResourceMetaData) Encode(input interface{}) error {
 	if reflect.TypeOf(input).Kind() != reflect.Ptr {
 		return fmt.Errorf("need a pointer")
@@ -26,6 +31,10 @@ func (rmd ResourceMetaData) Encode(input interface{}) error {
 		return err
 	}
 
+	if v, ok := serialized["tags"].(map[string]interface{}); ok && rmd.tagsAll {
+		serialized["tags"] = tagDefaultsFromMeta(rmd.Client).RemoveIgnored(v)
+	}
+
 	for k, v := range serialized {
 		//lintignore:R001
 		if err := rmd.ResourceData.Set(k, v); err != nil {
@@ -95,7 +104,7 @@ func recurse(objType reflect.Type, objVal reflect.Value, debugLogger Logger) (ou
 				iter := fieldVal.MapRange()
 				attr := make(map[string]interface{})
 				for iter.Next() {
-					attr[iter.Key().String()] = iter.Value().Interface()
+					attr[iter.Key().String()] = encodeMapValue(iter.Value())
 				}
 				output[structTags.hclPath] = attr
 
@@ -106,7 +115,7 @@ func recurse(objType reflect.Type, objVal reflect.Value, debugLogger Logger) (ou
 				case reflect.String:
 					debugLogger.Infof("Setting %q to []string", structTags.hclPath)
 					if sv.Len() > 0 {
-						output[structTags.hclPath] = sv.Interface()
+						output[structTags.hclPath] = encodeStringSlice(sv)
 					} else {
 						output[structTags.hclPath] = make([]string, 0)
 					}
@@ -152,6 +161,14 @@ func recurse(objType reflect.Type, objVal reflect.Value, debugLogger Logger) (ou
 					output[structTags.hclPath] = attr
 				}
 
+			case reflect.Struct:
+				v, err := encodeStruct(fieldVal, debugLogger)
+				if err != nil {
+					return nil, fmt.Errorf("serializing nested object %q: %+v", structTags.hclPath, err)
+				}
+				debugLogger.Infof("Setting %q to %+v", structTags.hclPath, v)
+				output[structTags.hclPath] = v
+
 			case reflect.Pointer:
 				if !fieldVal.IsNil() {
 					pv := fieldVal.Elem()
@@ -180,7 +197,7 @@ func recurse(objType reflect.Type, objVal reflect.Value, debugLogger Logger) (ou
 						iter := pv.MapRange()
 						attr := make(map[string]interface{})
 						for iter.Next() {
-							attr[iter.Key().String()] = iter.Value().Interface()
+							attr[iter.Key().String()] = encodeMapValue(iter.Value())
 						}
 						output[structTags.hclPath] = attr
 
@@ -191,7 +208,7 @@ func recurse(objType reflect.Type, objVal reflect.Value, debugLogger Logger) (ou
 						case reflect.String:
 							debugLogger.Infof("Setting %q to []string", structTags.hclPath)
 							if sv.Len() > 0 {
-								output[structTags.hclPath] = sv.Interface()
+								output[structTags.hclPath] = encodeStringSlice(sv)
 							} else {
 								output[structTags.hclPath] = make([]string, 0)
 							}
@@ -237,6 +254,13 @@ func recurse(objType reflect.Type, objVal reflect.Value, debugLogger Logger) (ou
 							output[structTags.hclPath] = attr
 						}
 
+					case reflect.Struct:
+						v, err := encodeStruct(pv, debugLogger)
+						if err != nil {
+							return nil, fmt.Errorf("serializing nested object %q: %+v", structTags.hclPath, err)
+						}
+						debugLogger.Infof("Setting %q to %+v", structTags.hclPath, v)
+						output[structTags.hclPath] = v
 					}
 				} else {
 					debugLogger.Infof("Setting %q to nil", structTags.hclPath)
@@ -251,3 +275,43 @@ func recurse(objType reflect.Type, objVal reflect.Value, debugLogger Logger) (ou
 
 	return output, nil
 }
+
+// encodeStruct encodes a time.Time as an RFC3339 string (where the zero value is an empty string), and any other
+// struct as a list containing a single nested object, as used for blocks with `MaxItems: 1`
+func encodeStruct(input reflect.Value, debugLogger Logger) (interface{}, error) {
+	if input.Type() == timeType {
+		t := input.Interface().(time.Time)
+		if t.IsZero() {
+			return "", nil
+		}
+		return t.Format(time.RFC3339), nil
+	}
+
+	serialized, err := recurse(input.Type(), input, debugLogger)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{serialized}, nil
+}
+
+// encodeStringSlice returns a []string for a slice of a (potentially named) string type, since the Plugin SDK
+// is unable to set a slice of a named string type
+func encodeStringSlice(input reflect.Value) []string {
+	if v, ok := input.Interface().([]string); ok {
+		return v
+	}
+
+	output := make([]string, input.Len())
+	for i := 0; i < input.Len(); i++ {
+		output[i] = input.Index(i).String()
+	}
+	return output
+}
+
+// encodeMapValue returns the value of a map item, where a named string type is returned as a string
+func encodeMapValue(input reflect.Value) interface{} {
+	if input.Kind() == reflect.String {
+		return input.String()
+	}
+	return input.Interface()
+}
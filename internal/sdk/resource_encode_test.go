@@ -6,9 +6,12 @@ package sdk
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 type encodeTestData struct {
@@ -1048,6 +1051,228 @@ func TestResourceEncode_NestedThreeLevelsDeepMultipleOptionalItems(t *testing.T)
 	}.test(t)
 }
 
+func TestResourceEncode_NamedTypesAndTimes(t *testing.T) {
+	type Type struct {
+		Enum         decodeTestEnum            `tfschema:"enum"`
+		OptionalEnum *decodeTestEnum           `tfschema:"optional_enum"`
+		ListOfEnums  []decodeTestEnum          `tfschema:"list_of_enums"`
+		MapOfEnums   map[string]decodeTestEnum `tfschema:"map_of_enums"`
+		Time         time.Time                 `tfschema:"time"`
+		OptionalTime *time.Time                `tfschema:"optional_time"`
+		EmptyTime    time.Time                 `tfschema:"empty_time"`
+	}
+	encodeTestData{
+		Input: &Type{
+			Enum:         decodeTestEnumFirst,
+			OptionalEnum: pointer.To(decodeTestEnumSecond),
+			ListOfEnums:  []decodeTestEnum{decodeTestEnumFirst, decodeTestEnumSecond},
+			MapOfEnums: map[string]decodeTestEnum{
+				"hello": decodeTestEnumSecond,
+			},
+			Time:         time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			OptionalTime: pointer.To(time.Date(2024, 1, 2, 3, 4, 5, 0, time.FixedZone("", 3600))),
+		},
+		Expected: map[string]interface{}{
+			"enum":          "First",
+			"optional_enum": "Second",
+			"list_of_enums": []string{"First", "Second"},
+			"map_of_enums": map[string]interface{}{
+				"hello": "Second",
+			},
+			"time":          "2024-01-02T03:04:05Z",
+			"optional_time": "2024-01-02T03:04:05+01:00",
+			"empty_time":    "",
+		},
+	}.test(t)
+}
+
+func TestResourceEncode_NestedObject(t *testing.T) {
+	type Inner struct {
+		Value  string `tfschema:"value"`
+		Number *int64 `tfschema:"number"`
+	}
+	type Type struct {
+		Object         Inner  `tfschema:"object"`
+		OptionalObject *Inner `tfschema:"optional_object"`
+		EmptyObject    *Inner `tfschema:"empty_object"`
+	}
+	encodeTestData{
+		Input: &Type{
+			Object: Inner{
+				Value: "first",
+			},
+			OptionalObject: &Inner{
+				Value:  "second",
+				Number: pointer.To(int64(2)),
+			},
+		},
+		Expected: map[string]interface{}{
+			"object": []interface{}{
+				map[string]interface{}{
+					"value":  "first",
+					"number": nil,
+				},
+			},
+			"optional_object": []interface{}{
+				map[string]interface{}{
+					"value":  "second",
+					"number": int64(2),
+				},
+			},
+			"empty_object": nil,
+		},
+	}.test(t)
+}
+
+func TestResourceEncodeDecode_RoundTrip(t *testing.T) {
+	type Inner struct {
+		Value   string          `tfschema:"value"`
+		Enum    *decodeTestEnum `tfschema:"enum"`
+		Created *time.Time      `tfschema:"created"`
+	}
+	type Type struct {
+		Name         string           `tfschema:"name"`
+		Enum         decodeTestEnum   `tfschema:"enum"`
+		OptionalEnum *decodeTestEnum  `tfschema:"optional_enum"`
+		ListOfEnums  []decodeTestEnum `tfschema:"list_of_enums"`
+		Updated      time.Time        `tfschema:"updated"`
+		Object       *Inner           `tfschema:"object"`
+		Objects      *[]Inner         `tfschema:"objects"`
+	}
+
+	nested := map[string]*pluginsdk.Schema{
+		"value": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+		"enum": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+		"created": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+	}
+	resourceSchema := map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+		"enum": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+		"optional_enum": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+		"list_of_enums": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+		},
+		"updated": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+		"object": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     &pluginsdk.Resource{Schema: nested},
+		},
+		"objects": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem:     &pluginsdk.Resource{Schema: nested},
+		},
+	}
+
+	testCases := map[string]Type{
+		"empty": {
+			Name: "empty",
+		},
+		"complete": {
+			Name:         "complete",
+			Enum:         decodeTestEnumFirst,
+			OptionalEnum: pointer.To(decodeTestEnumSecond),
+			ListOfEnums:  []decodeTestEnum{decodeTestEnumSecond, decodeTestEnumFirst},
+			Updated:      time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			Object: &Inner{
+				Value:   "object",
+				Enum:    pointer.To(decodeTestEnumFirst),
+				Created: pointer.To(time.Date(2023, 6, 7, 8, 9, 10, 0, time.UTC)),
+			},
+			Objects: &[]Inner{
+				{
+					Value: "first",
+				},
+				{
+					Value: "second",
+					Enum:  pointer.To(decodeTestEnumSecond),
+				},
+			},
+		},
+	}
+
+	for name, input := range testCases {
+		t.Run(name, func(t *testing.T) {
+			encoded, err := recurse(reflect.TypeOf(input), reflect.ValueOf(input), NullLogger{})
+			if err != nil {
+				t.Fatalf("encoding: %+v", err)
+			}
+
+			// the encoded values are then used as the configuration, from which the values are decoded
+			d := schema.TestResourceDataRaw(t, resourceSchema, roundTripConfig(encoded).(map[string]interface{}))
+			metadata := ResourceMetaData{
+				ResourceData:             d,
+				serializationDebugLogger: NullLogger{},
+			}
+
+			var output Type
+			if err := metadata.Decode(&output); err != nil {
+				t.Fatalf("decoding: %+v", err)
+			}
+
+			if diff := cmp.Diff(input, output); diff != "" {
+				t.Fatalf("Output mismatch, diff:\n\n %s", diff)
+			}
+		})
+	}
+}
+
+// roundTripConfig converts the encoded values into the form used for configuration, where unset values are omitted
+func roundTripConfig(input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		output := make(map[string]interface{})
+		for key, val := range v {
+			if val == nil || val == "" {
+				continue
+			}
+			output[key] = roundTripConfig(val)
+		}
+		return output
+
+	case []interface{}:
+		output := make([]interface{}, 0)
+		for _, val := range v {
+			output = append(output, roundTripConfig(val))
+		}
+		return output
+
+	case []string:
+		output := make([]interface{}, 0)
+		for _, val := range v {
+			output = append(output, val)
+		}
+		return output
+	}
+
+	return input
+}
+
 func (testData encodeTestData) test(t *testing.T) {
 	objType := reflect.TypeOf(testData.Input).Elem()
 	objVal := reflect.ValueOf(testData.Input).Elem()
@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// ResourceIDTypes defines the Resource ID type used for a field before and after a State Upgrade
+type ResourceIDTypes struct {
+	// Old is the Resource ID type that the existing value is parsed (insensitively) using
+	Old resourceids.ResourceId
+
+	// New is the Resource ID type that the value is rewritten using, in canonical form - which
+	// can be omitted when only the casing of the value needs to be normalised.
+	//
+	// The user-specifiable segments of the Old Resource ID (that is, anything other than Static and
+	// Resource Provider segments) are mapped to the New Resource ID in order.
+	New resourceids.ResourceId
+}
+
+var _ pluginsdk.StateUpgrade = ResourceIDStateUpgrade{}
+
+// ResourceIDStateUpgrade is a generic State Upgrade which rewrites the `id` and any other ID-valued fields
+// from one Resource ID type to another, for example when the casing or shape of a Resource ID changes.
+//
+// This can be used by both Typed Resources (via StateUpgradeData) and Untyped Resources (via pluginsdk.StateUpgrades).
+type ResourceIDStateUpgrade struct {
+	// PointInTimeSchema is the Schema for this Resource at the time of this version
+	PointInTimeSchema map[string]*pluginsdk.Schema
+
+	// ID defines the Resource ID types used for the `id` field
+	ID ResourceIDTypes
+
+	// Fields is an optional map of the path to any other ID-valued field to the Resource ID types used for this field.
+	// The field can either be a String or a List/Set of Strings - and fields within a block can be specified using
+	// the name of the block (for example `ip_configuration.subnet_id`), in which case every item is rewritten.
+	Fields map[string]ResourceIDTypes
+}
+
+func (u ResourceIDStateUpgrade) Schema() map[string]*pluginsdk.Schema {
+	return u.PointInTimeSchema
+}
+
+func (u ResourceIDStateUpgrade) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if err := upgradeResourceIDField(rawState, []string{"id"}, u.ID); err != nil {
+			return rawState, err
+		}
+
+		paths := make([]string, 0, len(u.Fields))
+		for k := range u.Fields {
+			paths = append(paths, k)
+		}
+		sort.Strings(paths)
+
+		for _, path := range paths {
+			if err := upgradeResourceIDField(rawState, strings.Split(path, "."), u.Fields[path]); err != nil {
+				return rawState, fmt.Errorf("upgrading %q: %+v", path, err)
+			}
+		}
+
+		return rawState, nil
+	}
+}
+
+func upgradeResourceIDField(rawState map[string]interface{}, path []string, types ResourceIDTypes) error {
+	value, ok := rawState[path[0]]
+	if !ok || value == nil {
+		return nil
+	}
+
+	if len(path) > 1 {
+		items, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("expected %q to be a block but got %T", path[0], value)
+		}
+
+		for _, item := range items {
+			// an empty block can be represented as nil
+			if item == nil {
+				continue
+			}
+
+			nested, ok := item.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("expected the items within %q to be an object but got %T", path[0], item)
+			}
+			if err := upgradeResourceIDField(nested, path[1:], types); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		updated, err := types.upgrade(v)
+		if err != nil {
+			return err
+		}
+		rawState[path[0]] = updated
+
+	case []interface{}:
+		for i, item := range v {
+			raw, ok := item.(string)
+			if !ok {
+				return fmt.Errorf("expected the items within %q to be a string but got %T", path[0], item)
+			}
+
+			updated, err := types.upgrade(raw)
+			if err != nil {
+				return err
+			}
+			v[i] = updated
+		}
+
+	default:
+		return fmt.Errorf("expected %q to be a string or a list of strings but got %T", path[0], value)
+	}
+
+	return nil
+}
+
+func (t ResourceIDTypes) upgrade(input string) (string, error) {
+	if input == "" {
+		return input, nil
+	}
+
+	if t.Old == nil {
+		return "", fmt.Errorf("internal-error: the Old Resource ID type must be specified")
+	}
+	newType := t.New
+	if newType == nil {
+		newType = t.Old
+	}
+
+	parsed, err := resourceids.NewParserFromResourceIdType(t.Old).Parse(input, true)
+	if err != nil {
+		return "", fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	oldSegments := userSpecifiableSegments(t.Old)
+	newSegments := userSpecifiableSegments(newType)
+	if len(oldSegments) != len(newSegments) {
+		return "", fmt.Errorf("internal-error: the Resource ID types %T and %T contain a different number of user-specifiable segments (%d and %d)", t.Old, newType, len(oldSegments), len(newSegments))
+	}
+
+	result := resourceids.ParseResult{
+		Parsed:   make(map[string]string),
+		RawInput: input,
+	}
+	for i, segment := range newSegments {
+		result.Parsed[segment.Name] = parsed.Parsed[oldSegments[i].Name]
+	}
+
+	// a new instance is used so that the Resource ID type provided can be safely shared
+	idType := reflect.TypeOf(newType)
+	if idType.Kind() != reflect.Ptr {
+		return "", fmt.Errorf("internal-error: the Resource ID type %T must be a pointer", newType)
+	}
+	id, ok := reflect.New(idType.Elem()).Interface().(resourceids.ResourceId)
+	if !ok {
+		return "", fmt.Errorf("internal-error: %T is not a Resource ID type", newType)
+	}
+	if err := id.FromParseResult(result); err != nil {
+		return "", fmt.Errorf("populating %T from %q: %+v", newType, input, err)
+	}
+
+	output := id.ID()
+	if output != input {
+		log.Printf("[DEBUG] Updating ID from %q to %q", input, output)
+	}
+	return output, nil
+}
+
+func userSpecifiableSegments(id resourceids.ResourceId) []resourceids.Segment {
+	out := make([]resourceids.Segment, 0)
+	for _, segment := range id.Segments() {
+		if segment.Type == resourceids.StaticSegmentType || segment.Type == resourceids.ResourceProviderSegmentType {
+			continue
+		}
+		out = append(out, segment)
+	}
+	return out
+}
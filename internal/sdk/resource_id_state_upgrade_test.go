@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ resourceids.ResourceId = &testLegacyThingId{}
+
+// testLegacyThingId is the shape of a Resource ID prior to the API renaming `things` to `widgets`
+type testLegacyThingId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	ThingName         string
+}
+
+func (id *testLegacyThingId) FromParseResult(input resourceids.ParseResult) error {
+	id.SubscriptionId = input.Parsed["subscriptionId"]
+	id.ResourceGroupName = input.Parsed["resourceGroupName"]
+	id.ThingName = input.Parsed["thingName"]
+	return nil
+}
+
+func (id *testLegacyThingId) ID() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Example/things/%s", id.SubscriptionId, id.ResourceGroupName, id.ThingName)
+}
+
+func (id *testLegacyThingId) String() string {
+	return fmt.Sprintf("Thing %q (Resource Group %q)", id.ThingName, id.ResourceGroupName)
+}
+
+func (id *testLegacyThingId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftExample", "Microsoft.Example", "Microsoft.Example"),
+		resourceids.StaticSegment("staticThings", "things", "things"),
+		resourceids.UserSpecifiedSegment("thingName", "thingValue"),
+	}
+}
+
+var _ resourceids.ResourceId = &testWidgetId{}
+
+type testWidgetId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	WidgetName        string
+}
+
+func (id *testWidgetId) FromParseResult(input resourceids.ParseResult) error {
+	id.SubscriptionId = input.Parsed["subscriptionId"]
+	id.ResourceGroupName = input.Parsed["resourceGroupName"]
+	id.WidgetName = input.Parsed["widgetName"]
+	return nil
+}
+
+func (id *testWidgetId) ID() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Example/widgets/%s", id.SubscriptionId, id.ResourceGroupName, id.WidgetName)
+}
+
+func (id *testWidgetId) String() string {
+	return fmt.Sprintf("Widget %q (Resource Group %q)", id.WidgetName, id.ResourceGroupName)
+}
+
+func (id *testWidgetId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftExample", "Microsoft.Example", "Microsoft.Example"),
+		resourceids.StaticSegment("staticWidgets", "widgets", "widgets"),
+		resourceids.UserSpecifiedSegment("widgetName", "widgetValue"),
+	}
+}
+
+func TestResourceIDStateUpgrade(t *testing.T) {
+	testData := []struct {
+		name     string
+		upgrade  ResourceIDStateUpgrade
+		input    map[string]interface{}
+		expected map[string]interface{}
+		errors   bool
+	}{
+		{
+			name: "id casing normalised",
+			upgrade: ResourceIDStateUpgrade{
+				ID: ResourceIDTypes{
+					Old: &commonids.ResourceGroupId{},
+				},
+			},
+			input: map[string]interface{}{
+				"id":   "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/resourcegroups/example",
+				"name": "example",
+			},
+			expected: map[string]interface{}{
+				"id":   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+				"name": "example",
+			},
+		},
+		{
+			name: "id already canonical",
+			upgrade: ResourceIDStateUpgrade{
+				ID: ResourceIDTypes{
+					Old: &commonids.ResourceGroupId{},
+				},
+			},
+			input: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+			},
+			expected: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+			},
+		},
+		{
+			name: "id shape changed",
+			upgrade: ResourceIDStateUpgrade{
+				ID: ResourceIDTypes{
+					Old: &testLegacyThingId{},
+					New: &testWidgetId{},
+				},
+			},
+			input: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/microsoft.example/Things/thing1",
+			},
+			expected: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Example/widgets/thing1",
+			},
+		},
+		{
+			name: "id and nominated fields",
+			upgrade: ResourceIDStateUpgrade{
+				ID: ResourceIDTypes{
+					Old: &testLegacyThingId{},
+					New: &testWidgetId{},
+				},
+				Fields: map[string]ResourceIDTypes{
+					"resource_group_id": {
+						Old: &commonids.ResourceGroupId{},
+					},
+					"subnet_ids": {
+						Old: &commonids.SubnetId{},
+					},
+					"ip_configuration.subnet_id": {
+						Old: &commonids.SubnetId{},
+					},
+				},
+			},
+			input: map[string]interface{}{
+				"id":                "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Example/things/thing1",
+				"resource_group_id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/example",
+				"subnet_ids": []interface{}{
+					"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Network/VirtualNetworks/network1/Subnets/subnet1",
+					"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet2",
+				},
+				"ip_configuration": []interface{}{
+					map[string]interface{}{
+						"name":      "first",
+						"subnet_id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Network/virtualnetworks/network1/subnets/subnet1",
+					},
+					map[string]interface{}{
+						"name":      "second",
+						"subnet_id": "",
+					},
+				},
+			},
+			expected: map[string]interface{}{
+				"id":                "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Example/widgets/thing1",
+				"resource_group_id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+				"subnet_ids": []interface{}{
+					"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+					"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet2",
+				},
+				"ip_configuration": []interface{}{
+					map[string]interface{}{
+						"name":      "first",
+						"subnet_id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+					},
+					map[string]interface{}{
+						"name":      "second",
+						"subnet_id": "",
+					},
+				},
+			},
+		},
+		{
+			name: "nominated fields not set",
+			upgrade: ResourceIDStateUpgrade{
+				ID: ResourceIDTypes{
+					Old: &commonids.ResourceGroupId{},
+				},
+				Fields: map[string]ResourceIDTypes{
+					"subnet_id": {
+						Old: &commonids.SubnetId{},
+					},
+					"ip_configuration.subnet_id": {
+						Old: &commonids.SubnetId{},
+					},
+				},
+			},
+			input: map[string]interface{}{
+				"id":        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+				"subnet_id": nil,
+			},
+			expected: map[string]interface{}{
+				"id":        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+				"subnet_id": nil,
+			},
+		},
+		{
+			name: "invalid id",
+			upgrade: ResourceIDStateUpgrade{
+				ID: ResourceIDTypes{
+					Old: &commonids.ResourceGroupId{},
+				},
+			},
+			input: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-9876-4563-123456789012",
+			},
+			errors: true,
+		},
+		{
+			name: "invalid nominated field",
+			upgrade: ResourceIDStateUpgrade{
+				ID: ResourceIDTypes{
+					Old: &commonids.ResourceGroupId{},
+				},
+				Fields: map[string]ResourceIDTypes{
+					"subnet_id": {
+						Old: &commonids.SubnetId{},
+					},
+				},
+			},
+			input: map[string]interface{}{
+				"id":        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+				"subnet_id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+			},
+			errors: true,
+		},
+		{
+			name: "mismatched resource id types",
+			upgrade: ResourceIDStateUpgrade{
+				ID: ResourceIDTypes{
+					Old: &commonids.ResourceGroupId{},
+					New: &commonids.SubnetId{},
+				},
+			},
+			input: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+			},
+			errors: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual, err := v.upgrade.UpgradeFunc()(context.TODO(), v.input, nil)
+		if v.errors {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+
+		if !reflect.DeepEqual(v.expected, actual) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestResourceIDStateUpgradeWithPluginSdk(t *testing.T) {
+	upgrade := ResourceIDStateUpgrade{
+		PointInTimeSchema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+		ID: ResourceIDTypes{
+			Old: &commonids.ResourceGroupId{},
+		},
+	}
+
+	upgraders := pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
+		0: upgrade,
+	})
+	if len(upgraders) != 1 {
+		t.Fatalf("expected a single State Upgrader but got %d", len(upgraders))
+	}
+
+	actual, err := upgraders[0].Upgrade(context.TODO(), map[string]interface{}{
+		"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/example",
+	}, nil)
+	if err != nil {
+		t.Fatalf("upgrading: %+v", err)
+	}
+	if expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example"; actual["id"] != expected {
+		t.Fatalf("expected the `id` to be %q but got %q", expected, actual["id"])
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// this is only here to aid testing
+var validateResourceTypeLocation = resourceproviders.ValidateResourceTypeLocation
+
+// addResourceTypeLocationValidation validates at plan time that the Resource Type is available in the `location`
+// specified for the resource, using the Resource Providers retrieved when the Provider was configured
+func addResourceTypeLocationValidation(resource *pluginsdk.Resource, resourceType string) {
+	if _, ok := resource.Schema["location"]; !ok || resourceType == "" {
+		return
+	}
+
+	customizeDiff := customizeDiffResourceTypeLocation(resourceType)
+	if resource.CustomizeDiff != nil {
+		resource.CustomizeDiff = pluginsdk.CustomDiffInSequence(resource.CustomizeDiff, customizeDiff)
+	} else {
+		resource.CustomizeDiff = customizeDiff
+	}
+}
+
+// EnableResourceTypeLocationValidation validates at plan time that the Resource Type is available in the `location`
+// specified for an untyped Resource - Typed Resources should instead implement `ResourceWithAzureResourceType`
+func EnableResourceTypeLocationValidation(resource *pluginsdk.Resource, resourceType string) {
+	if resource == nil {
+		return
+	}
+
+	addResourceTypeLocationValidation(resource, resourceType)
+}
+
+func customizeDiffResourceTypeLocation(resourceType string) pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+		// the location of an existing resource has already been validated by the API
+		if diff.Id() != "" && !diff.HasChange("location") {
+			return nil
+		}
+
+		// the location may not be known until apply time, for example when it's a reference to a new Resource Group
+		if !diff.NewValueKnown("location") {
+			return nil
+		}
+
+		location, ok := diff.Get("location").(string)
+		if !ok {
+			return nil
+		}
+
+		return validateResourceTypeLocation(resourceType, location)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func testResourceTypeLocationResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"location": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func TestResourceTypeLocationValidationSkipsResourcesWithoutLocation(t *testing.T) {
+	resource := testResourceTypeLocationResource()
+	delete(resource.Schema, "location")
+
+	addResourceTypeLocationValidation(resource, "Microsoft.Web/sites")
+
+	if resource.CustomizeDiff != nil {
+		t.Fatalf("expected no CustomizeDiff to be configured for a Resource without `location`")
+	}
+}
+
+func TestResourceTypeLocationValidationDiff(t *testing.T) {
+	validated := make([]string, 0)
+	original := validateResourceTypeLocation
+	validateResourceTypeLocation = func(resourceType string, location string) error {
+		validated = append(validated, fmt.Sprintf("%s@%s", resourceType, location))
+		if location != "westeurope" {
+			return fmt.Errorf("the Resource Type %q is not available in the Location %q", resourceType, location)
+		}
+		return nil
+	}
+	defer func() {
+		validateResourceTypeLocation = original
+	}()
+
+	existingCalled := false
+	resource := testResourceTypeLocationResource()
+	resource.CustomizeDiff = func(_ context.Context, _ *pluginsdk.ResourceDiff, _ interface{}) error {
+		existingCalled = true
+		return nil
+	}
+	addResourceTypeLocationValidation(resource, "Microsoft.Web/sites")
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":     "example",
+		"location": "westeurope",
+	})
+	if _, err := resource.Diff(context.TODO(), nil, config, nil); err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	if !existingCalled {
+		t.Fatalf("expected the existing CustomizeDiff to be called")
+	}
+
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":     "example",
+		"location": "westus",
+	})
+	if _, err := resource.Diff(context.TODO(), nil, config, nil); err == nil {
+		t.Fatalf("expected an error for a Location the Resource Type isn't available in")
+	}
+
+	// the Location of an existing resource which isn't changing isn't validated
+	state := &terraform.InstanceState{
+		ID: "example",
+		Attributes: map[string]string{
+			"id":       "example",
+			"name":     "example",
+			"location": "westus",
+		},
+	}
+	validated = make([]string, 0)
+	if _, err := resource.Diff(context.TODO(), state, config, nil); err != nil {
+		t.Fatalf("expected no error for an existing resource but got: %+v", err)
+	}
+	if len(validated) != 0 {
+		t.Fatalf("expected the Location of an existing resource not to be validated but got %+v", validated)
+	}
+}
@@ -24,6 +24,21 @@ type TypedServiceRegistration interface {
 	WebsiteCategories() []string
 }
 
+// FrameworkTypedServiceRegistration is a superset of TypedServiceRegistration allowing a Service to
+// also contribute Data Sources and Resources built natively on the Terraform Plugin Framework
+//
+// NOTE: this is intentionally an optional interface as most Services only contain Data Sources and
+// Resources built on top of the Plugin SDK
+type FrameworkTypedServiceRegistration interface {
+	TypedServiceRegistration
+
+	// FrameworkDataSources returns a list of Plugin Framework Data Sources supported by this Service
+	FrameworkDataSources() []FrameworkDataSource
+
+	// FrameworkResources returns a list of Plugin Framework Resources supported by this Service
+	FrameworkResources() []FrameworkResource
+}
+
 // UntypedServiceRegistration is the interface used for untyped/raw Plugin SDK resources
 // in the future this'll be superseded by the TypedServiceRegistration which allows for
 // stronger Typed resources to be used.
@@ -0,0 +1,348 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// EnableTagsAll applies the `default_tags` and `ignore_tags` from the Provider block to a taggable Resource - that
+// is one defining a configurable `tags` map, as returned by the `commonschema.Tags*` and `tags.Schema*` helpers - by
+// adding the computed `tags_all` field (see `tags.SchemaTagsAll`). The `default_tags` are merged into the tags sent
+// to Azure by `tags.Expand`, `tags.ExpandPointer` and `tags.FromTypedObject` (and into the `tags` of a Typed Model
+// by `ResourceMetaData.Decode`), and the `ignore_tags` are removed from the tags returned from Azure by the
+// equivalent Flatten functions (and `ResourceMetaData.Encode`) - and the functions of the Resource are wrapped so that:
+//
+//   - after Create, Read and Update, `tags_all` contains the tags returned from Azure less any `ignore_tags`,
+//     and `tags` omits any `default_tags` which weren't explicitly configured on the Resource
+//   - during Update, any tags matching the `ignore_tags` which exist on the resource in Azure are carried into the
+//     tags sent to Azure, so that these aren't removed when the tags are replaced
+//   - during plan, `tags_all` is calculated from `tags` and `default_tags`, and the merged set is validated
+//
+// Since the `default_tags` can change without `tags` changing, Update functions must check
+// `HasChanges("tags", "tags_all")` rather than `HasChange("tags")` when determining whether to send the tags to
+// Azure. Resources without a configurable `tags` map are left as-is.
+func EnableTagsAll(resource *pluginsdk.Resource) {
+	if !SupportsTagsAll(resource) {
+		return
+	}
+
+	tagsSchema := resource.Schema["tags"]
+	if _, ok := resource.Schema["tags_all"]; !ok {
+		resource.Schema["tags_all"] = tags.SchemaTagsAll()
+	}
+
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+	supportsUpdate := resource.Update != nil || resource.UpdateContext != nil || resource.UpdateWithoutTimeout != nil //nolint:staticcheck
+
+	validateFunc := tagsSchema.ValidateFunc
+	if validateFunc == nil {
+		validateFunc = tags.Validate
+	}
+
+	resource.Create = wrapLegacyFuncWithTagsAll(resource.Create, tagsAllCreate) //nolint:staticcheck
+	resource.CreateContext = wrapContextFuncWithTagsAll(resource.CreateContext, tagsAllCreate)
+	resource.CreateWithoutTimeout = wrapContextFuncWithTagsAll(resource.CreateWithoutTimeout, tagsAllCreate)
+	resource.Read = wrapLegacyFuncWithTagsAll(resource.Read, tagsAllRead) //nolint:staticcheck
+	resource.ReadContext = wrapContextFuncWithTagsAll(resource.ReadContext, tagsAllRead)
+	resource.ReadWithoutTimeout = wrapContextFuncWithTagsAll(resource.ReadWithoutTimeout, tagsAllRead)
+	resource.Update = wrapLegacyFuncWithTagsAll(resource.Update, tagsAllUpdate) //nolint:staticcheck
+	resource.UpdateContext = wrapContextFuncWithTagsAll(resource.UpdateContext, tagsAllUpdate)
+	resource.UpdateWithoutTimeout = wrapContextFuncWithTagsAll(resource.UpdateWithoutTimeout, tagsAllUpdate)
+
+	// where tags can't be updated in-place, a change to the `default_tags` has to recreate the resource too
+	customizeDiff := customizeDiffTagsAll(validateFunc, tagsSchema.ForceNew || !supportsUpdate)
+	if resource.CustomizeDiff != nil {
+		resource.CustomizeDiff = pluginsdk.CustomDiffInSequence(resource.CustomizeDiff, customizeDiff)
+	} else {
+		resource.CustomizeDiff = customizeDiff
+	}
+}
+
+// SupportsTagsAll returns whether the `default_tags` and `ignore_tags` from the Provider block apply to the Resource,
+// which is the case when it defines a configurable `tags` map of strings
+func SupportsTagsAll(resource *pluginsdk.Resource) bool {
+	if resource == nil || resource.Schema == nil {
+		return false
+	}
+
+	tagsSchema, ok := resource.Schema["tags"]
+	if !ok || tagsSchema == nil || tagsSchema.Type != pluginsdk.TypeMap || !tagsSchema.Optional {
+		return false
+	}
+	if elem, ok := tagsSchema.Elem.(*pluginsdk.Schema); ok && elem.Type != pluginsdk.TypeString {
+		return false
+	}
+
+	if tagsAll, ok := resource.Schema["tags_all"]; ok {
+		// a Resource can define `tags_all` itself, but only as the computed field this manages
+		return tagsAll.Computed && !tagsAll.Optional && tagsAll.Type == pluginsdk.TypeMap
+	}
+
+	return true
+}
+
+type tagsAllOperation string
+
+const (
+	tagsAllCreate tagsAllOperation = "create"
+	tagsAllRead   tagsAllOperation = "read"
+	tagsAllUpdate tagsAllOperation = "update"
+)
+
+func tagDefaultsFromMeta(meta interface{}) tags.ProviderDefaults {
+	if client, ok := meta.(*clients.Client); ok && client != nil {
+		return client.TagDefaults
+	}
+
+	return tags.ProviderDefaults{}
+}
+
+func wrapLegacyFuncWithTagsAll(in func(d *pluginsdk.ResourceData, meta interface{}) error, operation tagsAllOperation) func(d *pluginsdk.ResourceData, meta interface{}) error {
+	if in == nil {
+		return nil
+	}
+
+	return func(d *pluginsdk.ResourceData, meta interface{}) error {
+		ctx := context.Background()
+		if client, ok := meta.(*clients.Client); ok && client != nil && client.StopContext != nil {
+			ctx = client.StopContext
+		}
+
+		defaults := tagDefaultsFromMeta(meta)
+		configured, err := beforeTagsAll(ctx, d, meta, defaults, operation)
+		if err != nil {
+			return err
+		}
+
+		if err := in(d, meta); err != nil {
+			restoreConfiguredTags(d, configured)
+			return err
+		}
+
+		return afterTagsAll(d, defaults, configured, operation)
+	}
+}
+
+func wrapContextFuncWithTagsAll(in func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics, operation tagsAllOperation) func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	if in == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+		defaults := tagDefaultsFromMeta(meta)
+		configured, err := beforeTagsAll(ctx, d, meta, defaults, operation)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		diags := in(ctx, d, meta)
+		if diags.HasError() {
+			restoreConfiguredTags(d, configured)
+			return diags
+		}
+
+		if err := afterTagsAll(d, defaults, configured, operation); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		return diags
+	}
+}
+
+// retrieveRemoteTags returns the tags for the resource with the specified ID from Azure, using the Tags API (which
+// is available for every taggable Azure Resource Manager resource) - or nil when the ID isn't a Resource Manager ID
+var retrieveRemoteTags = func(ctx context.Context, meta interface{}, id string) (map[string]string, error) {
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil || client.Resource == nil || client.Resource.TagsClient == nil {
+		return nil, nil
+	}
+
+	// for example the data plane resources, or resources using a composite ID
+	if !strings.HasPrefix(id, "/") || strings.Contains(id, "|") {
+		return nil, nil
+	}
+
+	resp, err := client.Resource.TagsClient.GetAtScope(ctx, commonids.NewScopeID(id))
+	if err != nil {
+		return nil, err
+	}
+	if model := resp.Model; model != nil && model.Properties.Tags != nil {
+		return *model.Properties.Tags, nil
+	}
+
+	return nil, nil
+}
+
+// beforeTagsAll returns the tags configured on the Resource prior to the operation, and when updating, adds any tags
+// matching the `ignore_tags` which exist on the resource in Azure into `tags` - so that these are included in the
+// tags sent to Azure (which generally replace the existing tags) rather than being removed
+func beforeTagsAll(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, defaults tags.ProviderDefaults, operation tagsAllOperation) (map[string]interface{}, error) {
+	configured := d.Get("tags").(map[string]interface{})
+
+	if operation != tagsAllUpdate || d.Id() == "" || (len(defaults.IgnoreKeys) == 0 && len(defaults.IgnoreKeyPrefixes) == 0) {
+		return configured, nil
+	}
+
+	remote, err := retrieveRemoteTags(ctx, meta, d.Id())
+	if err != nil {
+		// this is best-effort, since not every resource supports the Tags API
+		log.Printf("[WARN] retrieving the existing tags for %q to retain the `ignore_tags`: %+v", d.Id(), err)
+		return configured, nil
+	}
+
+	ignored := make(map[string]interface{})
+	for k, v := range remote {
+		if _, ok := configured[k]; !ok && defaults.IsIgnored(k) {
+			ignored[k] = v
+		}
+	}
+	if len(ignored) == 0 {
+		return configured, nil
+	}
+
+	withIgnored := make(map[string]interface{}, len(configured)+len(ignored))
+	for k, v := range ignored {
+		withIgnored[k] = v
+	}
+	for k, v := range configured {
+		withIgnored[k] = v
+	}
+	if err := d.Set("tags", withIgnored); err != nil {
+		return nil, fmt.Errorf("retaining the tags matching the `ignore_tags`: %+v", err)
+	}
+
+	return configured, nil
+}
+
+// restoreConfiguredTags restores the tags configured on the Resource when the operation failed, so that (when the
+// Resource exists) the state doesn't contain the tags carried into `tags` by beforeTagsAll, or returned from Azure
+func restoreConfiguredTags(d *pluginsdk.ResourceData, configured map[string]interface{}) {
+	if d.Id() == "" {
+		return
+	}
+
+	if err := d.Set("tags", configured); err != nil {
+		log.Printf("[WARN] restoring `tags` for %q: %+v", d.Id(), err)
+	}
+}
+
+// afterTagsAll splits the tags returned from Azure into `tags` and `tags_all` - where `configured` contains
+// the tags which were defined on the Resource prior to the operation, used to retain any tags which were
+// explicitly configured with the same key and value as a default tag. The `ignore_tags` are removed here too,
+// for Resources which don't flatten the tags using the `tags` package.
+func afterTagsAll(d *pluginsdk.ResourceData, defaults tags.ProviderDefaults, configured map[string]interface{}, operation tagsAllOperation) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	all := d.Get("tags").(map[string]interface{})
+	if operation != tagsAllRead {
+		// the `default_tags` were sent to Azure, but won't be present when the Resource doesn't read the tags back
+		all = defaults.Merge(all)
+	}
+	all = defaults.RemoveIgnored(all)
+
+	resourceTags := make(map[string]interface{}, len(all))
+	for k, v := range all {
+		if _, ok := configured[k]; !ok && defaults.IsDefault(k, v.(string)) {
+			continue
+		}
+		resourceTags[k] = v
+	}
+
+	if err := d.Set("tags", resourceTags); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+	if err := d.Set("tags_all", all); err != nil {
+		return fmt.Errorf("setting `tags_all`: %+v", err)
+	}
+
+	return nil
+}
+
+// mergeDefaultTagsIntoModel merges the `default_tags` into the top-level `tags` field of the specified Typed Model,
+// so that these are sent to Azure by Typed Resources which use the tags from the model as-is
+func mergeDefaultTagsIntoModel(input interface{}, defaults tags.ProviderDefaults) {
+	if len(defaults.DefaultTags) == 0 {
+		return
+	}
+
+	objType := reflect.TypeOf(input).Elem()
+	objVal := reflect.ValueOf(input).Elem()
+	for i := 0; i < objType.NumField(); i++ {
+		field := objType.Field(i)
+		structTags, err := parseStructTags(field.Tag)
+		if err != nil || structTags == nil || structTags.hclPath != "tags" {
+			continue
+		}
+		if field.Type.Kind() != reflect.Map || field.Type.Key().Kind() != reflect.String {
+			continue
+		}
+
+		fieldVal := objVal.Field(i)
+		if fieldVal.IsNil() {
+			fieldVal.Set(reflect.MakeMapWithSize(field.Type, len(defaults.DefaultTags)))
+		}
+		for k, v := range defaults.DefaultTags {
+			key := reflect.ValueOf(k).Convert(field.Type.Key())
+			if fieldVal.MapIndex(key).IsValid() {
+				continue
+			}
+			fieldVal.SetMapIndex(key, reflect.ValueOf(v).Convert(field.Type.Elem()))
+		}
+	}
+}
+
+// customizeDiffTagsAll plans the value for `tags_all` from `tags` and the `default_tags`, validating the
+// combined set of tags using the validation function defined for `tags`
+func customizeDiffTagsAll(validateFunc pluginsdk.SchemaValidateFunc, forceNew bool) pluginsdk.CustomizeDiffFunc {
+	return func(_ context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+		if !d.NewValueKnown("tags") {
+			return d.SetNewComputed("tags_all")
+		}
+
+		defaults := tagDefaultsFromMeta(meta)
+		merged := defaults.Merge(d.Get("tags").(map[string]interface{}))
+		if _, errs := validateFunc(merged, "tags"); len(errs) > 0 {
+			return fmt.Errorf("validating `tags` combined with the `default_tags` from the Provider block: %+v", errs[0])
+		}
+
+		all := defaults.RemoveIgnored(merged)
+		if old, _ := d.GetChange("tags_all"); reflect.DeepEqual(old, all) {
+			return nil
+		}
+
+		if err := d.SetNew("tags_all", all); err != nil {
+			return fmt.Errorf("setting `tags_all`: %+v", err)
+		}
+
+		// state written before `tags_all` was available (and not yet refreshed) doesn't contain it, in which case
+		// the Resource mustn't be recreated since the effective set of tags may not have changed
+		if forceNew && d.Id() != "" && hasTagsAllInState(d) {
+			return d.ForceNew("tags_all")
+		}
+
+		return nil
+	}
+}
+
+func hasTagsAllInState(d *pluginsdk.ResourceDiff) bool {
+	state := d.GetRawState()
+	if state.IsNull() || !state.IsKnown() || !state.Type().IsObjectType() || !state.Type().HasAttribute("tags_all") {
+		return false
+	}
+
+	return !state.GetAttr("tags_all").IsNull()
+}
@@ -0,0 +1,574 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func testTagsAllResource(apiTags *map[string]interface{}) *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags":     tags.Schema(),
+			"tags_all": tags.SchemaTagsAll(),
+		},
+		// emulate a Resource which sends `tags` to the API (replacing the existing tags) and then reads back
+		// whatever the API returns
+		CreateContext: func(_ context.Context, d *pluginsdk.ResourceData, _ interface{}) diag.Diagnostics {
+			*apiTags = tags.FlattenWithoutIgnoring(tags.Expand(d.Get("tags").(map[string]interface{})))
+			d.SetId("example")
+			return nil
+		},
+		ReadContext: func(_ context.Context, d *pluginsdk.ResourceData, _ interface{}) diag.Diagnostics {
+			if err := tags.FlattenAndSet(d, tags.ExpandWithoutDefaults(*apiTags)); err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		},
+		UpdateContext: func(_ context.Context, d *pluginsdk.ResourceData, _ interface{}) diag.Diagnostics {
+			if d.HasChanges("tags", "tags_all") {
+				*apiTags = tags.FlattenWithoutIgnoring(tags.Expand(d.Get("tags").(map[string]interface{})))
+			}
+			return nil
+		},
+		DeleteContext: func(_ context.Context, _ *pluginsdk.ResourceData, _ interface{}) diag.Diagnostics {
+			return nil
+		},
+	}
+}
+
+func testTagsAllMeta(t *testing.T) *clients.Client {
+	meta := &clients.Client{
+		TagDefaults: tags.ProviderDefaults{
+			DefaultTags: map[string]string{
+				"environment": "production",
+				"team":        "platform",
+			},
+			IgnoreKeys:        []string{"CreatedBy"},
+			IgnoreKeyPrefixes: []string{"policy-"},
+		},
+	}
+	configureTestTagDefaults(t, meta)
+
+	return meta
+}
+
+// configureTestTagDefaults configures the `default_tags` and `ignore_tags` used by the Expand/Flatten functions in
+// the `tags` package, as done when the Provider is configured, for the duration of the test
+func configureTestTagDefaults(t *testing.T, meta *clients.Client) {
+	tags.ConfigureProviderDefaults(meta.TagDefaults)
+	t.Cleanup(func() {
+		tags.ConfigureProviderDefaults(tags.ProviderDefaults{})
+	})
+}
+
+func TestEnableTagsAllAddsTagsAll(t *testing.T) {
+	apiTags := map[string]interface{}{}
+	resource := testTagsAllResource(&apiTags)
+	delete(resource.Schema, "tags_all")
+
+	EnableTagsAll(resource)
+
+	tagsAll, ok := resource.Schema["tags_all"]
+	if !ok {
+		t.Fatalf("expected `tags_all` to be added to a Resource defining `tags`")
+	}
+	if !tagsAll.Computed || tagsAll.Optional {
+		t.Fatalf("expected `tags_all` to be Computed only")
+	}
+	if resource.CustomizeDiff == nil {
+		t.Fatalf("expected a CustomizeDiff to be configured for a Resource defining `tags`")
+	}
+}
+
+func TestEnableTagsAllSkipsResourcesWithoutTags(t *testing.T) {
+	testData := []struct {
+		Name   string
+		Schema map[string]*pluginsdk.Schema
+	}{
+		{
+			Name:   "No Tags",
+			Schema: map[string]*pluginsdk.Schema{},
+		},
+		{
+			Name: "Computed Tags",
+			Schema: map[string]*pluginsdk.Schema{
+				"tags": tags.SchemaDataSource(),
+			},
+		},
+		{
+			Name: "Tags As A List",
+			Schema: map[string]*pluginsdk.Schema{
+				"tags": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+				},
+			},
+		},
+		{
+			Name: "Configurable Tags All",
+			Schema: map[string]*pluginsdk.Schema{
+				"tags":     tags.Schema(),
+				"tags_all": tags.Schema(),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q", v.Name)
+
+		apiTags := map[string]interface{}{}
+		resource := testTagsAllResource(&apiTags)
+		resource.Schema = v.Schema
+
+		EnableTagsAll(resource)
+
+		if resource.CustomizeDiff != nil {
+			t.Fatalf("expected no CustomizeDiff to be configured")
+		}
+		if _, ok := resource.Schema["tags_all"]; ok && v.Name != "Configurable Tags All" {
+			t.Fatalf("expected `tags_all` not to be added")
+		}
+	}
+}
+
+func TestEnableTagsAllCreate(t *testing.T) {
+	apiTags := map[string]interface{}{}
+	resource := testTagsAllResource(&apiTags)
+	EnableTagsAll(resource)
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"name": "example",
+		"tags": map[string]interface{}{
+			"hello":       "world",
+			"environment": "staging",
+		},
+	})
+	if diags := resource.CreateContext(context.TODO(), d, testTagsAllMeta(t)); diags.HasError() {
+		t.Fatalf("creating: %+v", diags)
+	}
+
+	expectedAll := map[string]interface{}{
+		"environment": "staging",
+		"hello":       "world",
+		"team":        "platform",
+	}
+	if !reflect.DeepEqual(apiTags, expectedAll) {
+		t.Fatalf("expected the tags sent to the API to be %+v but got %+v", expectedAll, apiTags)
+	}
+	if actual := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(actual, expectedAll) {
+		t.Fatalf("expected `tags_all` to be %+v but got %+v", expectedAll, actual)
+	}
+
+	expected := map[string]interface{}{
+		"environment": "staging",
+		"hello":       "world",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected `tags` to be %+v but got %+v", expected, actual)
+	}
+}
+
+func TestEnableTagsAllRead(t *testing.T) {
+	testData := []struct {
+		Name         string
+		Configured   map[string]interface{}
+		APITags      map[string]interface{}
+		ExpectedTags map[string]interface{}
+		ExpectedAll  map[string]interface{}
+	}{
+		{
+			Name:       "Defaults and Ignored Tags",
+			Configured: map[string]interface{}{"hello": "world"},
+			APITags: map[string]interface{}{
+				"CreatedBy":    "someone",
+				"environment":  "production",
+				"hello":        "world",
+				"policy-owner": "security",
+				"team":         "platform",
+			},
+			ExpectedTags: map[string]interface{}{
+				"hello": "world",
+			},
+			ExpectedAll: map[string]interface{}{
+				"environment": "production",
+				"hello":       "world",
+				"team":        "platform",
+			},
+		},
+		{
+			Name:       "Configured Tag matching a Default",
+			Configured: map[string]interface{}{"environment": "production"},
+			APITags: map[string]interface{}{
+				"environment": "production",
+				"team":        "platform",
+			},
+			ExpectedTags: map[string]interface{}{
+				"environment": "production",
+			},
+			ExpectedAll: map[string]interface{}{
+				"environment": "production",
+				"team":        "platform",
+			},
+		},
+		{
+			Name:       "Import",
+			Configured: map[string]interface{}{},
+			APITags: map[string]interface{}{
+				"environment": "production",
+				"hello":       "world",
+				"team":        "developers",
+			},
+			ExpectedTags: map[string]interface{}{
+				"hello": "world",
+				"team":  "developers",
+			},
+			ExpectedAll: map[string]interface{}{
+				"environment": "production",
+				"hello":       "world",
+				"team":        "developers",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q", v.Name)
+
+		apiTags := v.APITags
+		resource := testTagsAllResource(&apiTags)
+		EnableTagsAll(resource)
+
+		d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+			"name": "example",
+			"tags": v.Configured,
+		})
+		d.SetId("example")
+
+		if diags := resource.ReadContext(context.TODO(), d, testTagsAllMeta(t)); diags.HasError() {
+			t.Fatalf("reading: %+v", diags)
+		}
+
+		if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, v.ExpectedTags) {
+			t.Fatalf("expected `tags` to be %+v but got %+v", v.ExpectedTags, actual)
+		}
+		if actual := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(actual, v.ExpectedAll) {
+			t.Fatalf("expected `tags_all` to be %+v but got %+v", v.ExpectedAll, actual)
+		}
+	}
+}
+
+func TestEnableTagsAllUpdateOnlyDefaultTagsChanged(t *testing.T) {
+	apiTags := map[string]interface{}{
+		"environment": "production",
+		"hello":       "world",
+		"team":        "platform",
+	}
+	resource := testTagsAllResource(&apiTags)
+	EnableTagsAll(resource)
+
+	state := &terraform.InstanceState{
+		ID: "example",
+		Attributes: map[string]string{
+			"id":                   "example",
+			"name":                 "example",
+			"tags.%":               "1",
+			"tags.hello":           "world",
+			"tags_all.%":           "3",
+			"tags_all.environment": "production",
+			"tags_all.hello":       "world",
+			"tags_all.team":        "platform",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "example",
+		"tags": map[string]interface{}{
+			"hello": "world",
+		},
+	})
+
+	meta := testTagsAllMeta(t)
+	meta.TagDefaults.DefaultTags = map[string]string{
+		"team": "developers",
+	}
+	configureTestTagDefaults(t, meta)
+
+	diff, err := resource.Diff(context.TODO(), state, config, meta)
+	if err != nil {
+		t.Fatalf("diffing: %+v", err)
+	}
+	d, err := schema.InternalMap(resource.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatalf("building the ResourceData: %+v", err)
+	}
+
+	if diags := resource.UpdateContext(context.TODO(), d, meta); diags.HasError() {
+		t.Fatalf("updating: %+v", diags)
+	}
+
+	expectedAll := map[string]interface{}{
+		"hello": "world",
+		"team":  "developers",
+	}
+	if !reflect.DeepEqual(apiTags, expectedAll) {
+		t.Fatalf("expected the tags sent to the API to be %+v but got %+v", expectedAll, apiTags)
+	}
+}
+
+func TestEnableTagsAllDiff(t *testing.T) {
+	apiTags := map[string]interface{}{}
+	resource := testTagsAllResource(&apiTags)
+	EnableTagsAll(resource)
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "example",
+		"tags": map[string]interface{}{
+			"hello": "world",
+			"team":  "developers",
+		},
+	})
+	diff, err := resource.Diff(context.TODO(), nil, config, testTagsAllMeta(t))
+	if err != nil {
+		t.Fatalf("diffing: %+v", err)
+	}
+
+	expected := map[string]string{
+		"tags_all.%":           "3",
+		"tags_all.environment": "production",
+		"tags_all.hello":       "world",
+		"tags_all.team":        "developers",
+	}
+	for k, v := range expected {
+		attr, ok := diff.Attributes[k]
+		if !ok {
+			t.Fatalf("expected a diff for %q but didn't get one", k)
+		}
+		if attr.New != v {
+			t.Fatalf("expected %q to be %q but got %q", k, v, attr.New)
+		}
+	}
+}
+
+func TestEnableTagsAllDiffValidatesMergedTags(t *testing.T) {
+	apiTags := map[string]interface{}{}
+	resource := testTagsAllResource(&apiTags)
+	EnableTagsAll(resource)
+
+	meta := testTagsAllMeta(t)
+	for i := 0; i < 50; i++ {
+		meta.TagDefaults.DefaultTags[fmt.Sprintf("default%d", i)] = "value"
+	}
+
+	configured := map[string]interface{}{}
+	for i := 0; i < 10; i++ {
+		configured[fmt.Sprintf("tag%d", i)] = "value"
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "example",
+		"tags": configured,
+	})
+	if _, err := resource.Diff(context.TODO(), nil, config, meta); err == nil {
+		t.Fatalf("expected an error when the combined tags exceed the maximum number of tags but didn't get one")
+	}
+}
+
+func TestEnableTagsAllUpdateRetainsIgnoredTags(t *testing.T) {
+	apiTags := map[string]interface{}{
+		"CreatedBy":    "someone",
+		"environment":  "production",
+		"hello":        "world",
+		"policy-owner": "security",
+		"team":         "platform",
+	}
+	resource := testTagsAllResource(&apiTags)
+	EnableTagsAll(resource)
+
+	retrieveRemoteTagsBefore := retrieveRemoteTags
+	retrieveRemoteTags = func(_ context.Context, _ interface{}, id string) (map[string]string, error) {
+		if id != "example" {
+			return nil, fmt.Errorf("unexpected ID %q", id)
+		}
+		output := make(map[string]string)
+		for k, v := range apiTags {
+			output[k] = v.(string)
+		}
+		return output, nil
+	}
+	t.Cleanup(func() {
+		retrieveRemoteTags = retrieveRemoteTagsBefore
+	})
+
+	state := &terraform.InstanceState{
+		ID: "example",
+		Attributes: map[string]string{
+			"id":                   "example",
+			"name":                 "example",
+			"tags.%":               "1",
+			"tags.hello":           "world",
+			"tags_all.%":           "3",
+			"tags_all.environment": "production",
+			"tags_all.hello":       "world",
+			"tags_all.team":        "platform",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "example",
+		"tags": map[string]interface{}{
+			"hello": "there",
+		},
+	})
+
+	meta := testTagsAllMeta(t)
+	diff, err := resource.Diff(context.TODO(), state, config, meta)
+	if err != nil {
+		t.Fatalf("diffing: %+v", err)
+	}
+	d, err := schema.InternalMap(resource.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatalf("building the ResourceData: %+v", err)
+	}
+
+	if diags := resource.UpdateContext(context.TODO(), d, meta); diags.HasError() {
+		t.Fatalf("updating: %+v", diags)
+	}
+
+	expectedAPI := map[string]interface{}{
+		"CreatedBy":    "someone",
+		"environment":  "production",
+		"hello":        "there",
+		"policy-owner": "security",
+		"team":         "platform",
+	}
+	if !reflect.DeepEqual(apiTags, expectedAPI) {
+		t.Fatalf("expected the tags sent to the API to be %+v but got %+v", expectedAPI, apiTags)
+	}
+
+	expected := map[string]interface{}{
+		"hello": "there",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected `tags` to be %+v but got %+v", expected, actual)
+	}
+	expectedAll := map[string]interface{}{
+		"environment": "production",
+		"hello":       "there",
+		"team":        "platform",
+	}
+	if actual := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(actual, expectedAll) {
+		t.Fatalf("expected `tags_all` to be %+v but got %+v", expectedAll, actual)
+	}
+}
+
+func TestEnableTagsAllRestoresTagsOnError(t *testing.T) {
+	apiTags := map[string]interface{}{}
+	resource := testTagsAllResource(&apiTags)
+
+	// emulate a Resource which is partially created, having read the tags (including the defaults) back from the API
+	read := resource.ReadContext
+	resource.CreateContext = func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+		apiTags = tags.FlattenWithoutIgnoring(tags.Expand(d.Get("tags").(map[string]interface{})))
+		d.SetId("example")
+		if diags := read(ctx, d, meta); diags.HasError() {
+			return diags
+		}
+		return diag.Errorf("waiting for the creation of the example")
+	}
+	EnableTagsAll(resource)
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"name": "example",
+		"tags": map[string]interface{}{
+			"hello": "world",
+		},
+	})
+	if diags := resource.CreateContext(context.TODO(), d, testTagsAllMeta(t)); !diags.HasError() {
+		t.Fatalf("expected an error when creating but didn't get one")
+	}
+
+	expected := map[string]interface{}{
+		"hello": "world",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected `tags` to be %+v but got %+v", expected, actual)
+	}
+}
+
+func TestEnableTagsAllTypedModel(t *testing.T) {
+	type model struct {
+		Name string            `tfschema:"name"`
+		Tags map[string]string `tfschema:"tags"`
+	}
+
+	resourceSchema := testTagsAllResource(&map[string]interface{}{}).Schema
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"name": "example",
+		"tags": map[string]interface{}{
+			"environment": "staging",
+			"hello":       "world",
+		},
+	})
+	metadata := ResourceMetaData{
+		Client:                   testTagsAllMeta(t),
+		ResourceData:             d,
+		serializationDebugLogger: NullLogger{},
+		tagsAll:                  true,
+	}
+
+	var config model
+	if err := metadata.Decode(&config); err != nil {
+		t.Fatalf("decoding: %+v", err)
+	}
+	expected := map[string]string{
+		"environment": "staging",
+		"hello":       "world",
+		"team":        "platform",
+	}
+	if !reflect.DeepEqual(config.Tags, expected) {
+		t.Fatalf("expected the decoded tags to be %+v but got %+v", expected, config.Tags)
+	}
+
+	state := model{
+		Name: "example",
+		Tags: map[string]string{
+			"CreatedBy":    "someone",
+			"hello":        "world",
+			"policy-owner": "security",
+		},
+	}
+	if err := metadata.Encode(&state); err != nil {
+		t.Fatalf("encoding: %+v", err)
+	}
+	expectedState := map[string]interface{}{
+		"hello": "world",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expectedState) {
+		t.Fatalf("expected `tags` to be %+v but got %+v", expectedState, actual)
+	}
+
+	// the `default_tags` only apply to Resources supporting `tags_all`
+	metadata.tagsAll = false
+	var untagged model
+	if err := metadata.Decode(&untagged); err != nil {
+		t.Fatalf("decoding: %+v", err)
+	}
+	if _, ok := untagged.Tags["team"]; ok {
+		t.Fatalf("expected the `default_tags` not to be merged but got %+v", untagged.Tags)
+	}
+}
@@ -0,0 +1,372 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// TypedSchemaType is the type of value exposed by a TypedSchemaAttribute
+type TypedSchemaType string
+
+const (
+	TypedSchemaTypeBool   TypedSchemaType = "Bool"
+	TypedSchemaTypeFloat  TypedSchemaType = "Float"
+	TypedSchemaTypeInt    TypedSchemaType = "Int"
+	TypedSchemaTypeList   TypedSchemaType = "List"
+	TypedSchemaTypeMap    TypedSchemaType = "Map"
+	TypedSchemaTypeSet    TypedSchemaType = "Set"
+	TypedSchemaTypeString TypedSchemaType = "String"
+)
+
+func (t TypedSchemaType) isPrimitive() bool {
+	switch t {
+	case TypedSchemaTypeBool, TypedSchemaTypeFloat, TypedSchemaTypeInt, TypedSchemaTypeString:
+		return true
+	}
+	return false
+}
+
+// TypedSchemaNestingMode defines how the items within a TypedSchemaBlock are exposed
+type TypedSchemaNestingMode string
+
+const (
+	TypedSchemaNestingModeList TypedSchemaNestingMode = "List"
+	TypedSchemaNestingModeSet  TypedSchemaNestingMode = "Set"
+)
+
+// TypedSchema is a provider-owned description of the Schema for a Resource or Data Source, which can be
+// compiled down to both the Plugin SDK (for use with the Resource/DataSource wrappers) and the Plugin
+// Framework (for use with the FrameworkResource/FrameworkDataSource wrappers) - allowing a Resource to be
+// moved between the two without redefining its Schema.
+//
+// Models for either output can continue to use `tfschema` struct tags, see FrameworkResourceMetaData.Decode.
+type TypedSchema struct {
+	// Attributes is a map of the field name to the attribute definition
+	Attributes map[string]TypedSchemaAttribute
+
+	// Blocks is a map of the field name to the nested block definition
+	Blocks map[string]TypedSchemaBlock
+}
+
+// TypedSchemaAttribute defines a single field within a TypedSchema
+type TypedSchemaAttribute struct {
+	// Type is the type of this field
+	Type TypedSchemaType
+
+	// ElementType is the type of the items within this field, which must be set when Type is a List, Map or Set
+	ElementType TypedSchemaType
+
+	// Description is a description of this field used in the Framework schema
+	Description string
+
+	Required bool
+	Optional bool
+	Computed bool
+
+	// ForceNew specifies that changing this field requires that the resource is replaced
+	ForceNew bool
+
+	// Sensitive specifies that the value of this field shouldn't be output
+	Sensitive bool
+
+	// Default is the default value used when this field isn't specified, which must be of the Go type
+	// matching the Type (e.g. `string` for a TypedSchemaTypeString) and can only be set for Optional fields
+	Default interface{}
+
+	// ValidateFunc validates the value of this field - for a List or Set this is called for each item, for
+	// a Map this is called for the whole map, matching the behaviour of the Plugin SDK
+	ValidateFunc pluginsdk.SchemaValidateFunc
+}
+
+// TypedSchemaBlock defines a nested block within a TypedSchema
+type TypedSchemaBlock struct {
+	// NestingMode defines whether this block is exposed as a List or a Set
+	NestingMode TypedSchemaNestingMode
+
+	// Description is a description of this block used in the Framework schema
+	Description string
+
+	Required bool
+	Optional bool
+	Computed bool
+
+	// ForceNew specifies that changing this block requires that the resource is replaced
+	ForceNew bool
+
+	MinItems int
+	MaxItems int
+
+	// Attributes is a map of the field name to the attribute definition for fields within this block
+	Attributes map[string]TypedSchemaAttribute
+
+	// Blocks is a map of the field name to the nested block definition for blocks within this block
+	Blocks map[string]TypedSchemaBlock
+}
+
+func (b TypedSchemaBlock) schema() TypedSchema {
+	return TypedSchema{
+		Attributes: b.Attributes,
+		Blocks:     b.Blocks,
+	}
+}
+
+// Validate confirms that the TypedSchema is valid, such that it can be compiled to both the Plugin SDK
+// and the Plugin Framework
+func (s TypedSchema) Validate() error {
+	return s.validate("", false)
+}
+
+func (s TypedSchema) validate(prefix string, withinComputedBlock bool) error {
+	for _, k := range sortedKeys(s.Attributes) {
+		v := s.Attributes[k]
+		if _, ok := s.Blocks[k]; ok {
+			return fmt.Errorf("%q is defined as both an Attribute and a Block", prefix+k)
+		}
+		if err := v.validate(withinComputedBlock); err != nil {
+			return fmt.Errorf("validating the Attribute %q: %+v", prefix+k, err)
+		}
+	}
+
+	for _, k := range sortedKeys(s.Blocks) {
+		v := s.Blocks[k]
+		if err := v.validate(withinComputedBlock); err != nil {
+			return fmt.Errorf("validating the Block %q: %+v", prefix+k, err)
+		}
+		if err := v.schema().validate(prefix+k+".", withinComputedBlock || v.isComputedOnly()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a TypedSchemaAttribute) isComputedOnly() bool {
+	return a.Computed && !a.Optional && !a.Required
+}
+
+func (a TypedSchemaAttribute) validate(withinComputedBlock bool) error {
+	if err := validateTypedSchemaBehaviour(a.Required, a.Optional, a.Computed, a.ForceNew, withinComputedBlock); err != nil {
+		return err
+	}
+
+	switch a.Type {
+	case TypedSchemaTypeBool, TypedSchemaTypeFloat, TypedSchemaTypeInt, TypedSchemaTypeString:
+		if a.ElementType != "" {
+			return fmt.Errorf("`ElementType` can only be specified for a List, Map or Set")
+		}
+
+	case TypedSchemaTypeList, TypedSchemaTypeMap, TypedSchemaTypeSet:
+		if !a.ElementType.isPrimitive() {
+			return fmt.Errorf("`ElementType` must be a Bool, Float, Int or String but got %q", a.ElementType)
+		}
+
+	default:
+		return fmt.Errorf("unsupported `Type` %q", a.Type)
+	}
+
+	if a.Default != nil {
+		if !a.Optional || a.Computed {
+			return fmt.Errorf("`Default` can only be specified for an Optional, non-Computed field")
+		}
+
+		valid := false
+		switch a.Type {
+		case TypedSchemaTypeBool:
+			_, valid = a.Default.(bool)
+		case TypedSchemaTypeFloat:
+			_, valid = a.Default.(float64)
+		case TypedSchemaTypeInt:
+			_, valid = a.Default.(int)
+		case TypedSchemaTypeString:
+			_, valid = a.Default.(string)
+		}
+		if !valid {
+			return fmt.Errorf("`Default` of type %T is not supported for a field of type %q", a.Default, a.Type)
+		}
+	}
+
+	return nil
+}
+
+func (b TypedSchemaBlock) isComputedOnly() bool {
+	return b.Computed && !b.Optional && !b.Required
+}
+
+func (b TypedSchemaBlock) validate(withinComputedBlock bool) error {
+	if err := validateTypedSchemaBehaviour(b.Required, b.Optional, b.Computed, b.ForceNew, withinComputedBlock); err != nil {
+		return err
+	}
+
+	if b.NestingMode != TypedSchemaNestingModeList && b.NestingMode != TypedSchemaNestingModeSet {
+		return fmt.Errorf("unsupported `NestingMode` %q", b.NestingMode)
+	}
+
+	if b.Computed && !b.isComputedOnly() {
+		return fmt.Errorf("a Block must be either Computed-only or user-specifyable, but not both")
+	}
+
+	if b.MinItems < 0 || b.MaxItems < 0 {
+		return fmt.Errorf("`MinItems` and `MaxItems` must not be negative")
+	}
+	if b.MaxItems > 0 && b.MinItems > b.MaxItems {
+		return fmt.Errorf("`MinItems` (%d) must not be greater than `MaxItems` (%d)", b.MinItems, b.MaxItems)
+	}
+
+	return nil
+}
+
+func validateTypedSchemaBehaviour(required, optional, computed, forceNew, withinComputedBlock bool) error {
+	if withinComputedBlock {
+		if required || optional || !computed {
+			return fmt.Errorf("fields within a Computed-only Block must also be Computed-only")
+		}
+		return nil
+	}
+
+	if !required && !optional && !computed {
+		return fmt.Errorf("one of `Required`, `Optional` or `Computed` must be specified")
+	}
+	if required && (optional || computed) {
+		return fmt.Errorf("`Required` cannot be combined with `Optional` or `Computed`")
+	}
+	if forceNew && computed && !optional {
+		return fmt.Errorf("`ForceNew` cannot be specified for a Computed-only field")
+	}
+
+	return nil
+}
+
+// PluginSdkSchema compiles the TypedSchema into the Plugin SDK Schema
+func (s TypedSchema) PluginSdkSchema() map[string]*pluginsdk.Schema {
+	out := make(map[string]*pluginsdk.Schema, len(s.Attributes)+len(s.Blocks))
+	for k, v := range s.Attributes {
+		out[k] = v.pluginSdkSchema()
+	}
+	for k, v := range s.Blocks {
+		out[k] = v.pluginSdkSchema()
+	}
+	return out
+}
+
+// PluginSdkArguments compiles the user-specifyable fields within the TypedSchema into the Plugin SDK Schema,
+// for use as the Arguments of a Resource or Data Source
+func (s TypedSchema) PluginSdkArguments() map[string]*pluginsdk.Schema {
+	out := make(map[string]*pluginsdk.Schema)
+	for k, v := range s.Attributes {
+		if !v.isComputedOnly() {
+			out[k] = v.pluginSdkSchema()
+		}
+	}
+	for k, v := range s.Blocks {
+		if !v.isComputedOnly() {
+			out[k] = v.pluginSdkSchema()
+		}
+	}
+	return out
+}
+
+// PluginSdkAttributes compiles the Computed-only fields within the TypedSchema into the Plugin SDK Schema,
+// for use as the Attributes of a Resource or Data Source
+func (s TypedSchema) PluginSdkAttributes() map[string]*pluginsdk.Schema {
+	out := make(map[string]*pluginsdk.Schema)
+	for k, v := range s.Attributes {
+		if v.isComputedOnly() {
+			out[k] = v.pluginSdkSchema()
+		}
+	}
+	for k, v := range s.Blocks {
+		if v.isComputedOnly() {
+			out[k] = v.pluginSdkSchema()
+		}
+	}
+	return out
+}
+
+func (a TypedSchemaAttribute) pluginSdkSchema() *pluginsdk.Schema {
+	out := &pluginsdk.Schema{
+		Type:        a.Type.pluginSdkValueType(),
+		Required:    a.Required,
+		Optional:    a.Optional,
+		Computed:    a.Computed,
+		ForceNew:    a.ForceNew,
+		Sensitive:   a.Sensitive,
+		Description: a.Description,
+		Default:     a.Default,
+	}
+
+	switch a.Type {
+	case TypedSchemaTypeList, TypedSchemaTypeSet:
+		// the Plugin SDK validates each item in a List/Set using the ValidateFunc on the Elem
+		out.Elem = &pluginsdk.Schema{
+			Type:         a.ElementType.pluginSdkValueType(),
+			ValidateFunc: a.ValidateFunc,
+		}
+
+	case TypedSchemaTypeMap:
+		out.Elem = &pluginsdk.Schema{
+			Type: a.ElementType.pluginSdkValueType(),
+		}
+		out.ValidateFunc = a.ValidateFunc
+
+	default:
+		out.ValidateFunc = a.ValidateFunc
+	}
+
+	return out
+}
+
+func (b TypedSchemaBlock) pluginSdkSchema() *pluginsdk.Schema {
+	valueType := pluginsdk.TypeList
+	if b.NestingMode == TypedSchemaNestingModeSet {
+		valueType = pluginsdk.TypeSet
+	}
+
+	return &pluginsdk.Schema{
+		Type:        valueType,
+		Required:    b.Required,
+		Optional:    b.Optional,
+		Computed:    b.Computed,
+		ForceNew:    b.ForceNew,
+		Description: b.Description,
+		MinItems:    b.MinItems,
+		MaxItems:    b.MaxItems,
+		Elem: &pluginsdk.Resource{
+			Schema: b.schema().PluginSdkSchema(),
+		},
+	}
+}
+
+func (t TypedSchemaType) pluginSdkValueType() pluginsdk.ValueType {
+	switch t {
+	case TypedSchemaTypeBool:
+		return pluginsdk.TypeBool
+	case TypedSchemaTypeFloat:
+		return pluginsdk.TypeFloat
+	case TypedSchemaTypeInt:
+		return pluginsdk.TypeInt
+	case TypedSchemaTypeList:
+		return pluginsdk.TypeList
+	case TypedSchemaTypeMap:
+		return pluginsdk.TypeMap
+	case TypedSchemaTypeSet:
+		return pluginsdk.TypeSet
+	case TypedSchemaTypeString:
+		return pluginsdk.TypeString
+	}
+
+	return pluginsdk.TypeInvalid
+}
+
+func sortedKeys[T any](input map[string]T) []string {
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,454 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+)
+
+// FrameworkResourceSchema compiles the TypedSchema into the Plugin Framework Schema for a FrameworkResource
+//
+// Since the Plugin Framework requires that fields with a Default are Computed, these are marked as
+// Optional and Computed - and ForceNew is implemented using a Plan Modifier.
+func (s TypedSchema) FrameworkResourceSchema() resourceschema.Schema {
+	attributes, blocks := s.frameworkResourceFields()
+	return resourceschema.Schema{
+		Attributes: attributes,
+		Blocks:     blocks,
+	}
+}
+
+// FrameworkDataSourceSchema compiles the TypedSchema into the Plugin Framework Schema for a FrameworkDataSource
+func (s TypedSchema) FrameworkDataSourceSchema() datasourceschema.Schema {
+	attributes, blocks := s.frameworkDataSourceFields()
+	return datasourceschema.Schema{
+		Attributes: attributes,
+		Blocks:     blocks,
+	}
+}
+
+func (s TypedSchema) frameworkResourceFields() (map[string]resourceschema.Attribute, map[string]resourceschema.Block) {
+	attributes := make(map[string]resourceschema.Attribute)
+	blocks := make(map[string]resourceschema.Block)
+
+	for k, v := range s.Attributes {
+		attributes[k] = v.frameworkResourceAttribute()
+	}
+	for k, v := range s.Blocks {
+		// the Plugin Framework doesn't support Computed blocks, so these are exposed as Nested Attributes
+		// which are represented identically in the State
+		if v.isComputedOnly() {
+			attributes[k] = v.frameworkResourceNestedAttribute()
+			continue
+		}
+		blocks[k] = v.frameworkResourceBlock()
+	}
+
+	return attributes, blocks
+}
+
+func (a TypedSchemaAttribute) frameworkResourceAttribute() resourceschema.Attribute {
+	// the Plugin Framework requires that any field with a Default is Computed
+	computed := a.Computed || a.Default != nil
+
+	switch a.Type {
+	case TypedSchemaTypeBool:
+		out := resourceschema.BoolAttribute{
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    computed,
+			Sensitive:   a.Sensitive,
+			Description: a.Description,
+		}
+		if v, ok := a.Default.(bool); ok {
+			out.Default = frameworkhelpers.NewWrappedBoolDefault(v)
+		}
+		if a.ForceNew {
+			out.PlanModifiers = []planmodifier.Bool{frameworkhelpers.RequiresReplace{}}
+		}
+		return out
+
+	case TypedSchemaTypeFloat:
+		out := resourceschema.Float64Attribute{
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    computed,
+			Sensitive:   a.Sensitive,
+			Description: a.Description,
+		}
+		if v, ok := a.Default.(float64); ok {
+			out.Default = frameworkhelpers.NewWrappedFloat64Default(v)
+		}
+		if a.ValidateFunc != nil {
+			out.Validators = []validator.Float64{frameworkhelpers.WrappedFloat64Validator{Func: a.ValidateFunc}}
+		}
+		if a.ForceNew {
+			out.PlanModifiers = []planmodifier.Float64{frameworkhelpers.RequiresReplace{}}
+		}
+		return out
+
+	case TypedSchemaTypeInt:
+		out := resourceschema.Int64Attribute{
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    computed,
+			Sensitive:   a.Sensitive,
+			Description: a.Description,
+		}
+		if v, ok := a.Default.(int); ok {
+			out.Default = frameworkhelpers.NewWrappedInt64Default(v)
+		}
+		if a.ValidateFunc != nil {
+			out.Validators = []validator.Int64{frameworkhelpers.WrappedInt64Validator{Func: a.ValidateFunc}}
+		}
+		if a.ForceNew {
+			out.PlanModifiers = []planmodifier.Int64{frameworkhelpers.RequiresReplace{}}
+		}
+		return out
+
+	case TypedSchemaTypeList:
+		out := resourceschema.ListAttribute{
+			ElementType: a.ElementType.frameworkAttrType(),
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    computed,
+			Sensitive:   a.Sensitive,
+			Description: a.Description,
+		}
+		if a.ValidateFunc != nil {
+			out.Validators = []validator.List{frameworkhelpers.WrappedListValidator{Func: a.ValidateFunc}}
+		}
+		if a.ForceNew {
+			out.PlanModifiers = []planmodifier.List{frameworkhelpers.RequiresReplace{}}
+		}
+		return out
+
+	case TypedSchemaTypeMap:
+		out := resourceschema.MapAttribute{
+			ElementType: a.ElementType.frameworkAttrType(),
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    computed,
+			Sensitive:   a.Sensitive,
+			Description: a.Description,
+		}
+		if a.ValidateFunc != nil {
+			out.Validators = []validator.Map{frameworkhelpers.WrappedMapValidator{Func: a.ValidateFunc}}
+		}
+		if a.ForceNew {
+			out.PlanModifiers = []planmodifier.Map{frameworkhelpers.RequiresReplace{}}
+		}
+		return out
+
+	case TypedSchemaTypeSet:
+		out := resourceschema.SetAttribute{
+			ElementType: a.ElementType.frameworkAttrType(),
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    computed,
+			Sensitive:   a.Sensitive,
+			Description: a.Description,
+		}
+		if a.ValidateFunc != nil {
+			out.Validators = []validator.Set{frameworkhelpers.WrappedSetValidator{Func: a.ValidateFunc}}
+		}
+		if a.ForceNew {
+			out.PlanModifiers = []planmodifier.Set{frameworkhelpers.RequiresReplace{}}
+		}
+		return out
+	}
+
+	out := resourceschema.StringAttribute{
+		Required:    a.Required,
+		Optional:    a.Optional,
+		Computed:    computed,
+		Sensitive:   a.Sensitive,
+		Description: a.Description,
+	}
+	if v, ok := a.Default.(string); ok {
+		out.Default = frameworkhelpers.NewWrappedStringDefault(v)
+	}
+	if a.ValidateFunc != nil {
+		out.Validators = []validator.String{frameworkhelpers.WrappedStringValidator{Func: a.ValidateFunc}}
+	}
+	if a.ForceNew {
+		out.PlanModifiers = []planmodifier.String{frameworkhelpers.RequiresReplace{}}
+	}
+	return out
+}
+
+func (b TypedSchemaBlock) frameworkResourceBlock() resourceschema.Block {
+	attributes, blocks := b.schema().frameworkResourceFields()
+	nestedObject := resourceschema.NestedBlockObject{
+		Attributes: attributes,
+		Blocks:     blocks,
+	}
+
+	if b.NestingMode == TypedSchemaNestingModeSet {
+		out := resourceschema.SetNestedBlock{
+			NestedObject: nestedObject,
+			Description:  b.Description,
+		}
+		out.Validators = b.frameworkSetValidators()
+		if b.ForceNew {
+			out.PlanModifiers = []planmodifier.Set{frameworkhelpers.RequiresReplace{}}
+		}
+		return out
+	}
+
+	out := resourceschema.ListNestedBlock{
+		NestedObject: nestedObject,
+		Description:  b.Description,
+	}
+	out.Validators = b.frameworkListValidators()
+	if b.ForceNew {
+		out.PlanModifiers = []planmodifier.List{frameworkhelpers.RequiresReplace{}}
+	}
+	return out
+}
+
+func (b TypedSchemaBlock) frameworkResourceNestedAttribute() resourceschema.Attribute {
+	attributes := make(map[string]resourceschema.Attribute)
+	for k, v := range b.Attributes {
+		attributes[k] = v.frameworkResourceAttribute()
+	}
+	for k, v := range b.Blocks {
+		attributes[k] = v.frameworkResourceNestedAttribute()
+	}
+
+	nestedObject := resourceschema.NestedAttributeObject{
+		Attributes: attributes,
+	}
+
+	if b.NestingMode == TypedSchemaNestingModeSet {
+		return resourceschema.SetNestedAttribute{
+			NestedObject: nestedObject,
+			Computed:     true,
+			Description:  b.Description,
+		}
+	}
+
+	return resourceschema.ListNestedAttribute{
+		NestedObject: nestedObject,
+		Computed:     true,
+		Description:  b.Description,
+	}
+}
+
+func (s TypedSchema) frameworkDataSourceFields() (map[string]datasourceschema.Attribute, map[string]datasourceschema.Block) {
+	attributes := make(map[string]datasourceschema.Attribute)
+	blocks := make(map[string]datasourceschema.Block)
+
+	for k, v := range s.Attributes {
+		attributes[k] = v.frameworkDataSourceAttribute()
+	}
+	for k, v := range s.Blocks {
+		if v.isComputedOnly() {
+			attributes[k] = v.frameworkDataSourceNestedAttribute()
+			continue
+		}
+		blocks[k] = v.frameworkDataSourceBlock()
+	}
+
+	return attributes, blocks
+}
+
+func (a TypedSchemaAttribute) frameworkDataSourceAttribute() datasourceschema.Attribute {
+	// Data Sources don't support Defaults in the Plugin Framework, instead any Default is
+	// exposed as an Optional and Computed field which should be set during the Read
+	computed := a.Computed || a.Default != nil
+
+	switch a.Type {
+	case TypedSchemaTypeBool:
+		return datasourceschema.BoolAttribute{
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    computed,
+			Sensitive:   a.Sensitive,
+			Description: a.Description,
+		}
+
+	case TypedSchemaTypeFloat:
+		out := datasourceschema.Float64Attribute{
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    computed,
+			Sensitive:   a.Sensitive,
+			Description: a.Description,
+		}
+		if a.ValidateFunc != nil {
+			out.Validators = []validator.Float64{frameworkhelpers.WrappedFloat64Validator{Func: a.ValidateFunc}}
+		}
+		return out
+
+	case TypedSchemaTypeInt:
+		out := datasourceschema.Int64Attribute{
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    computed,
+			Sensitive:   a.Sensitive,
+			Description: a.Description,
+		}
+		if a.ValidateFunc != nil {
+			out.Validators = []validator.Int64{frameworkhelpers.WrappedInt64Validator{Func: a.ValidateFunc}}
+		}
+		return out
+
+	case TypedSchemaTypeList:
+		out := datasourceschema.ListAttribute{
+			ElementType: a.ElementType.frameworkAttrType(),
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    computed,
+			Sensitive:   a.Sensitive,
+			Description: a.Description,
+		}
+		if a.ValidateFunc != nil {
+			out.Validators = []validator.List{frameworkhelpers.WrappedListValidator{Func: a.ValidateFunc}}
+		}
+		return out
+
+	case TypedSchemaTypeMap:
+		out := datasourceschema.MapAttribute{
+			ElementType: a.ElementType.frameworkAttrType(),
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    computed,
+			Sensitive:   a.Sensitive,
+			Description: a.Description,
+		}
+		if a.ValidateFunc != nil {
+			out.Validators = []validator.Map{frameworkhelpers.WrappedMapValidator{Func: a.ValidateFunc}}
+		}
+		return out
+
+	case TypedSchemaTypeSet:
+		out := datasourceschema.SetAttribute{
+			ElementType: a.ElementType.frameworkAttrType(),
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    computed,
+			Sensitive:   a.Sensitive,
+			Description: a.Description,
+		}
+		if a.ValidateFunc != nil {
+			out.Validators = []validator.Set{frameworkhelpers.WrappedSetValidator{Func: a.ValidateFunc}}
+		}
+		return out
+	}
+
+	out := datasourceschema.StringAttribute{
+		Required:    a.Required,
+		Optional:    a.Optional,
+		Computed:    computed,
+		Sensitive:   a.Sensitive,
+		Description: a.Description,
+	}
+	if a.ValidateFunc != nil {
+		out.Validators = []validator.String{frameworkhelpers.WrappedStringValidator{Func: a.ValidateFunc}}
+	}
+	return out
+}
+
+func (b TypedSchemaBlock) frameworkDataSourceBlock() datasourceschema.Block {
+	attributes, blocks := b.schema().frameworkDataSourceFields()
+	nestedObject := datasourceschema.NestedBlockObject{
+		Attributes: attributes,
+		Blocks:     blocks,
+	}
+
+	if b.NestingMode == TypedSchemaNestingModeSet {
+		return datasourceschema.SetNestedBlock{
+			NestedObject: nestedObject,
+			Description:  b.Description,
+			Validators:   b.frameworkSetValidators(),
+		}
+	}
+
+	return datasourceschema.ListNestedBlock{
+		NestedObject: nestedObject,
+		Description:  b.Description,
+		Validators:   b.frameworkListValidators(),
+	}
+}
+
+func (b TypedSchemaBlock) frameworkDataSourceNestedAttribute() datasourceschema.Attribute {
+	attributes := make(map[string]datasourceschema.Attribute)
+	for k, v := range b.Attributes {
+		attributes[k] = v.frameworkDataSourceAttribute()
+	}
+	for k, v := range b.Blocks {
+		attributes[k] = v.frameworkDataSourceNestedAttribute()
+	}
+
+	nestedObject := datasourceschema.NestedAttributeObject{
+		Attributes: attributes,
+	}
+
+	if b.NestingMode == TypedSchemaNestingModeSet {
+		return datasourceschema.SetNestedAttribute{
+			NestedObject: nestedObject,
+			Computed:     true,
+			Description:  b.Description,
+		}
+	}
+
+	return datasourceschema.ListNestedAttribute{
+		NestedObject: nestedObject,
+		Computed:     true,
+		Description:  b.Description,
+	}
+}
+
+// minItems returns the minimum number of items for this block, since the Plugin Framework doesn't
+// support Required blocks these are instead validated as requiring at least one item
+func (b TypedSchemaBlock) minItems() int {
+	if b.Required && b.MinItems == 0 {
+		return 1
+	}
+	return b.MinItems
+}
+
+func (b TypedSchemaBlock) frameworkListValidators() []validator.List {
+	validators := make([]validator.List, 0)
+	if v := b.minItems(); v > 0 {
+		validators = append(validators, listvalidator.SizeAtLeast(v))
+	}
+	if b.MaxItems > 0 {
+		validators = append(validators, listvalidator.SizeAtMost(b.MaxItems))
+	}
+	return validators
+}
+
+func (b TypedSchemaBlock) frameworkSetValidators() []validator.Set {
+	validators := make([]validator.Set, 0)
+	if v := b.minItems(); v > 0 {
+		validators = append(validators, setvalidator.SizeAtLeast(v))
+	}
+	if b.MaxItems > 0 {
+		validators = append(validators, setvalidator.SizeAtMost(b.MaxItems))
+	}
+	return validators
+}
+
+func (t TypedSchemaType) frameworkAttrType() attr.Type {
+	switch t {
+	case TypedSchemaTypeBool:
+		return types.BoolType
+	case TypedSchemaTypeFloat:
+		return types.Float64Type
+	case TypedSchemaTypeInt:
+		return types.Int64Type
+	}
+
+	return types.StringType
+}
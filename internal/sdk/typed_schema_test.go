@@ -0,0 +1,509 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func testTypedSchema() TypedSchema {
+	return TypedSchema{
+		Attributes: map[string]TypedSchemaAttribute{
+			"id": {
+				Type:     TypedSchemaTypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         TypedSchemaTypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"enabled": {
+				Type:     TypedSchemaTypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"instance_count": {
+				Type:         TypedSchemaTypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+			"ratio": {
+				Type:     TypedSchemaTypeFloat,
+				Optional: true,
+			},
+			"secret": {
+				Type:      TypedSchemaTypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"zones": {
+				Type:         TypedSchemaTypeList,
+				ElementType:  TypedSchemaTypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"tags": {
+				Type:        TypedSchemaTypeMap,
+				ElementType: TypedSchemaTypeString,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]TypedSchemaBlock{
+			"rule": {
+				NestingMode: TypedSchemaNestingModeList,
+				Optional:    true,
+				MaxItems:    2,
+				Attributes: map[string]TypedSchemaAttribute{
+					"name": {
+						Type:     TypedSchemaTypeString,
+						Required: true,
+					},
+					"priority": {
+						Type:     TypedSchemaTypeInt,
+						Optional: true,
+					},
+				},
+			},
+			"endpoint": {
+				NestingMode: TypedSchemaNestingModeList,
+				Computed:    true,
+				Attributes: map[string]TypedSchemaAttribute{
+					"address": {
+						Type:     TypedSchemaTypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestTypedSchemaValidate(t *testing.T) {
+	testData := []struct {
+		name   string
+		input  TypedSchema
+		errors bool
+	}{
+		{
+			name:   "valid",
+			input:  testTypedSchema(),
+			errors: false,
+		},
+		{
+			name: "no behaviour",
+			input: TypedSchema{
+				Attributes: map[string]TypedSchemaAttribute{
+					"name": {
+						Type: TypedSchemaTypeString,
+					},
+				},
+			},
+			errors: true,
+		},
+		{
+			name: "required and computed",
+			input: TypedSchema{
+				Attributes: map[string]TypedSchemaAttribute{
+					"name": {
+						Type:     TypedSchemaTypeString,
+						Required: true,
+						Computed: true,
+					},
+				},
+			},
+			errors: true,
+		},
+		{
+			name: "default for a required field",
+			input: TypedSchema{
+				Attributes: map[string]TypedSchemaAttribute{
+					"name": {
+						Type:     TypedSchemaTypeString,
+						Required: true,
+						Default:  "example",
+					},
+				},
+			},
+			errors: true,
+		},
+		{
+			name: "default of the wrong type",
+			input: TypedSchema{
+				Attributes: map[string]TypedSchemaAttribute{
+					"instance_count": {
+						Type:     TypedSchemaTypeInt,
+						Optional: true,
+						Default:  "1",
+					},
+				},
+			},
+			errors: true,
+		},
+		{
+			name: "list without an element type",
+			input: TypedSchema{
+				Attributes: map[string]TypedSchemaAttribute{
+					"zones": {
+						Type:     TypedSchemaTypeList,
+						Optional: true,
+					},
+				},
+			},
+			errors: true,
+		},
+		{
+			name: "force new computed-only",
+			input: TypedSchema{
+				Attributes: map[string]TypedSchemaAttribute{
+					"name": {
+						Type:     TypedSchemaTypeString,
+						Computed: true,
+						ForceNew: true,
+					},
+				},
+			},
+			errors: true,
+		},
+		{
+			name: "attribute and block with the same name",
+			input: TypedSchema{
+				Attributes: map[string]TypedSchemaAttribute{
+					"rule": {
+						Type:     TypedSchemaTypeString,
+						Optional: true,
+					},
+				},
+				Blocks: map[string]TypedSchemaBlock{
+					"rule": {
+						NestingMode: TypedSchemaNestingModeList,
+						Optional:    true,
+					},
+				},
+			},
+			errors: true,
+		},
+		{
+			name: "user-specifyable field within a computed block",
+			input: TypedSchema{
+				Blocks: map[string]TypedSchemaBlock{
+					"endpoint": {
+						NestingMode: TypedSchemaNestingModeList,
+						Computed:    true,
+						Attributes: map[string]TypedSchemaAttribute{
+							"address": {
+								Type:     TypedSchemaTypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+			errors: true,
+		},
+		{
+			name: "block min items greater than max items",
+			input: TypedSchema{
+				Blocks: map[string]TypedSchemaBlock{
+					"rule": {
+						NestingMode: TypedSchemaNestingModeSet,
+						Optional:    true,
+						MinItems:    2,
+						MaxItems:    1,
+					},
+				},
+			},
+			errors: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		err := v.input.Validate()
+		if v.errors && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.errors && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+}
+
+func TestTypedSchemaPluginSdk(t *testing.T) {
+	input := testTypedSchema()
+
+	combined, err := combineSchema(input.PluginSdkArguments(), input.PluginSdkAttributes())
+	if err != nil {
+		t.Fatalf("combining the Arguments and Attributes: %+v", err)
+	}
+	if len(*combined) != len(input.PluginSdkSchema()) {
+		t.Fatalf("expected the Arguments and Attributes to contain every field")
+	}
+
+	r := &pluginsdk.Resource{
+		Schema: *combined,
+	}
+	if err := r.InternalValidate(nil, true); err != nil {
+		t.Fatalf("validating the Plugin SDK schema: %+v", err)
+	}
+
+	out := input.PluginSdkSchema()
+	if !out["name"].ForceNew || out["name"].ValidateFunc == nil {
+		t.Fatalf("expected `name` to be ForceNew with a ValidateFunc")
+	}
+	if out["enabled"].Default != true {
+		t.Fatalf("expected `enabled` to have a Default of `true` but got %+v", out["enabled"].Default)
+	}
+	if !out["secret"].Sensitive {
+		t.Fatalf("expected `secret` to be Sensitive")
+	}
+	if elem, ok := out["zones"].Elem.(*pluginsdk.Schema); !ok || elem.ValidateFunc == nil || out["zones"].ValidateFunc != nil {
+		t.Fatalf("expected `zones` to validate each item within the list")
+	}
+	if out["rule"].MaxItems != 2 {
+		t.Fatalf("expected `rule` to have MaxItems of 2 but got %d", out["rule"].MaxItems)
+	}
+	if _, ok := input.PluginSdkAttributes()["endpoint"]; !ok {
+		t.Fatalf("expected the Computed-only block `endpoint` to be an Attribute")
+	}
+}
+
+func TestTypedSchemaFrameworkResource(t *testing.T) {
+	ctx := context.TODO()
+	out := testTypedSchema().FrameworkResourceSchema()
+
+	if diags := out.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("validating the Framework schema: %+v", diags)
+	}
+
+	name, ok := out.Attributes["name"].(schema.StringAttribute)
+	if !ok {
+		t.Fatalf("expected `name` to be a String Attribute but got %T", out.Attributes["name"])
+	}
+	if !name.Required || len(name.Validators) != 1 || len(name.PlanModifiers) != 1 {
+		t.Fatalf("expected `name` to be Required with a Validator and a Plan Modifier")
+	}
+	if _, ok := name.PlanModifiers[0].(frameworkhelpers.RequiresReplace); !ok {
+		t.Fatalf("expected `name` to require replacement but got %T", name.PlanModifiers[0])
+	}
+
+	enabled, ok := out.Attributes["enabled"].(schema.BoolAttribute)
+	if !ok || !enabled.Optional || !enabled.Computed || enabled.Default == nil {
+		t.Fatalf("expected `enabled` to be an Optional and Computed Bool Attribute with a Default")
+	}
+
+	if secret, ok := out.Attributes["secret"].(schema.StringAttribute); !ok || !secret.Sensitive {
+		t.Fatalf("expected `secret` to be a Sensitive String Attribute")
+	}
+
+	rule, ok := out.Blocks["rule"].(schema.ListNestedBlock)
+	if !ok {
+		t.Fatalf("expected `rule` to be a List Nested Block but got %T", out.Blocks["rule"])
+	}
+	if len(rule.Validators) != 1 {
+		t.Fatalf("expected `rule` to have a single Validator for MaxItems but got %d", len(rule.Validators))
+	}
+
+	if endpoint, ok := out.Attributes["endpoint"].(schema.ListNestedAttribute); !ok || !endpoint.Computed {
+		t.Fatalf("expected the Computed-only block `endpoint` to be a Computed List Nested Attribute")
+	}
+}
+
+func TestTypedSchemaFrameworkDataSource(t *testing.T) {
+	ctx := context.TODO()
+	out := testTypedSchema().FrameworkDataSourceSchema()
+
+	if diags := out.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("validating the Framework schema: %+v", diags)
+	}
+
+	if _, ok := out.Blocks["rule"]; !ok {
+		t.Fatalf("expected `rule` to be a Block")
+	}
+	if _, ok := out.Attributes["endpoint"]; !ok {
+		t.Fatalf("expected the Computed-only block `endpoint` to be an Attribute")
+	}
+}
+
+type testTypedSchemaRule struct {
+	Name     string `tfschema:"name"`
+	Priority int64  `tfschema:"priority"`
+}
+
+type testTypedSchemaEndpoint struct {
+	Address string `tfschema:"address"`
+}
+
+type testTypedSchemaModel struct {
+	ID       string                    `tfschema:"id"`
+	Name     string                    `tfschema:"name"`
+	Enabled  bool                      `tfschema:"enabled"`
+	Count    *int64                    `tfschema:"instance_count"`
+	Ratio    float64                   `tfschema:"ratio"`
+	Secret   string                    `tfschema:"secret"`
+	Zones    []string                  `tfschema:"zones"`
+	Tags     map[string]string         `tfschema:"tags"`
+	Rule     []testTypedSchemaRule     `tfschema:"rule"`
+	Endpoint []testTypedSchemaEndpoint `tfschema:"endpoint"`
+}
+
+type testTypedSchemaResource struct {
+	model *testTypedSchemaModel
+}
+
+var _ FrameworkResource = &testTypedSchemaResource{}
+
+func (r *testTypedSchemaResource) ResourceType() string {
+	return "azurerm_typed_schema_example"
+}
+
+func (r *testTypedSchemaResource) Schema(_ context.Context) schema.Schema {
+	return testTypedSchema().FrameworkResourceSchema()
+}
+
+func (r *testTypedSchemaResource) Create() FrameworkResourceFunc {
+	return FrameworkResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata FrameworkResourceMetaData) error {
+			var model testTypedSchemaModel
+			if err := metadata.Decode(ctx, &model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := commonids.NewResourceGroupID("12345678-1234-9876-4563-123456789012", model.Name)
+			model.ID = id.ID()
+			model.Endpoint = []testTypedSchemaEndpoint{
+				{
+					Address: "10.0.0.1",
+				},
+			}
+			r.model = &model
+
+			return metadata.SetID(ctx, id)
+		},
+	}
+}
+
+func (r *testTypedSchemaResource) Read() FrameworkResourceFunc {
+	return FrameworkResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata FrameworkResourceMetaData) error {
+			return metadata.Encode(ctx, r.model)
+		},
+	}
+}
+
+func (r *testTypedSchemaResource) Delete() FrameworkResourceFunc {
+	return FrameworkResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata FrameworkResourceMetaData) error {
+			return nil
+		},
+	}
+}
+
+func (r *testTypedSchemaResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateResourceGroupID
+}
+
+func TestTypedSchemaFrameworkEncodeDecode(t *testing.T) {
+	ctx := context.TODO()
+	r := &testTypedSchemaResource{}
+	wrapper, resourceSchema := testFrameworkResourceWrapper(t, r)
+
+	objectType := resourceSchema.Type().TerraformType(ctx).(tftypes.Object)
+	ruleType := objectType.AttributeTypes["rule"].(tftypes.List).ElementType
+	plan := tfsdk.Plan{
+		Schema: resourceSchema,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id":             tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"name":           tftypes.NewValue(tftypes.String, "example"),
+			"enabled":        tftypes.NewValue(tftypes.Bool, true),
+			"instance_count": tftypes.NewValue(tftypes.Number, 3),
+			"ratio":          tftypes.NewValue(tftypes.Number, 0.5),
+			"secret":         tftypes.NewValue(tftypes.String, nil),
+			"zones": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "1"),
+				tftypes.NewValue(tftypes.String, "2"),
+			}),
+			"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"env": tftypes.NewValue(tftypes.String, "test"),
+			}),
+			"rule": tftypes.NewValue(objectType.AttributeTypes["rule"], []tftypes.Value{
+				tftypes.NewValue(ruleType, map[string]tftypes.Value{
+					"name":     tftypes.NewValue(tftypes.String, "first"),
+					"priority": tftypes.NewValue(tftypes.Number, 100),
+				}),
+			}),
+			"endpoint": tftypes.NewValue(objectType.AttributeTypes["endpoint"], tftypes.UnknownValue),
+			"timeouts": tftypes.NewValue(objectType.AttributeTypes["timeouts"], nil),
+		}),
+	}
+
+	createResponse := resource.CreateResponse{
+		State: tfsdk.State{
+			Schema: resourceSchema,
+			Raw:    tftypes.NewValue(objectType, nil),
+		},
+	}
+	wrapper.Create(ctx, resource.CreateRequest{Plan: plan}, &createResponse)
+	if createResponse.Diagnostics.HasError() {
+		t.Fatalf("creating: %+v", createResponse.Diagnostics)
+	}
+
+	count := int64(3)
+	expected := testTypedSchemaModel{
+		ID:      "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+		Name:    "example",
+		Enabled: true,
+		Count:   &count,
+		Ratio:   0.5,
+		Zones:   []string{"1", "2"},
+		Tags: map[string]string{
+			"env": "test",
+		},
+		Rule: []testTypedSchemaRule{
+			{
+				Name:     "first",
+				Priority: 100,
+			},
+		},
+		Endpoint: []testTypedSchemaEndpoint{
+			{
+				Address: "10.0.0.1",
+			},
+		},
+	}
+
+	// decoding what's been encoded into the State should return the same Model
+	var actual testTypedSchemaModel
+	metadata := FrameworkResourceMetaData{
+		State: &createResponse.State,
+		model: tfsdk.State{
+			Schema: r.Schema(ctx),
+		},
+	}
+	if err := metadata.Decode(ctx, &actual); err != nil {
+		t.Fatalf("decoding: %+v", err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
@@ -39,6 +39,11 @@ func (dw *DataSourceWrapper) DataSource() (*schema.Resource, error) {
 			return nil, fmt.Errorf("validating model for %q: %+v", dw.dataSource.ResourceType(), err)
 		}
 	}
+	if v, ok := dw.dataSource.(DataSourceWithItemModelObject); ok {
+		if err := ValidateModelObject(v.ItemModelObject()); err != nil {
+			return nil, fmt.Errorf("validating item model for %q: %+v", dw.dataSource.ResourceType(), err)
+		}
+	}
 
 	d := func(duration time.Duration) *time.Duration {
 		return &duration
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -47,11 +48,19 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		return &duration
 	}
 
+	// the `default_tags` and `ignore_tags` apply to the model of a taggable Resource (see EnableTagsAll)
+	tagsAll := SupportsTagsAll(&schema.Resource{Schema: *resourceSchema})
+	resourceRunArgs := func(d *schema.ResourceData, meta interface{}) ResourceMetaData {
+		metaData := runArgs(d, meta, rw.logger)
+		metaData.tagsAll = tagsAll
+		return metaData
+	}
+
 	resource := schema.Resource{
 		Schema: *resourceSchema,
 
 		CreateContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+			metaData := resourceRunArgs(d, meta)
 			err := rw.resource.Create().Func(ctx, metaData)
 			if err != nil {
 				return err
@@ -64,14 +73,16 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 
 		// looks like these could be reused, easiest if they're not
 		ReadContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+			metaData := resourceRunArgs(d, meta)
 			return rw.resource.Read().Func(ctx, metaData)
 		}),
 		DeleteContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+			metaData := resourceRunArgs(d, meta)
 			return rw.resource.Delete().Func(ctx, metaData)
 		}),
 
+		// these are the hard-coded defaults, which are replaced by any `default_timeouts` configured
+		// in the Provider block when the Provider is configured (see timeouts.ApplyProviderDefaults)
 		Timeouts: &schema.ResourceTimeout{
 			Create: d(rw.resource.Create().Timeout),
 			Read:   d(rw.resource.Read().Timeout),
@@ -96,9 +107,10 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			return nil
 		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
 			if v, ok := rw.resource.(ResourceWithCustomImporter); ok {
-				metaData := runArgs(d, meta, rw.logger)
+				metaData := resourceRunArgs(d, meta)
 
-				ctx, cancel := context.WithTimeout(ctx, rw.resource.Read().Timeout)
+				// the Read timeout includes any `default_timeouts` configured in the Provider block
+				ctx, cancel := context.WithTimeout(ctx, d.Timeout(pluginsdk.TimeoutRead))
 				defer cancel()
 				err := v.CustomImporter()(ctx, metaData)
 				if err != nil {
@@ -116,7 +128,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 	// implementations can opt to interface
 	if v, ok := rw.resource.(ResourceWithUpdate); ok {
 		resource.UpdateContext = rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+			metaData := resourceRunArgs(d, meta)
 
 			err := v.Update().Func(ctx, metaData)
 			if err != nil {
@@ -146,6 +158,10 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		}
 	}
 
+	if v, ok := rw.resource.(ResourceWithAzureResourceType); ok {
+		addResourceTypeLocationValidation(&resource, v.AzureResourceType())
+	}
+
 	if v, ok := rw.resource.(ResourceWithDeprecationAndNoReplacement); ok {
 		message := v.DeprecationMessage()
 		if message == "" {
@@ -188,8 +204,12 @@ func (rw *ResourceWrapper) diagnosticsWrapper(in func(ctx context.Context, d *sc
 
 func diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error, logger Logger) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		// the locks acquired during the operation are owned by it, so that a deadlock between operations is reported
+		ctx = locks.WithOwner(ctx)
+
 		out := make([]diag.Diagnostic, 0)
-		if err := in(ctx, d, meta); err != nil {
+		err := in(ctx, d, meta)
+		if err != nil {
 			out = append(out, diag.Diagnostic{
 				Severity:      diag.Error,
 				Summary:       err.Error(),
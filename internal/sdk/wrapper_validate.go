@@ -59,6 +59,19 @@ func validateModelObjectRecursively(prefix string, objType reflect.Type, objVal
 			}
 		}
 
+		// a single nested object (or a pointer to one) is used for blocks with `MaxItems: 1`
+		if nestedType := field.Type; nestedType.Kind() == reflect.Struct || (nestedType.Kind() == reflect.Pointer && nestedType.Elem().Kind() == reflect.Struct) {
+			if nestedType.Kind() == reflect.Pointer {
+				nestedType = nestedType.Elem()
+			}
+			if nestedType != timeType {
+				fieldName := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, field.Name), ".")
+				if err := validateModelObjectRecursively(fieldName, nestedType, reflect.Indirect(reflect.New(nestedType))); err != nil {
+					return err
+				}
+			}
+		}
+
 		fieldName := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, field.Name), ".")
 		structTags, err := parseStructTags(field.Tag)
 		if err != nil {
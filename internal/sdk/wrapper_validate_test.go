@@ -3,7 +3,10 @@
 
 package sdk
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestValidateTopLevelObjectValid(t *testing.T) {
 	type Person struct {
@@ -69,3 +72,29 @@ func TestValidateNestedObjectInvalid(t *testing.T) {
 		t.Fatalf("expected an error but didn't get one")
 	}
 }
+
+func TestValidateSingleNestedObject(t *testing.T) {
+	type Pet struct {
+		Name string `tfschema:"name"`
+	}
+	type Person struct {
+		Name    string    `tfschema:"name"`
+		Born    time.Time `tfschema:"born"`
+		Pet     *Pet      `tfschema:"pet"`
+		Partner Pet       `tfschema:"partner"`
+	}
+	if err := ValidateModelObject(&Person{}); err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+
+	type InvalidPet struct {
+		Name string
+	}
+	type InvalidPerson struct {
+		Name string      `tfschema:"name"`
+		Pet  *InvalidPet `tfschema:"pet"`
+	}
+	if err := ValidateModelObject(&InvalidPerson{}); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
@@ -14,13 +14,13 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/analysisservices/2017-08-01/servers"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/analysisservices/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -195,7 +195,7 @@ func resourceAnalysisServicesServerCreate(d *pluginsdk.ResourceData, meta interf
 			AsAdministrators:     expandAnalysisServicesServerAdminUsers(d),
 			IPV4FirewallSettings: expandAnalysisServicesServerFirewallSettings(d),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandPointer(d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("enable_power_bi_service"); ok && !features.FourPointOhBeta() {
@@ -286,7 +286,7 @@ func resourceAnalysisServicesServerRead(d *pluginsdk.ResourceData, meta interfac
 			}
 		}
 
-		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+		if err := tags.FlattenPointerAndSet(d, model.Tags); err != nil {
 			return err
 		}
 	}
@@ -335,7 +335,7 @@ func resourceAnalysisServicesServerUpdate(d *pluginsdk.ResourceData, meta interf
 		Sku: &servers.ResourceSku{
 			Name: d.Get("sku").(string),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandPointer(d.Get("tags").(map[string]interface{})),
 		Properties: &servers.AnalysisServicesServerMutableProperties{
 			AsAdministrators:        expandAnalysisServicesServerAdminUsers(d),
 			IPV4FirewallSettings:    expandAnalysisServicesServerFirewallSettings(d),
@@ -17,7 +17,6 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/api"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apimanagementservice"
@@ -36,6 +35,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
 	apimValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -769,7 +769,7 @@ func resourceApiManagementServiceCreate(d *pluginsdk.ResourceData, meta interfac
 			Certificates:        certificates,
 		},
 		Sku:  sku,
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandPointer(t),
 	}
 
 	if _, ok := d.GetOk("hostname_configuration"); ok {
@@ -1011,8 +1011,8 @@ func resourceApiManagementServiceUpdate(d *pluginsdk.ResourceData, meta interfac
 		payload.Sku = pointer.To(sku)
 	}
 
-	if d.HasChange("tags") {
-		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		payload.Tags = tags.ExpandPointer(d.Get("tags").(map[string]interface{}))
 	}
 
 	if d.HasChange("public_ip_address_id") {
@@ -1399,7 +1399,7 @@ func resourceApiManagementServiceRead(d *pluginsdk.ResourceData, meta interface{
 			d.Set("sign_up", []interface{}{})
 			d.Set("delegation", []interface{}{})
 		}
-		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+		if err := tags.FlattenPointerAndSet(d, model.Tags); err != nil {
 			return err
 		}
 	}
@@ -6,7 +6,7 @@ package validate
 import (
 	"fmt"
 
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 )
 
 func RedisCacheLocation(input interface{}, key string) (warnings []string, errors []error) {
@@ -20,5 +20,5 @@ func RedisCacheLocation(input interface{}, key string) (warnings []string, error
 		return warnings, errors
 	}
 
-	return location.EnhancedValidate(v, key)
+	return resourceproviders.EnhancedValidateLocation(v, key)
 }
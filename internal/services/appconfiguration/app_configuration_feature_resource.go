@@ -466,7 +466,7 @@ func (k FeatureResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("while unlocking key/label pair %s/%s: %+v", nestedItemId.Key, nestedItemId.Label, err)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				kv.Tags = tags.Expand(model.Tags)
 			}
 
@@ -17,7 +17,6 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2023-03-01/configurationstores"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2023-03-01/deletedconfigurationstores"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2023-03-01/operations"
@@ -27,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -311,7 +311,7 @@ func resourceAppConfigurationCreate(d *pluginsdk.ResourceData, meta interface{})
 			DisableLocalAuth:      utils.Bool(!d.Get("local_auth_enabled").(bool)),
 			Encryption:            expandAppConfigurationEncryption(d.Get("encryption").([]interface{})),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandPointer(d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.Get("soft_delete_retention_days").(int); ok && v != 7 {
@@ -398,9 +398,9 @@ func resourceAppConfigurationUpdate(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		t := d.Get("tags").(map[string]interface{})
-		update.Tags = tags.Expand(t)
+		update.Tags = tags.ExpandPointer(t)
 	}
 
 	if d.HasChange("identity") {
@@ -621,7 +621,7 @@ func resourceAppConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 		d.Set("replica", replica)
 
-		return tags.FlattenAndSet(d, model.Tags)
+		return tags.FlattenPointerAndSet(d, model.Tags)
 	}
 
 	return nil
@@ -229,7 +229,7 @@ func (r ApplicationInsightsWorkbookResource) Update() sdk.ResourceFunc {
 				properties.Properties.SerializedData = model.DataJson
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				properties.Tags = &model.Tags
 			}
 
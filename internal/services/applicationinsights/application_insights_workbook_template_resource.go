@@ -267,7 +267,7 @@ func (r ApplicationInsightsWorkbookTemplateResource) Update() sdk.ResourceFunc {
 				properties.Properties.Localized = &localizedValue
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				properties.Tags = &model.Tags
 			}
 
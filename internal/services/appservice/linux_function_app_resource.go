@@ -94,6 +94,8 @@ var _ sdk.ResourceWithCustomizeDiff = LinuxFunctionAppResource{}
 
 var _ sdk.ResourceWithStateMigration = LinuxFunctionAppResource{}
 
+var _ sdk.ResourceWithAzureResourceType = LinuxFunctionAppResource{}
+
 func (r LinuxFunctionAppResource) ModelObject() interface{} {
 	return &LinuxFunctionAppModel{}
 }
@@ -102,6 +104,10 @@ func (r LinuxFunctionAppResource) ResourceType() string {
 	return "azurerm_linux_function_app"
 }
 
+func (r LinuxFunctionAppResource) AzureResourceType() string {
+	return "Microsoft.Web/sites"
+}
+
 func (r LinuxFunctionAppResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return commonids.ValidateFunctionAppID
 }
@@ -980,7 +986,7 @@ func (r LinuxFunctionAppResource) Update() sdk.ResourceFunc {
 				model.Properties.KeyVaultReferenceIdentity = pointer.To(state.KeyVaultReferenceIdentityID)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				model.Tags = pointer.To(state.Tags)
 			}
 
@@ -930,7 +930,7 @@ func (r LinuxFunctionAppSlotResource) Update() sdk.ResourceFunc {
 				model.Properties.KeyVaultReferenceIdentity = pointer.To(state.KeyVaultReferenceIdentityID)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				model.Tags = pointer.To(state.Tags)
 			}
 
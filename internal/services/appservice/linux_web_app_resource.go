@@ -76,6 +76,8 @@ var _ sdk.ResourceWithCustomImporter = LinuxWebAppResource{}
 
 var _ sdk.ResourceWithStateMigration = LinuxWebAppResource{}
 
+var _ sdk.ResourceWithAzureResourceType = LinuxWebAppResource{}
+
 func (r LinuxWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -265,6 +267,10 @@ func (r LinuxWebAppResource) ResourceType() string {
 	return "azurerm_linux_web_app"
 }
 
+func (r LinuxWebAppResource) AzureResourceType() string {
+	return "Microsoft.Web/sites"
+}
+
 func (r LinuxWebAppResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -818,7 +824,7 @@ func (r LinuxWebAppResource) Update() sdk.ResourceFunc {
 				model.Properties.KeyVaultReferenceIdentity = pointer.To(state.KeyVaultReferenceIdentityID)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				model.Tags = pointer.To(state.Tags)
 			}
 
@@ -32,6 +32,8 @@ var _ sdk.ResourceWithUpdate = ServicePlanResource{}
 
 var _ sdk.ResourceWithStateMigration = ServicePlanResource{}
 
+var _ sdk.ResourceWithAzureResourceType = ServicePlanResource{}
+
 type OSType string
 
 const (
@@ -146,6 +148,10 @@ func (r ServicePlanResource) ResourceType() string {
 	return "azurerm_service_plan"
 }
 
+func (r ServicePlanResource) AzureResourceType() string {
+	return "Microsoft.Web/serverFarms"
+}
+
 func (r ServicePlanResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 60 * time.Minute,
@@ -336,7 +342,7 @@ func (r ServicePlanResource) Update() sdk.ResourceFunc {
 				model.Sku.Name = utils.String(state.Sku)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				model.Tags = pointer.To(state.Tags)
 			}
 
@@ -31,6 +31,8 @@ var _ sdk.ResourceWithUpdate = StaticWebAppResource{}
 
 var _ sdk.ResourceWithCustomizeDiff = StaticWebAppResource{}
 
+var _ sdk.ResourceWithAzureResourceType = StaticWebAppResource{}
+
 type StaticWebAppResourceModel struct {
 	Name                string                                     `tfschema:"name"`
 	ResourceGroupName   string                                     `tfschema:"resource_group_name"`
@@ -143,6 +145,10 @@ func (r StaticWebAppResource) ResourceType() string {
 	return "azurerm_static_web_app"
 }
 
+func (r StaticWebAppResource) AzureResourceType() string {
+	return "Microsoft.Web/staticSites"
+}
+
 func (r StaticWebAppResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -416,7 +422,7 @@ func (r StaticWebAppResource) Update() sdk.ResourceFunc {
 				}
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				model.Tags = pointer.To(config.Tags)
 			}
 
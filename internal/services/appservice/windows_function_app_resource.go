@@ -93,6 +93,8 @@ var _ sdk.ResourceWithCustomizeDiff = WindowsFunctionAppResource{}
 
 var _ sdk.ResourceWithStateMigration = WindowsFunctionAppResource{}
 
+var _ sdk.ResourceWithAzureResourceType = WindowsFunctionAppResource{}
+
 func (r WindowsFunctionAppResource) ModelObject() interface{} {
 	return &WindowsFunctionAppModel{}
 }
@@ -101,6 +103,10 @@ func (r WindowsFunctionAppResource) ResourceType() string {
 	return "azurerm_windows_function_app"
 }
 
+func (r WindowsFunctionAppResource) AzureResourceType() string {
+	return "Microsoft.Web/sites"
+}
+
 func (r WindowsFunctionAppResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return commonids.ValidateFunctionAppID
 }
@@ -988,7 +994,7 @@ func (r WindowsFunctionAppResource) Update() sdk.ResourceFunc {
 				model.Properties.KeyVaultReferenceIdentity = pointer.To(state.KeyVaultReferenceIdentityID)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				model.Tags = pointer.To(state.Tags)
 			}
 
@@ -74,6 +74,8 @@ var _ sdk.ResourceWithCustomImporter = WindowsWebAppResource{}
 
 var _ sdk.ResourceWithStateMigration = WindowsWebAppResource{}
 
+var _ sdk.ResourceWithAzureResourceType = WindowsWebAppResource{}
+
 func (r WindowsWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -263,6 +265,10 @@ func (r WindowsWebAppResource) ResourceType() string {
 	return "azurerm_windows_web_app"
 }
 
+func (r WindowsWebAppResource) AzureResourceType() string {
+	return "Microsoft.Web/sites"
+}
+
 func (r WindowsWebAppResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
@@ -850,7 +856,7 @@ func (r WindowsWebAppResource) Update() sdk.ResourceFunc {
 				model.Properties.KeyVaultReferenceIdentity = pointer.To(state.KeyVaultReferenceIdentityID)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				model.Tags = pointer.To(state.Tags)
 			}
 
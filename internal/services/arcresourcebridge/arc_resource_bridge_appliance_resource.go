@@ -14,11 +14,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resourceconnector/2022-10-27/appliances"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
@@ -132,7 +132,7 @@ func (r ArcResourceBridgeApplianceResource) Create() sdk.ResourceFunc {
 						Provider: pointer.To(model.Provider),
 					},
 				},
-				Tags: tags.Expand(model.Tags),
+				Tags: tags.ExpandPointer(model.Tags),
 			}
 
 			parameters.Identity = identity
@@ -192,8 +192,8 @@ func (r ArcResourceBridgeApplianceResource) Update() sdk.ResourceFunc {
 				parameters.Identity = identity
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				parameters.Tags = tags.Expand(model.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				parameters.Tags = tags.ExpandPointer(model.Tags)
 			}
 
 			if metadata.ResourceData.HasChanges("public_key_base64") {
@@ -239,7 +239,7 @@ func (r ArcResourceBridgeApplianceResource) Read() sdk.ResourceFunc {
 			if model := resp.Model; model != nil {
 				state.Location = location.Normalize(model.Location)
 				state.Identity = identity.FlattenSystemAssignedToModel(model.Identity)
-				state.Tags = tags.Flatten(model.Tags)
+				state.Tags = tags.FlattenPointer(model.Tags)
 
 				if props := model.Properties; props != nil {
 					state.Distro = pointer.From(props.Distro)
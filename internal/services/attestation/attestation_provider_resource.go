@@ -16,13 +16,13 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/attestation/2020-10-01/attestationproviders"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/attestation/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -171,7 +171,7 @@ func resourceAttestationProviderCreate(d *pluginsdk.ResourceData, meta interface
 	props := attestationproviders.AttestationServiceCreationParams{
 		Location:   location.Normalize(d.Get("location").(string)),
 		Properties: attestationproviders.AttestationServiceCreationSpecificParams{},
-		Tags:       tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:       tags.ExpandPointer(d.Get("tags").(map[string]interface{})),
 	}
 
 	// NOTE: This maybe an slice in a future release or even a slice of slices
@@ -285,7 +285,7 @@ func resourceAttestationProviderRead(d *pluginsdk.ResourceData, meta interface{}
 			d.Set("trust_model", props.TrustModel)
 		}
 
-		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+		if err := tags.FlattenPointerAndSet(d, model.Tags); err != nil {
 			return fmt.Errorf("setting `tags`: %+v", err)
 		}
 	}
@@ -333,9 +333,9 @@ func resourceAttestationProviderUpdate(d *pluginsdk.ResourceData, meta interface
 		return err
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		payload := attestationproviders.AttestationServicePatchParams{
-			Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+			Tags: tags.ExpandPointer(d.Get("tags").(map[string]interface{})),
 		}
 		if _, err := attestationClients.ProviderClient.Update(ctx, *id, payload); err != nil {
 			return fmt.Errorf("updating %s: %+v", *id, err)
@@ -49,6 +49,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		RoleDefinitionDataSource{},
 		RoleManagementPolicyDataSource{},
+		sdk.NewListDataSource(RoleAssignmentsDataSource{}),
 	}
 }
 
@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2022-04-01/roleassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type RoleAssignmentsDataSource struct{}
+
+var _ sdk.ListDataSource = RoleAssignmentsDataSource{}
+
+type RoleAssignmentsDataSourceModel struct {
+	PrincipalId string `tfschema:"principal_id"`
+	Scope       string `tfschema:"scope"`
+}
+
+type RoleAssignmentsDataSourceItemModel struct {
+	Id               string `tfschema:"id"`
+	Name             string `tfschema:"name"`
+	Condition        string `tfschema:"condition"`
+	ConditionVersion string `tfschema:"condition_version"`
+	Description      string `tfschema:"description"`
+	PrincipalId      string `tfschema:"principal_id"`
+	PrincipalType    string `tfschema:"principal_type"`
+	RoleDefinitionId string `tfschema:"role_definition_id"`
+	Scope            string `tfschema:"scope"`
+}
+
+func (RoleAssignmentsDataSource) ResourceType() string {
+	return "azurerm_role_assignments"
+}
+
+func (RoleAssignmentsDataSource) ItemsAttribute() string {
+	return "role_assignments"
+}
+
+func (RoleAssignmentsDataSource) ItemModelObject() interface{} {
+	return &RoleAssignmentsDataSourceItemModel{}
+}
+
+func (RoleAssignmentsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"principal_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"scope": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: commonids.ValidateScopeID,
+		},
+	}
+}
+
+func (RoleAssignmentsDataSource) ItemAttributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"condition": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"condition_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"description": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"principal_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"principal_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"role_definition_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"scope": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (RoleAssignmentsDataSource) List() sdk.ListFunc {
+	return sdk.ListFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData, addPage sdk.ListPageFunc) error {
+			client := metadata.Client.Authorization.ScopedRoleAssignmentsClient
+
+			var state RoleAssignmentsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			scope := state.Scope
+			if scope == "" {
+				scope = commonids.NewSubscriptionID(metadata.Client.Account.SubscriptionId).ID()
+			}
+			id := commonids.NewScopeID(scope)
+
+			options := roleassignments.DefaultListForScopeOperationOptions()
+			if state.PrincipalId != "" {
+				options.Filter = pointer.To(fmt.Sprintf("principalId eq '%s'", state.PrincipalId))
+			}
+
+			resp, err := client.ListForScopeComplete(ctx, id, options)
+			if err != nil {
+				return fmt.Errorf("listing Role Assignments within %s: %+v", id, err)
+			}
+
+			page := make([]interface{}, 0, len(resp.Items))
+			for _, item := range resp.Items {
+				if item.Id == nil {
+					continue
+				}
+
+				model := RoleAssignmentsDataSourceItemModel{
+					Id:   *item.Id,
+					Name: pointer.From(item.Name),
+				}
+				if props := item.Properties; props != nil {
+					model.Condition = pointer.From(props.Condition)
+					model.ConditionVersion = pointer.From(props.ConditionVersion)
+					model.Description = pointer.From(props.Description)
+					model.PrincipalId = props.PrincipalId
+					model.PrincipalType = string(pointer.From(props.PrincipalType))
+					model.RoleDefinitionId = props.RoleDefinitionId
+					model.Scope = pointer.From(props.Scope)
+				}
+				page = append(page, model)
+			}
+
+			return addPage(page...)
+		},
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type RoleAssignmentsDataSource struct{}
+
+func TestAccRoleAssignmentsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_role_assignments", "test")
+	id := uuid.New().String()
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: RoleAssignmentsDataSource{}.basic(id, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("role_assignments.#").HasValue("1"),
+				check.That(data.ResourceName).Key("role_assignments.0.name").HasValue(id),
+				check.That(data.ResourceName).Key("role_assignments.0.principal_type").Exists(),
+				check.That(data.ResourceName).Key("role_assignments.0.role_definition_id").Exists(),
+			),
+		},
+	})
+}
+
+func (RoleAssignmentsDataSource) basic(id string, data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_role_assignment" "test" {
+  name                 = "%s"
+  scope                = azurerm_resource_group.test.id
+  role_definition_name = "Reader"
+  principal_id         = data.azurerm_client_config.current.object_id
+}
+
+data "azurerm_role_assignments" "test" {
+  scope        = azurerm_resource_group.test.id
+  principal_id = data.azurerm_client_config.current.object_id
+  name         = azurerm_role_assignment.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, id)
+}
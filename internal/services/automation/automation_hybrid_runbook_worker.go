@@ -159,7 +159,10 @@ func (m HybridRunbookWorkerResource) Read() sdk.ResourceFunc {
 			client := meta.Client.Automation.HybridRunbookWorker
 			result, err := client.Get(ctx, *id)
 			if err != nil {
-				return err
+				if response.WasNotFound(result.HttpResponse) {
+					return meta.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
 			}
 			if result.Model == nil {
 				return fmt.Errorf("retrieving %s got nil model", id)
@@ -10,10 +10,10 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/module"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
@@ -142,7 +142,7 @@ func (r PowerShell72ModuleResource) Create() sdk.ResourceFunc {
 				Properties: module.ModuleCreateOrUpdateProperties{
 					ContentLink: expandPowerShell72ModuleLink(model.ModuleLink),
 				},
-				Tags: tags.Expand(model.Tags),
+				Tags: tags.ExpandPointer(model.Tags),
 			}
 
 			if _, err := client.PowerShell72ModuleCreateOrUpdate(ctx, id, parameters); err != nil {
@@ -233,8 +233,8 @@ func (r PowerShell72ModuleResource) Update() sdk.ResourceFunc {
 				},
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				parameters.Tags = tags.Expand(model.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				parameters.Tags = tags.ExpandPointer(model.Tags)
 			}
 
 			if _, err := client.PowerShell72ModuleCreateOrUpdate(ctx, *id, parameters); err != nil {
@@ -330,7 +330,7 @@ func (r PowerShell72ModuleResource) Read() sdk.ResourceFunc {
 			output.Name = id.PowerShell72ModuleName
 			output.AutomationAccountID = module.NewAutomationAccountID(id.SubscriptionId, id.ResourceGroupName, id.AutomationAccountName).ID()
 			if resp.Model != nil {
-				output.Tags = tags.Flatten(resp.Model.Tags)
+				output.Tags = tags.FlattenPointer(resp.Model.Tags)
 			}
 
 			return metadata.Encode(&output)
@@ -158,7 +158,10 @@ func (m Python3PackageResource) Read() sdk.ResourceFunc {
 			client := meta.Client.Automation.Python3Package
 			result, err := client.Get(ctx, *id)
 			if err != nil {
-				return err
+				if response.WasNotFound(result.HttpResponse) {
+					return meta.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
 			}
 
 			if result.Model == nil {
@@ -217,7 +220,7 @@ func (m Python3PackageResource) Update() sdk.ResourceFunc {
 			}
 
 			var upd python3package.PythonPackageUpdateParameters
-			if meta.ResourceData.HasChange("tags") {
+			if meta.ResourceData.HasChanges("tags", "tags_all") {
 				upd.Tags = &model.Tags
 			}
 
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type StackHciVirtualMachineInstanceId struct {
+	SubscriptionId             string
+	ResourceGroup              string
+	MachineName                string
+	VirtualMachineInstanceName string
+}
+
+func NewStackHciVirtualMachineInstanceID(subscriptionId, resourceGroup, machineName, virtualMachineInstanceName string) StackHciVirtualMachineInstanceId {
+	return StackHciVirtualMachineInstanceId{
+		SubscriptionId:             subscriptionId,
+		ResourceGroup:              resourceGroup,
+		MachineName:                machineName,
+		VirtualMachineInstanceName: virtualMachineInstanceName,
+	}
+}
+
+func (id StackHciVirtualMachineInstanceId) String() string {
+	segments := []string{
+		fmt.Sprintf("Virtual Machine Instance Name %q", id.VirtualMachineInstanceName),
+		fmt.Sprintf("Machine Name %q", id.MachineName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Stack Hci Virtual Machine Instance", segmentsStr)
+}
+
+func (id StackHciVirtualMachineInstanceId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.HybridCompute/machines/%s/providers/Microsoft.AzureStackHCI/virtualMachineInstances/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.MachineName, id.VirtualMachineInstanceName)
+}
+
+// StackHciVirtualMachineInstanceID parses a StackHciVirtualMachineInstance ID into an StackHciVirtualMachineInstanceId struct
+func StackHciVirtualMachineInstanceID(input string) (*StackHciVirtualMachineInstanceId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an StackHciVirtualMachineInstance ID: %+v", input, err)
+	}
+
+	resourceId := StackHciVirtualMachineInstanceId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.MachineName, err = id.PopSegment("machines"); err != nil {
+		return nil, err
+	}
+	if resourceId.VirtualMachineInstanceName, err = id.PopSegment("virtualMachineInstances"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// StackHciVirtualMachineInstanceIDInsensitively parses an StackHciVirtualMachineInstance ID into an StackHciVirtualMachineInstanceId struct, insensitively
+// This should only be used to parse an ID for rewriting, the StackHciVirtualMachineInstanceID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func StackHciVirtualMachineInstanceIDInsensitively(input string) (*StackHciVirtualMachineInstanceId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := StackHciVirtualMachineInstanceId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'machines' segment
+	machinesKey := "machines"
+	for key := range id.Path {
+		if strings.EqualFold(key, machinesKey) {
+			machinesKey = key
+			break
+		}
+	}
+	if resourceId.MachineName, err = id.PopSegment(machinesKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'virtualMachineInstances' segment
+	virtualMachineInstancesKey := "virtualMachineInstances"
+	for key := range id.Path {
+		if strings.EqualFold(key, virtualMachineInstancesKey) {
+			virtualMachineInstancesKey = key
+			break
+		}
+	}
+	if resourceId.VirtualMachineInstanceName, err = id.PopSegment(virtualMachineInstancesKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -52,7 +52,12 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		StackHCIDeploymentSettingResource{},
+		StackHCIGalleryImageResource{},
 		StackHCILogicalNetworkResource{},
+		StackHCIMarketplaceGalleryImageResource{},
+		StackHCINetworkInterfaceResource{},
 		StackHCIStoragePathResource{},
+		StackHCIVirtualHardDiskResource{},
+		StackHCIVirtualMachineInstanceResource{},
 	}
 }
@@ -0,0 +1,378 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azurestackhci
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/galleryimages"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/storagecontainers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.Resource           = StackHCIGalleryImageResource{}
+	_ sdk.ResourceWithUpdate = StackHCIGalleryImageResource{}
+)
+
+type StackHCIGalleryImageResource struct{}
+
+func (StackHCIGalleryImageResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return galleryimages.ValidateGalleryImageID
+}
+
+func (StackHCIGalleryImageResource) ResourceType() string {
+	return "azurerm_stack_hci_gallery_image"
+}
+
+func (StackHCIGalleryImageResource) ModelObject() interface{} {
+	return &StackHCIGalleryImageResourceModel{}
+}
+
+type StackHCIGalleryImageResourceModel struct {
+	Name                string                           `tfschema:"name"`
+	ResourceGroupName   string                           `tfschema:"resource_group_name"`
+	Location            string                           `tfschema:"location"`
+	CustomLocationId    string                           `tfschema:"custom_location_id"`
+	CloudInitDataSource string                           `tfschema:"cloud_init_data_source"`
+	HypervGeneration    string                           `tfschema:"hyperv_generation"`
+	Identifier          []StackHCIGalleryImageIdentifier `tfschema:"identifier"`
+	ImagePath           string                           `tfschema:"image_path"`
+	OsType              string                           `tfschema:"os_type"`
+	StoragePathId       string                           `tfschema:"storage_path_id"`
+	Version             string                           `tfschema:"version"`
+	Tags                map[string]interface{}           `tfschema:"tags"`
+}
+
+func (StackHCIGalleryImageResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][\-\.\_a-zA-Z0-9]{0,78}[a-zA-Z0-9]$`),
+				"name must be between 2 and 80 characters and can only contain alphanumberic characters, hyphen, dot and underline",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"custom_location_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: customlocations.ValidateCustomLocationID,
+		},
+
+		"image_path": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"hyperv_generation": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(galleryimages.PossibleValuesForHyperVGeneration(), false),
+		},
+
+		"identifier": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"offer": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"publisher": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"sku": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"os_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(galleryimages.PossibleValuesForOperatingSystemTypes(), false),
+		},
+
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"cloud_init_data_source": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(galleryimages.PossibleValuesForCloudInitDataSource(), false),
+		},
+
+		"storage_path_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: storagecontainers.ValidateStorageContainerID,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (StackHCIGalleryImageResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StackHCIGalleryImageResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 2 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.GalleryImages
+
+			var config StackHCIGalleryImageResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			subscriptionId := metadata.Client.Account.SubscriptionId
+			id := galleryimages.NewGalleryImageID(subscriptionId, config.ResourceGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := galleryimages.GalleryImages{
+				Name:     pointer.To(config.Name),
+				Location: location.Normalize(config.Location),
+				Tags:     tags.ExpandPointer(config.Tags),
+				ExtendedLocation: &galleryimages.ExtendedLocation{
+					Name: pointer.To(config.CustomLocationId),
+					Type: pointer.To(galleryimages.ExtendedLocationTypesCustomLocation),
+				},
+				Properties: &galleryimages.GalleryImageProperties{
+					Identifier: expandStackHCIGalleryImageIdentifier(config.Identifier),
+					ImagePath:  pointer.To(config.ImagePath),
+					OsType:     galleryimages.OperatingSystemTypes(config.OsType),
+				},
+			}
+
+			if config.HypervGeneration != "" {
+				payload.Properties.HyperVGeneration = pointer.To(galleryimages.HyperVGeneration(config.HypervGeneration))
+			}
+
+			if config.Version != "" {
+				payload.Properties.Version = &galleryimages.GalleryImageVersion{
+					Name: pointer.To(config.Version),
+				}
+			}
+
+			if config.CloudInitDataSource != "" {
+				payload.Properties.CloudInitDataSource = pointer.To(galleryimages.CloudInitDataSource(config.CloudInitDataSource))
+			}
+
+			if config.StoragePathId != "" {
+				payload.Properties.ContainerId = pointer.To(config.StoragePathId)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r StackHCIGalleryImageResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.GalleryImages
+
+			id, err := galleryimages.ParseGalleryImageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			schema := StackHCIGalleryImageResourceModel{
+				Name:              id.GalleryImageName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				schema.Location = location.Normalize(model.Location)
+				schema.Tags = tags.FlattenPointer(model.Tags)
+
+				if model.ExtendedLocation != nil && model.ExtendedLocation.Name != nil {
+					customLocationId, err := customlocations.ParseCustomLocationIDInsensitively(*model.ExtendedLocation.Name)
+					if err != nil {
+						return err
+					}
+
+					schema.CustomLocationId = customLocationId.ID()
+				}
+
+				if props := model.Properties; props != nil {
+					schema.CloudInitDataSource = string(pointer.From(props.CloudInitDataSource))
+					schema.HypervGeneration = string(pointer.From(props.HyperVGeneration))
+					schema.Identifier = flattenStackHCIGalleryImageIdentifier(props.Identifier)
+					schema.ImagePath = pointer.From(props.ImagePath)
+					schema.OsType = string(props.OsType)
+
+					if props.Version != nil {
+						schema.Version = pointer.From(props.Version.Name)
+					}
+
+					if props.ContainerId != nil {
+						storagePathId, err := storagecontainers.ParseStorageContainerIDInsensitively(*props.ContainerId)
+						if err != nil {
+							return err
+						}
+
+						schema.StoragePathId = storagePathId.ID()
+					}
+				}
+			}
+
+			return metadata.Encode(&schema)
+		},
+	}
+}
+
+func (r StackHCIGalleryImageResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.GalleryImages
+
+			id, err := galleryimages.ParseGalleryImageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StackHCIGalleryImageResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			parameters := resp.Model
+			if parameters == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				parameters.Tags = tags.ExpandPointer(model.Tags)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+			return nil
+		},
+	}
+}
+
+func (r StackHCIGalleryImageResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.GalleryImages
+
+			id, err := galleryimages.ParseGalleryImageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStackHCIGalleryImageIdentifier(input []StackHCIGalleryImageIdentifier) *galleryimages.GalleryImageIdentifier {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+
+	return &galleryimages.GalleryImageIdentifier{
+		Offer:     v.Offer,
+		Publisher: v.Publisher,
+		Sku:       v.Sku,
+	}
+}
+
+func flattenStackHCIGalleryImageIdentifier(input *galleryimages.GalleryImageIdentifier) []StackHCIGalleryImageIdentifier {
+	if input == nil {
+		return make([]StackHCIGalleryImageIdentifier, 0)
+	}
+
+	return []StackHCIGalleryImageIdentifier{
+		{
+			Offer:     input.Offer,
+			Publisher: input.Publisher,
+			Sku:       input.Sku,
+		},
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azurestackhci_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/galleryimages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StackHCIGalleryImageResource struct{}
+
+// the image has to be uploaded somewhere accessible to the HCI cluster (e.g. a path on the cluster shared volume) beforehand
+const (
+	galleryImagePathEnv = "ARM_TEST_STACK_HCI_GALLERY_IMAGE_PATH"
+)
+
+func TestAccStackHCIGalleryImage_basic(t *testing.T) {
+	if os.Getenv(customLocationIdEnv) == "" || os.Getenv(galleryImagePathEnv) == "" {
+		t.Skipf("skipping since %q or %q has not been specified", customLocationIdEnv, galleryImagePathEnv)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_gallery_image", "test")
+	r := StackHCIGalleryImageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStackHCIGalleryImage_complete(t *testing.T) {
+	if os.Getenv(customLocationIdEnv) == "" || os.Getenv(galleryImagePathEnv) == "" {
+		t.Skipf("skipping since %q or %q has not been specified", customLocationIdEnv, galleryImagePathEnv)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_gallery_image", "test")
+	r := StackHCIGalleryImageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStackHCIGalleryImage_update(t *testing.T) {
+	if os.Getenv(customLocationIdEnv) == "" || os.Getenv(galleryImagePathEnv) == "" {
+		t.Skipf("skipping since %q or %q has not been specified", customLocationIdEnv, galleryImagePathEnv)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_gallery_image", "test")
+	r := StackHCIGalleryImageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStackHCIGalleryImage_requiresImport(t *testing.T) {
+	if os.Getenv(customLocationIdEnv) == "" || os.Getenv(galleryImagePathEnv) == "" {
+		t.Skipf("skipping since %q or %q has not been specified", customLocationIdEnv, galleryImagePathEnv)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_gallery_image", "test")
+	r := StackHCIGalleryImageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r StackHCIGalleryImageResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := galleryimages.ParseGalleryImageID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AzureStackHCI.GalleryImages.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r StackHCIGalleryImageResource) basic(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_stack_hci_gallery_image" "test" {
+  name                = "acctest-gi-${var.random_string}"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %q
+  image_path          = %q
+  os_type             = "Linux"
+}
+`, template, os.Getenv(customLocationIdEnv), os.Getenv(galleryImagePathEnv))
+}
+
+func (r StackHCIGalleryImageResource) requiresImport(data acceptance.TestData) string {
+	config := r.basic(data)
+
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_gallery_image" "import" {
+  name                = azurerm_stack_hci_gallery_image.test.name
+  resource_group_name = azurerm_stack_hci_gallery_image.test.resource_group_name
+  location            = azurerm_stack_hci_gallery_image.test.location
+  custom_location_id  = azurerm_stack_hci_gallery_image.test.custom_location_id
+  image_path          = azurerm_stack_hci_gallery_image.test.image_path
+  os_type             = azurerm_stack_hci_gallery_image.test.os_type
+}
+`, config)
+}
+
+func (r StackHCIGalleryImageResource) update(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_stack_hci_gallery_image" "test" {
+  name                = "acctest-gi-${var.random_string}"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %q
+  image_path          = %q
+  os_type             = "Linux"
+
+  tags = {
+    foo = "bar"
+  }
+}
+`, template, os.Getenv(customLocationIdEnv), os.Getenv(galleryImagePathEnv))
+}
+
+func (r StackHCIGalleryImageResource) complete(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_stack_hci_storage_path" "test" {
+  name                = "acctest-sp-${var.random_string}"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %[2]q
+  path                = "C:\\ClusterStorage\\UserStorage_2\\sp-${var.random_string}"
+}
+
+resource "azurerm_stack_hci_gallery_image" "test" {
+  name                   = "acctest-gi-${var.random_string}"
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = azurerm_resource_group.test.location
+  custom_location_id     = %[2]q
+  image_path             = %[3]q
+  os_type                = "Linux"
+  hyperv_generation      = "V2"
+  cloud_init_data_source = "NoCloud"
+  storage_path_id        = azurerm_stack_hci_storage_path.test.id
+  version                = "1.0.0"
+
+  identifier {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "22.04-LTS"
+  }
+
+  tags = {
+    foo = "bar"
+    env = "test"
+  }
+}
+`, template, os.Getenv(customLocationIdEnv), os.Getenv(galleryImagePathEnv))
+}
+
+func (r StackHCIGalleryImageResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+variable "primary_location" {
+  default = %q
+}
+
+variable "random_string" {
+  default = %q
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctest-hci-gi-${var.random_string}"
+  location = var.primary_location
+}
+`, data.Locations.Primary, data.RandomString)
+}
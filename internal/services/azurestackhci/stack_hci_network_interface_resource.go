@@ -0,0 +1,369 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azurestackhci
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/logicalnetworks"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/networkinterfaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.Resource           = StackHCINetworkInterfaceResource{}
+	_ sdk.ResourceWithUpdate = StackHCINetworkInterfaceResource{}
+)
+
+type StackHCINetworkInterfaceResource struct{}
+
+func (StackHCINetworkInterfaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return networkinterfaces.ValidateNetworkInterfaceID
+}
+
+func (StackHCINetworkInterfaceResource) ResourceType() string {
+	return "azurerm_stack_hci_network_interface"
+}
+
+func (StackHCINetworkInterfaceResource) ModelObject() interface{} {
+	return &StackHCINetworkInterfaceResourceModel{}
+}
+
+type StackHCINetworkInterfaceResourceModel struct {
+	Name              string                    `tfschema:"name"`
+	ResourceGroupName string                    `tfschema:"resource_group_name"`
+	Location          string                    `tfschema:"location"`
+	CustomLocationId  string                    `tfschema:"custom_location_id"`
+	DNSServers        []string                  `tfschema:"dns_servers"`
+	IPConfiguration   []StackHCIIPConfiguration `tfschema:"ip_configuration"`
+	MACAddress        string                    `tfschema:"mac_address"`
+	Tags              map[string]interface{}    `tfschema:"tags"`
+}
+
+type StackHCIIPConfiguration struct {
+	Gateway          string `tfschema:"gateway"`
+	PrefixLength     string `tfschema:"prefix_length"`
+	PrivateIPAddress string `tfschema:"private_ip_address"`
+	SubnetId         string `tfschema:"subnet_id"`
+}
+
+func (StackHCINetworkInterfaceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][\-\.\_a-zA-Z0-9]{0,78}[a-zA-Z0-9]$`),
+				"name must be between 2 and 80 characters and can only contain alphanumberic characters, hyphen, dot and underline",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"custom_location_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: customlocations.ValidateCustomLocationID,
+		},
+
+		"ip_configuration": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"subnet_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: logicalnetworks.ValidateLogicalNetworkID,
+					},
+
+					"private_ip_address": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsIPv4Address,
+					},
+
+					"gateway": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"prefix_length": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"dns_servers": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+		},
+
+		"mac_address": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (StackHCINetworkInterfaceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StackHCINetworkInterfaceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.NetworkInterfaces
+
+			var config StackHCINetworkInterfaceResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			subscriptionId := metadata.Client.Account.SubscriptionId
+			id := networkinterfaces.NewNetworkInterfaceID(subscriptionId, config.ResourceGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := networkinterfaces.NetworkInterfaces{
+				Name:     pointer.To(config.Name),
+				Location: location.Normalize(config.Location),
+				Tags:     tags.ExpandPointer(config.Tags),
+				ExtendedLocation: &networkinterfaces.ExtendedLocation{
+					Name: pointer.To(config.CustomLocationId),
+					Type: pointer.To(networkinterfaces.ExtendedLocationTypesCustomLocation),
+				},
+				Properties: &networkinterfaces.NetworkInterfaceProperties{
+					IPConfigurations: expandStackHCINetworkInterfaceIPConfiguration(config.IPConfiguration),
+					DnsSettings: &networkinterfaces.InterfaceDNSSettings{
+						DnsServers: pointer.To(config.DNSServers),
+					},
+				},
+			}
+
+			if config.MACAddress != "" {
+				payload.Properties.MacAddress = pointer.To(config.MACAddress)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r StackHCINetworkInterfaceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.NetworkInterfaces
+
+			id, err := networkinterfaces.ParseNetworkInterfaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			schema := StackHCINetworkInterfaceResourceModel{
+				Name:              id.NetworkInterfaceName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				schema.Location = location.Normalize(model.Location)
+				schema.Tags = tags.FlattenPointer(model.Tags)
+
+				if model.ExtendedLocation != nil && model.ExtendedLocation.Name != nil {
+					customLocationId, err := customlocations.ParseCustomLocationIDInsensitively(*model.ExtendedLocation.Name)
+					if err != nil {
+						return err
+					}
+
+					schema.CustomLocationId = customLocationId.ID()
+				}
+
+				if props := model.Properties; props != nil {
+					ipConfiguration, err := flattenStackHCINetworkInterfaceIPConfiguration(props.IPConfigurations)
+					if err != nil {
+						return err
+					}
+					schema.IPConfiguration = ipConfiguration
+					schema.MACAddress = pointer.From(props.MacAddress)
+
+					if props.DnsSettings != nil {
+						schema.DNSServers = pointer.From(props.DnsSettings.DnsServers)
+					}
+				}
+			}
+
+			return metadata.Encode(&schema)
+		},
+	}
+}
+
+func (r StackHCINetworkInterfaceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.NetworkInterfaces
+
+			id, err := networkinterfaces.ParseNetworkInterfaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StackHCINetworkInterfaceResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			parameters := resp.Model
+			if parameters == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				parameters.Tags = tags.ExpandPointer(model.Tags)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+			return nil
+		},
+	}
+}
+
+func (r StackHCINetworkInterfaceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.NetworkInterfaces
+
+			id, err := networkinterfaces.ParseNetworkInterfaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStackHCINetworkInterfaceIPConfiguration(input []StackHCIIPConfiguration) *[]networkinterfaces.IPConfiguration {
+	if len(input) == 0 {
+		return nil
+	}
+
+	results := make([]networkinterfaces.IPConfiguration, 0)
+	for _, v := range input {
+		ipConfiguration := networkinterfaces.IPConfiguration{
+			Properties: &networkinterfaces.IPConfigurationProperties{
+				Subnet: &networkinterfaces.IPConfigurationPropertiesSubnet{
+					Id: pointer.To(v.SubnetId),
+				},
+			},
+		}
+
+		if v.PrivateIPAddress != "" {
+			ipConfiguration.Properties.PrivateIPAddress = pointer.To(v.PrivateIPAddress)
+		}
+
+		results = append(results, ipConfiguration)
+	}
+
+	return &results
+}
+
+func flattenStackHCINetworkInterfaceIPConfiguration(input *[]networkinterfaces.IPConfiguration) ([]StackHCIIPConfiguration, error) {
+	if input == nil {
+		return make([]StackHCIIPConfiguration, 0), nil
+	}
+
+	results := make([]StackHCIIPConfiguration, 0)
+	for _, v := range *input {
+		if v.Properties == nil {
+			continue
+		}
+
+		var subnetId string
+		if v.Properties.Subnet != nil && v.Properties.Subnet.Id != nil {
+			parsedSubnetId, err := logicalnetworks.ParseLogicalNetworkIDInsensitively(*v.Properties.Subnet.Id)
+			if err != nil {
+				return nil, err
+			}
+
+			subnetId = parsedSubnetId.ID()
+		}
+
+		results = append(results, StackHCIIPConfiguration{
+			Gateway:          pointer.From(v.Properties.Gateway),
+			PrefixLength:     pointer.From(v.Properties.PrefixLength),
+			PrivateIPAddress: pointer.From(v.Properties.PrivateIPAddress),
+			SubnetId:         subnetId,
+		})
+	}
+
+	return results, nil
+}
@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azurestackhci_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/networkinterfaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StackHCINetworkInterfaceResource struct{}
+
+func TestAccStackHCINetworkInterface_basic(t *testing.T) {
+	if os.Getenv(customLocationIdEnv) == "" {
+		t.Skipf("skipping since %q has not been specified", customLocationIdEnv)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_network_interface", "test")
+	r := StackHCINetworkInterfaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStackHCINetworkInterface_complete(t *testing.T) {
+	if os.Getenv(customLocationIdEnv) == "" {
+		t.Skipf("skipping since %q has not been specified", customLocationIdEnv)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_network_interface", "test")
+	r := StackHCINetworkInterfaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStackHCINetworkInterface_update(t *testing.T) {
+	if os.Getenv(customLocationIdEnv) == "" {
+		t.Skipf("skipping since %q has not been specified", customLocationIdEnv)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_network_interface", "test")
+	r := StackHCINetworkInterfaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStackHCINetworkInterface_requiresImport(t *testing.T) {
+	if os.Getenv(customLocationIdEnv) == "" {
+		t.Skipf("skipping since %q has not been specified", customLocationIdEnv)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_network_interface", "test")
+	r := StackHCINetworkInterfaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r StackHCINetworkInterfaceResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := networkinterfaces.ParseNetworkInterfaceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AzureStackHCI.NetworkInterfaces.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r StackHCINetworkInterfaceResource) basic(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_stack_hci_network_interface" "test" {
+  name                = "acctest-ni-${var.random_string}"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = azurerm_stack_hci_logical_network.test.custom_location_id
+
+  ip_configuration {
+    subnet_id = azurerm_stack_hci_logical_network.test.id
+  }
+}
+`, template)
+}
+
+func (r StackHCINetworkInterfaceResource) requiresImport(data acceptance.TestData) string {
+	config := r.basic(data)
+
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_network_interface" "import" {
+  name                = azurerm_stack_hci_network_interface.test.name
+  resource_group_name = azurerm_stack_hci_network_interface.test.resource_group_name
+  location            = azurerm_stack_hci_network_interface.test.location
+  custom_location_id  = azurerm_stack_hci_network_interface.test.custom_location_id
+
+  ip_configuration {
+    subnet_id = azurerm_stack_hci_network_interface.test.ip_configuration.0.subnet_id
+  }
+}
+`, config)
+}
+
+func (r StackHCINetworkInterfaceResource) update(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_stack_hci_network_interface" "test" {
+  name                = "acctest-ni-${var.random_string}"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = azurerm_stack_hci_logical_network.test.custom_location_id
+
+  ip_configuration {
+    subnet_id = azurerm_stack_hci_logical_network.test.id
+  }
+
+  tags = {
+    foo = "bar"
+  }
+}
+`, template)
+}
+
+func (r StackHCINetworkInterfaceResource) complete(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_stack_hci_network_interface" "test" {
+  name                = "acctest-ni-${var.random_string}"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = azurerm_stack_hci_logical_network.test.custom_location_id
+  dns_servers         = ["10.0.0.8"]
+  mac_address         = "02:ec:01:0c:00:08"
+
+  ip_configuration {
+    subnet_id          = azurerm_stack_hci_logical_network.test.id
+    private_ip_address = "10.0.0.220"
+  }
+
+  tags = {
+    foo = "bar"
+    env = "test"
+  }
+}
+`, template)
+}
+
+func (r StackHCINetworkInterfaceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+variable "primary_location" {
+  default = %q
+}
+
+variable "random_string" {
+  default = %q
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctest-hci-ni-${var.random_string}"
+  location = var.primary_location
+}
+
+resource "azurerm_stack_hci_logical_network" "test" {
+  name                = "acctest-ln-${var.random_string}"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %q
+  virtual_switch_name = "ConvergedSwitch(managementcompute)"
+  dns_servers         = ["10.0.0.7", "10.0.0.8"]
+
+  subnet {
+    ip_allocation_method = "Static"
+    address_prefix       = "10.0.0.0/24"
+    ip_pool {
+      start = "10.0.0.218"
+      end   = "10.0.0.230"
+    }
+    route {
+      name                = "test-route"
+      address_prefix      = "0.0.0.0/0"
+      next_hop_ip_address = "10.0.0.1"
+    }
+  }
+}
+`, data.Locations.Primary, data.RandomString, os.Getenv(customLocationIdEnv))
+}
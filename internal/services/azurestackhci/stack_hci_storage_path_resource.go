@@ -13,10 +13,10 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/storagecontainers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
@@ -112,7 +112,7 @@ func (r StackHCIStoragePathResource) Create() sdk.ResourceFunc {
 			payload := storagecontainers.StorageContainers{
 				Name:     pointer.To(config.Name),
 				Location: location.Normalize(config.Location),
-				Tags:     tags.Expand(config.Tags),
+				Tags:     tags.ExpandPointer(config.Tags),
 				ExtendedLocation: &storagecontainers.ExtendedLocation{
 					Name: pointer.To(config.CustomLocationId),
 					Type: pointer.To(storagecontainers.ExtendedLocationTypesCustomLocation),
@@ -160,7 +160,7 @@ func (r StackHCIStoragePathResource) Read() sdk.ResourceFunc {
 
 			if model := resp.Model; model != nil {
 				schema.Location = location.Normalize(model.Location)
-				schema.Tags = tags.Flatten(model.Tags)
+				schema.Tags = tags.FlattenPointer(model.Tags)
 
 				if model.ExtendedLocation != nil && model.ExtendedLocation.Name != nil {
 					customLocationId, err := customlocations.ParseCustomLocationIDInsensitively(*model.ExtendedLocation.Name)
@@ -207,8 +207,8 @@ func (r StackHCIStoragePathResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("retrieving %s: `model` was nil", *id)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				parameters.Tags = tags.Expand(model.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				parameters.Tags = tags.ExpandPointer(model.Tags)
 			}
 
 			if err := client.CreateOrUpdateThenPoll(ctx, *id, *parameters); err != nil {
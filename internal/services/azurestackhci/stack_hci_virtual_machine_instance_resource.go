@@ -0,0 +1,1011 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azurestackhci
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/networkinterfaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/storagecontainers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/virtualharddisks"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/virtualmachineinstances"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/machines"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.Resource           = StackHCIVirtualMachineInstanceResource{}
+	_ sdk.ResourceWithUpdate = StackHCIVirtualMachineInstanceResource{}
+)
+
+type StackHCIVirtualMachineInstanceResource struct{}
+
+func (StackHCIVirtualMachineInstanceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StackHciVirtualMachineInstanceID
+}
+
+func (StackHCIVirtualMachineInstanceResource) ResourceType() string {
+	return "azurerm_stack_hci_virtual_machine_instance"
+}
+
+func (StackHCIVirtualMachineInstanceResource) ModelObject() interface{} {
+	return &StackHCIVirtualMachineInstanceResourceModel{}
+}
+
+type StackHCIVirtualMachineInstanceResourceModel struct {
+	ArcMachineId           string                                  `tfschema:"arc_machine_id"`
+	CustomLocationId       string                                  `tfschema:"custom_location_id"`
+	HardwareProfile        []StackHCIVMHardwareProfileModel        `tfschema:"hardware_profile"`
+	HttpProxyConfiguration []StackHCIVMHttpProxyConfigurationModel `tfschema:"http_proxy_configuration"`
+	NetworkProfile         []StackHCIVMNetworkProfileModel         `tfschema:"network_profile"`
+	OsProfile              []StackHCIVMOsProfileModel              `tfschema:"os_profile"`
+	SecureBootEnabled      bool                                    `tfschema:"secure_boot_enabled"`
+	SecurityType           string                                  `tfschema:"security_type"`
+	StorageProfile         []StackHCIVMStorageProfileModel         `tfschema:"storage_profile"`
+	TpmEnabled             bool                                    `tfschema:"tpm_enabled"`
+}
+
+type StackHCIVMHardwareProfileModel struct {
+	DynamicMemory   []StackHCIVMDynamicMemoryModel `tfschema:"dynamic_memory"`
+	MemoryInMb      int64                          `tfschema:"memory_in_mb"`
+	ProcessorNumber int64                          `tfschema:"processor_number"`
+	VmSize          string                         `tfschema:"vm_size"`
+}
+
+type StackHCIVMDynamicMemoryModel struct {
+	MaximumMemoryInMb            int64 `tfschema:"maximum_memory_in_mb"`
+	MinimumMemoryInMb            int64 `tfschema:"minimum_memory_in_mb"`
+	TargetMemoryBufferPercentage int64 `tfschema:"target_memory_buffer_percentage"`
+}
+
+type StackHCIVMHttpProxyConfigurationModel struct {
+	HttpProxy  string   `tfschema:"http_proxy"`
+	HttpsProxy string   `tfschema:"https_proxy"`
+	NoProxy    []string `tfschema:"no_proxy"`
+	TrustedCa  string   `tfschema:"trusted_ca"`
+}
+
+type StackHCIVMNetworkProfileModel struct {
+	NetworkInterfaceIds []string `tfschema:"network_interface_ids"`
+}
+
+type StackHCIVMOsProfileModel struct {
+	AdminPassword        string                                `tfschema:"admin_password"`
+	AdminUsername        string                                `tfschema:"admin_username"`
+	ComputerName         string                                `tfschema:"computer_name"`
+	LinuxConfiguration   []StackHCIVMLinuxConfigurationModel   `tfschema:"linux_configuration"`
+	WindowsConfiguration []StackHCIVMWindowsConfigurationModel `tfschema:"windows_configuration"`
+}
+
+type StackHCIVMLinuxConfigurationModel struct {
+	PasswordAuthenticationEnabled bool                    `tfschema:"password_authentication_enabled"`
+	ProvisionVMAgentEnabled       bool                    `tfschema:"provision_vm_agent_enabled"`
+	ProvisionVMConfigAgentEnabled bool                    `tfschema:"provision_vm_config_agent_enabled"`
+	SshPublicKey                  []StackHCIVMSshKeyModel `tfschema:"ssh_public_key"`
+}
+
+type StackHCIVMWindowsConfigurationModel struct {
+	AutomaticUpdateEnabled        bool                    `tfschema:"automatic_update_enabled"`
+	ProvisionVMAgentEnabled       bool                    `tfschema:"provision_vm_agent_enabled"`
+	ProvisionVMConfigAgentEnabled bool                    `tfschema:"provision_vm_config_agent_enabled"`
+	SshPublicKey                  []StackHCIVMSshKeyModel `tfschema:"ssh_public_key"`
+	TimeZone                      string                  `tfschema:"time_zone"`
+}
+
+type StackHCIVMSshKeyModel struct {
+	KeyData string `tfschema:"key_data"`
+	Path    string `tfschema:"path"`
+}
+
+type StackHCIVMStorageProfileModel struct {
+	DataDiskIds           []string `tfschema:"data_disk_ids"`
+	ImageId               string   `tfschema:"image_id"`
+	VmConfigStoragePathId string   `tfschema:"vm_config_storage_path_id"`
+}
+
+func (StackHCIVirtualMachineInstanceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"arc_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: machines.ValidateMachineID,
+		},
+
+		"custom_location_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: customlocations.ValidateCustomLocationID,
+		},
+
+		"hardware_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"vm_size": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice(virtualmachineinstances.PossibleValuesForVMSizeEnum(), false),
+					},
+
+					"processor_number": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"memory_in_mb": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"dynamic_memory": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"maximum_memory_in_mb": {
+									Type:         pluginsdk.TypeInt,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IntAtLeast(1),
+								},
+
+								"minimum_memory_in_mb": {
+									Type:         pluginsdk.TypeInt,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IntAtLeast(1),
+								},
+
+								"target_memory_buffer_percentage": {
+									Type:         pluginsdk.TypeInt,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IntBetween(5, 2000),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"network_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"network_interface_ids": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: networkinterfaces.ValidateNetworkInterfaceID,
+						},
+					},
+				},
+			},
+		},
+
+		"os_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"admin_username": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"computer_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"admin_password": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"linux_configuration": {
+						Type:          pluginsdk.TypeList,
+						Optional:      true,
+						ForceNew:      true,
+						MaxItems:      1,
+						ConflictsWith: []string{"os_profile.0.windows_configuration"},
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"password_authentication_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  true,
+								},
+
+								"provision_vm_agent_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  false,
+								},
+
+								"provision_vm_config_agent_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  false,
+								},
+
+								"ssh_public_key": stackHCIVirtualMachineInstanceSshPublicKeySchema(),
+							},
+						},
+					},
+
+					"windows_configuration": {
+						Type:          pluginsdk.TypeList,
+						Optional:      true,
+						ForceNew:      true,
+						MaxItems:      1,
+						ConflictsWith: []string{"os_profile.0.linux_configuration"},
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"automatic_update_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  false,
+								},
+
+								"provision_vm_agent_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  false,
+								},
+
+								"provision_vm_config_agent_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  false,
+								},
+
+								"ssh_public_key": stackHCIVirtualMachineInstanceSshPublicKeySchema(),
+
+								"time_zone": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"storage_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					// the image can be either a Gallery Image or a Marketplace Gallery Image
+					"image_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: azure.ValidateResourceID,
+					},
+
+					"data_disk_ids": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: virtualharddisks.ValidateVirtualHardDiskID,
+						},
+					},
+
+					"vm_config_storage_path_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: storagecontainers.ValidateStorageContainerID,
+					},
+				},
+			},
+		},
+
+		"http_proxy_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"http_proxy": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"https_proxy": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"no_proxy": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"trusted_ca": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"secure_boot_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  true,
+		},
+
+		"security_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(virtualmachineinstances.PossibleValuesForSecurityTypes(), false),
+		},
+
+		"tpm_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+	}
+}
+
+func (StackHCIVirtualMachineInstanceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StackHCIVirtualMachineInstanceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 1 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.VirtualMachineInstances
+
+			var config StackHCIVirtualMachineInstanceResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			arcMachineId, err := machines.ParseMachineID(config.ArcMachineId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewStackHciVirtualMachineInstanceID(arcMachineId.SubscriptionId, arcMachineId.ResourceGroupName, arcMachineId.MachineName, "default")
+			scopeId := commonids.NewScopeID(arcMachineId.ID())
+
+			existing, err := client.Get(ctx, scopeId)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := virtualmachineinstances.VirtualMachineInstance{
+				ExtendedLocation: &virtualmachineinstances.ExtendedLocation{
+					Name: pointer.To(config.CustomLocationId),
+					Type: pointer.To(virtualmachineinstances.ExtendedLocationTypesCustomLocation),
+				},
+				Properties: &virtualmachineinstances.VirtualMachineInstanceProperties{
+					HardwareProfile: expandStackHCIVirtualMachineInstanceHardwareProfile(config.HardwareProfile),
+					HTTPProxyConfig: expandStackHCIVirtualMachineInstanceHttpProxyConfiguration(config.HttpProxyConfiguration),
+					NetworkProfile:  expandStackHCIVirtualMachineInstanceNetworkProfile(config.NetworkProfile),
+					OsProfile:       expandStackHCIVirtualMachineInstanceOsProfile(config.OsProfile),
+					SecurityProfile: &virtualmachineinstances.VirtualMachineInstancePropertiesSecurityProfile{
+						EnableTPM: pointer.To(config.TpmEnabled),
+						UefiSettings: &virtualmachineinstances.VirtualMachineInstancePropertiesSecurityProfileUefiSettings{
+							SecureBootEnabled: pointer.To(config.SecureBootEnabled),
+						},
+					},
+					StorageProfile: expandStackHCIVirtualMachineInstanceStorageProfile(config.StorageProfile),
+				},
+			}
+
+			if config.SecurityType != "" {
+				payload.Properties.SecurityProfile.SecurityType = pointer.To(virtualmachineinstances.SecurityTypes(config.SecurityType))
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, scopeId, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r StackHCIVirtualMachineInstanceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.VirtualMachineInstances
+
+			id, err := parse.StackHciVirtualMachineInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			arcMachineId := machines.NewMachineID(id.SubscriptionId, id.ResourceGroup, id.MachineName)
+
+			resp, err := client.Get(ctx, commonids.NewScopeID(arcMachineId.ID()))
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			var state StackHCIVirtualMachineInstanceResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			schema := StackHCIVirtualMachineInstanceResourceModel{
+				ArcMachineId: arcMachineId.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if model.ExtendedLocation != nil && model.ExtendedLocation.Name != nil {
+					customLocationId, err := customlocations.ParseCustomLocationIDInsensitively(*model.ExtendedLocation.Name)
+					if err != nil {
+						return err
+					}
+
+					schema.CustomLocationId = customLocationId.ID()
+				}
+
+				if props := model.Properties; props != nil {
+					schema.HardwareProfile = flattenStackHCIVirtualMachineInstanceHardwareProfile(props.HardwareProfile)
+					schema.HttpProxyConfiguration = flattenStackHCIVirtualMachineInstanceHttpProxyConfiguration(props.HTTPProxyConfig, state.HttpProxyConfiguration)
+
+					networkProfile, err := flattenStackHCIVirtualMachineInstanceNetworkProfile(props.NetworkProfile)
+					if err != nil {
+						return err
+					}
+					schema.NetworkProfile = networkProfile
+
+					// the admin password is not returned by the API so it's read from the existing state
+					adminPassword := ""
+					if len(state.OsProfile) > 0 {
+						adminPassword = state.OsProfile[0].AdminPassword
+					}
+					schema.OsProfile = flattenStackHCIVirtualMachineInstanceOsProfile(props.OsProfile, adminPassword)
+
+					storageProfile, err := flattenStackHCIVirtualMachineInstanceStorageProfile(props.StorageProfile)
+					if err != nil {
+						return err
+					}
+					schema.StorageProfile = storageProfile
+
+					if securityProfile := props.SecurityProfile; securityProfile != nil {
+						schema.SecurityType = string(pointer.From(securityProfile.SecurityType))
+						schema.TpmEnabled = pointer.From(securityProfile.EnableTPM)
+
+						if securityProfile.UefiSettings != nil {
+							schema.SecureBootEnabled = pointer.From(securityProfile.UefiSettings.SecureBootEnabled)
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&schema)
+		},
+	}
+}
+
+func (r StackHCIVirtualMachineInstanceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 1 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.VirtualMachineInstances
+
+			id, err := parse.StackHciVirtualMachineInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StackHCIVirtualMachineInstanceResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := virtualmachineinstances.VirtualMachineInstanceUpdateRequest{
+				Properties: &virtualmachineinstances.VirtualMachineInstanceUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChanges("hardware_profile.0.processor_number", "hardware_profile.0.memory_in_mb") {
+				hardwareProfile := model.HardwareProfile[0]
+				parameters.Properties.HardwareProfile = &virtualmachineinstances.HardwareProfileUpdate{
+					VMSize: pointer.To(virtualmachineinstances.VMSizeEnum(hardwareProfile.VmSize)),
+				}
+
+				if hardwareProfile.ProcessorNumber != 0 {
+					parameters.Properties.HardwareProfile.Processors = pointer.To(hardwareProfile.ProcessorNumber)
+				}
+
+				if hardwareProfile.MemoryInMb != 0 {
+					parameters.Properties.HardwareProfile.MemoryMB = pointer.To(hardwareProfile.MemoryInMb)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("network_profile") {
+				networkInterfaces := make([]virtualmachineinstances.NetworkProfileUpdateNetworkInterfacesInlined, 0)
+				for _, networkInterfaceId := range model.NetworkProfile[0].NetworkInterfaceIds {
+					networkInterfaces = append(networkInterfaces, virtualmachineinstances.NetworkProfileUpdateNetworkInterfacesInlined{
+						Id: pointer.To(networkInterfaceId),
+					})
+				}
+
+				parameters.Properties.NetworkProfile = &virtualmachineinstances.NetworkProfileUpdate{
+					NetworkInterfaces: pointer.To(networkInterfaces),
+				}
+			}
+
+			if metadata.ResourceData.HasChange("storage_profile.0.data_disk_ids") {
+				dataDisks := make([]virtualmachineinstances.StorageProfileUpdateDataDisksInlined, 0)
+				for _, dataDiskId := range model.StorageProfile[0].DataDiskIds {
+					dataDisks = append(dataDisks, virtualmachineinstances.StorageProfileUpdateDataDisksInlined{
+						Id: pointer.To(dataDiskId),
+					})
+				}
+
+				parameters.Properties.StorageProfile = &virtualmachineinstances.StorageProfileUpdate{
+					DataDisks: pointer.To(dataDisks),
+				}
+			}
+
+			scopeId := commonids.NewScopeID(machines.NewMachineID(id.SubscriptionId, id.ResourceGroup, id.MachineName).ID())
+			if err := client.UpdateThenPoll(ctx, scopeId, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+			return nil
+		},
+	}
+}
+
+func (r StackHCIVirtualMachineInstanceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 1 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.VirtualMachineInstances
+
+			id, err := parse.StackHciVirtualMachineInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			scopeId := commonids.NewScopeID(machines.NewMachineID(id.SubscriptionId, id.ResourceGroup, id.MachineName).ID())
+			if err := client.DeleteThenPoll(ctx, scopeId); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func stackHCIVirtualMachineInstanceSshPublicKeySchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"key_data": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"path": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func expandStackHCIVirtualMachineInstanceHardwareProfile(input []StackHCIVMHardwareProfileModel) *virtualmachineinstances.VirtualMachineInstancePropertiesHardwareProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+
+	output := &virtualmachineinstances.VirtualMachineInstancePropertiesHardwareProfile{
+		VMSize: pointer.To(virtualmachineinstances.VMSizeEnum(v.VmSize)),
+	}
+
+	if v.ProcessorNumber != 0 {
+		output.Processors = pointer.To(v.ProcessorNumber)
+	}
+
+	if v.MemoryInMb != 0 {
+		output.MemoryMB = pointer.To(v.MemoryInMb)
+	}
+
+	if len(v.DynamicMemory) > 0 {
+		output.DynamicMemoryConfig = &virtualmachineinstances.VirtualMachineInstancePropertiesHardwareProfileDynamicMemoryConfig{
+			MaximumMemoryMB:    pointer.To(v.DynamicMemory[0].MaximumMemoryInMb),
+			MinimumMemoryMB:    pointer.To(v.DynamicMemory[0].MinimumMemoryInMb),
+			TargetMemoryBuffer: pointer.To(v.DynamicMemory[0].TargetMemoryBufferPercentage),
+		}
+	}
+
+	return output
+}
+
+func flattenStackHCIVirtualMachineInstanceHardwareProfile(input *virtualmachineinstances.VirtualMachineInstancePropertiesHardwareProfile) []StackHCIVMHardwareProfileModel {
+	if input == nil {
+		return make([]StackHCIVMHardwareProfileModel, 0)
+	}
+
+	dynamicMemory := make([]StackHCIVMDynamicMemoryModel, 0)
+	if v := input.DynamicMemoryConfig; v != nil {
+		dynamicMemory = append(dynamicMemory, StackHCIVMDynamicMemoryModel{
+			MaximumMemoryInMb:            pointer.From(v.MaximumMemoryMB),
+			MinimumMemoryInMb:            pointer.From(v.MinimumMemoryMB),
+			TargetMemoryBufferPercentage: pointer.From(v.TargetMemoryBuffer),
+		})
+	}
+
+	return []StackHCIVMHardwareProfileModel{
+		{
+			DynamicMemory:   dynamicMemory,
+			MemoryInMb:      pointer.From(input.MemoryMB),
+			ProcessorNumber: pointer.From(input.Processors),
+			VmSize:          string(pointer.From(input.VMSize)),
+		},
+	}
+}
+
+func expandStackHCIVirtualMachineInstanceHttpProxyConfiguration(input []StackHCIVMHttpProxyConfigurationModel) *virtualmachineinstances.HTTPProxyConfiguration {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+
+	output := &virtualmachineinstances.HTTPProxyConfiguration{
+		NoProxy: pointer.To(v.NoProxy),
+	}
+
+	if v.HttpProxy != "" {
+		output.HTTPProxy = pointer.To(v.HttpProxy)
+	}
+
+	if v.HttpsProxy != "" {
+		output.HTTPSProxy = pointer.To(v.HttpsProxy)
+	}
+
+	if v.TrustedCa != "" {
+		output.TrustedCa = pointer.To(v.TrustedCa)
+	}
+
+	return output
+}
+
+func flattenStackHCIVirtualMachineInstanceHttpProxyConfiguration(input *virtualmachineinstances.HTTPProxyConfiguration, existing []StackHCIVMHttpProxyConfigurationModel) []StackHCIVMHttpProxyConfigurationModel {
+	if input == nil {
+		return make([]StackHCIVMHttpProxyConfigurationModel, 0)
+	}
+
+	output := StackHCIVMHttpProxyConfigurationModel{
+		HttpProxy:  pointer.From(input.HTTPProxy),
+		HttpsProxy: pointer.From(input.HTTPSProxy),
+		NoProxy:    pointer.From(input.NoProxy),
+		TrustedCa:  pointer.From(input.TrustedCa),
+	}
+
+	// the proxy urls may contain credentials so the API can omit them, in which case they're read from the existing state
+	if len(existing) > 0 {
+		if output.HttpProxy == "" {
+			output.HttpProxy = existing[0].HttpProxy
+		}
+		if output.HttpsProxy == "" {
+			output.HttpsProxy = existing[0].HttpsProxy
+		}
+	}
+
+	return []StackHCIVMHttpProxyConfigurationModel{output}
+}
+
+func expandStackHCIVirtualMachineInstanceNetworkProfile(input []StackHCIVMNetworkProfileModel) *virtualmachineinstances.VirtualMachineInstancePropertiesNetworkProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	networkInterfaces := make([]virtualmachineinstances.VirtualMachineInstancePropertiesNetworkProfileNetworkInterfacesInlined, 0)
+	for _, networkInterfaceId := range input[0].NetworkInterfaceIds {
+		networkInterfaces = append(networkInterfaces, virtualmachineinstances.VirtualMachineInstancePropertiesNetworkProfileNetworkInterfacesInlined{
+			Id: pointer.To(networkInterfaceId),
+		})
+	}
+
+	return &virtualmachineinstances.VirtualMachineInstancePropertiesNetworkProfile{
+		NetworkInterfaces: pointer.To(networkInterfaces),
+	}
+}
+
+func flattenStackHCIVirtualMachineInstanceNetworkProfile(input *virtualmachineinstances.VirtualMachineInstancePropertiesNetworkProfile) ([]StackHCIVMNetworkProfileModel, error) {
+	if input == nil || input.NetworkInterfaces == nil {
+		return make([]StackHCIVMNetworkProfileModel, 0), nil
+	}
+
+	networkInterfaceIds := make([]string, 0)
+	for _, v := range *input.NetworkInterfaces {
+		if v.Id == nil {
+			continue
+		}
+
+		networkInterfaceId, err := networkinterfaces.ParseNetworkInterfaceIDInsensitively(*v.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		networkInterfaceIds = append(networkInterfaceIds, networkInterfaceId.ID())
+	}
+
+	return []StackHCIVMNetworkProfileModel{
+		{
+			NetworkInterfaceIds: networkInterfaceIds,
+		},
+	}, nil
+}
+
+func expandStackHCIVirtualMachineInstanceOsProfile(input []StackHCIVMOsProfileModel) *virtualmachineinstances.VirtualMachineInstancePropertiesOsProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+
+	output := &virtualmachineinstances.VirtualMachineInstancePropertiesOsProfile{
+		AdminUsername: pointer.To(v.AdminUsername),
+		ComputerName:  pointer.To(v.ComputerName),
+	}
+
+	if v.AdminPassword != "" {
+		output.AdminPassword = pointer.To(v.AdminPassword)
+	}
+
+	if len(v.LinuxConfiguration) > 0 {
+		linuxConfiguration := v.LinuxConfiguration[0]
+		output.LinuxConfiguration = &virtualmachineinstances.VirtualMachineInstancePropertiesOsProfileLinuxConfiguration{
+			DisablePasswordAuthentication: pointer.To(!linuxConfiguration.PasswordAuthenticationEnabled),
+			ProvisionVMAgent:              pointer.To(linuxConfiguration.ProvisionVMAgentEnabled),
+			ProvisionVMConfigAgent:        pointer.To(linuxConfiguration.ProvisionVMConfigAgentEnabled),
+			Ssh:                           expandStackHCIVirtualMachineInstanceSshConfiguration(linuxConfiguration.SshPublicKey),
+		}
+	}
+
+	if len(v.WindowsConfiguration) > 0 {
+		windowsConfiguration := v.WindowsConfiguration[0]
+		output.WindowsConfiguration = &virtualmachineinstances.VirtualMachineInstancePropertiesOsProfileWindowsConfiguration{
+			EnableAutomaticUpdates: pointer.To(windowsConfiguration.AutomaticUpdateEnabled),
+			ProvisionVMAgent:       pointer.To(windowsConfiguration.ProvisionVMAgentEnabled),
+			ProvisionVMConfigAgent: pointer.To(windowsConfiguration.ProvisionVMConfigAgentEnabled),
+			Ssh:                    expandStackHCIVirtualMachineInstanceSshConfiguration(windowsConfiguration.SshPublicKey),
+		}
+
+		if windowsConfiguration.TimeZone != "" {
+			output.WindowsConfiguration.TimeZone = pointer.To(windowsConfiguration.TimeZone)
+		}
+	}
+
+	return output
+}
+
+func flattenStackHCIVirtualMachineInstanceOsProfile(input *virtualmachineinstances.VirtualMachineInstancePropertiesOsProfile, adminPassword string) []StackHCIVMOsProfileModel {
+	if input == nil {
+		return make([]StackHCIVMOsProfileModel, 0)
+	}
+
+	linuxConfiguration := make([]StackHCIVMLinuxConfigurationModel, 0)
+	if v := input.LinuxConfiguration; v != nil {
+		linuxConfiguration = append(linuxConfiguration, StackHCIVMLinuxConfigurationModel{
+			PasswordAuthenticationEnabled: !pointer.From(v.DisablePasswordAuthentication),
+			ProvisionVMAgentEnabled:       pointer.From(v.ProvisionVMAgent),
+			ProvisionVMConfigAgentEnabled: pointer.From(v.ProvisionVMConfigAgent),
+			SshPublicKey:                  flattenStackHCIVirtualMachineInstanceSshConfiguration(v.Ssh),
+		})
+	}
+
+	windowsConfiguration := make([]StackHCIVMWindowsConfigurationModel, 0)
+	if v := input.WindowsConfiguration; v != nil {
+		windowsConfiguration = append(windowsConfiguration, StackHCIVMWindowsConfigurationModel{
+			AutomaticUpdateEnabled:        pointer.From(v.EnableAutomaticUpdates),
+			ProvisionVMAgentEnabled:       pointer.From(v.ProvisionVMAgent),
+			ProvisionVMConfigAgentEnabled: pointer.From(v.ProvisionVMConfigAgent),
+			SshPublicKey:                  flattenStackHCIVirtualMachineInstanceSshConfiguration(v.Ssh),
+			TimeZone:                      pointer.From(v.TimeZone),
+		})
+	}
+
+	return []StackHCIVMOsProfileModel{
+		{
+			AdminPassword:        adminPassword,
+			AdminUsername:        pointer.From(input.AdminUsername),
+			ComputerName:         pointer.From(input.ComputerName),
+			LinuxConfiguration:   linuxConfiguration,
+			WindowsConfiguration: windowsConfiguration,
+		},
+	}
+}
+
+func expandStackHCIVirtualMachineInstanceSshConfiguration(input []StackHCIVMSshKeyModel) *virtualmachineinstances.SshConfiguration {
+	if len(input) == 0 {
+		return nil
+	}
+
+	publicKeys := make([]virtualmachineinstances.SshPublicKey, 0)
+	for _, v := range input {
+		publicKeys = append(publicKeys, virtualmachineinstances.SshPublicKey{
+			KeyData: pointer.To(v.KeyData),
+			Path:    pointer.To(v.Path),
+		})
+	}
+
+	return &virtualmachineinstances.SshConfiguration{
+		PublicKeys: pointer.To(publicKeys),
+	}
+}
+
+func flattenStackHCIVirtualMachineInstanceSshConfiguration(input *virtualmachineinstances.SshConfiguration) []StackHCIVMSshKeyModel {
+	if input == nil || input.PublicKeys == nil {
+		return make([]StackHCIVMSshKeyModel, 0)
+	}
+
+	results := make([]StackHCIVMSshKeyModel, 0)
+	for _, v := range *input.PublicKeys {
+		results = append(results, StackHCIVMSshKeyModel{
+			KeyData: pointer.From(v.KeyData),
+			Path:    pointer.From(v.Path),
+		})
+	}
+
+	return results
+}
+
+func expandStackHCIVirtualMachineInstanceStorageProfile(input []StackHCIVMStorageProfileModel) *virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+
+	dataDisks := make([]virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfileDataDisksInlined, 0)
+	for _, dataDiskId := range v.DataDiskIds {
+		dataDisks = append(dataDisks, virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfileDataDisksInlined{
+			Id: pointer.To(dataDiskId),
+		})
+	}
+
+	output := &virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfile{
+		DataDisks: pointer.To(dataDisks),
+		ImageReference: &virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfileImageReference{
+			Id: pointer.To(v.ImageId),
+		},
+	}
+
+	if v.VmConfigStoragePathId != "" {
+		output.VMConfigStoragePathId = pointer.To(v.VmConfigStoragePathId)
+	}
+
+	return output
+}
+
+func flattenStackHCIVirtualMachineInstanceStorageProfile(input *virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfile) ([]StackHCIVMStorageProfileModel, error) {
+	if input == nil {
+		return make([]StackHCIVMStorageProfileModel, 0), nil
+	}
+
+	dataDiskIds := make([]string, 0)
+	if input.DataDisks != nil {
+		for _, v := range *input.DataDisks {
+			if v.Id == nil {
+				continue
+			}
+
+			dataDiskId, err := virtualharddisks.ParseVirtualHardDiskIDInsensitively(*v.Id)
+			if err != nil {
+				return nil, err
+			}
+
+			dataDiskIds = append(dataDiskIds, dataDiskId.ID())
+		}
+	}
+
+	output := StackHCIVMStorageProfileModel{
+		DataDiskIds: dataDiskIds,
+	}
+
+	if input.ImageReference != nil {
+		output.ImageId = pointer.From(input.ImageReference.Id)
+	}
+
+	if input.VMConfigStoragePathId != nil {
+		storagePathId, err := storagecontainers.ParseStorageContainerIDInsensitively(*input.VMConfigStoragePathId)
+		if err != nil {
+			return nil, err
+		}
+
+		output.VmConfigStoragePathId = storagePathId.ID()
+	}
+
+	return []StackHCIVMStorageProfileModel{output}, nil
+}
@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azurestackhci_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/machines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StackHCIVirtualMachineInstanceResource struct{}
+
+func TestAccStackHCIVirtualMachineInstance_basic(t *testing.T) {
+	if os.Getenv(customLocationIdEnv) == "" {
+		t.Skipf("skipping since %q has not been specified", customLocationIdEnv)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_virtual_machine_instance", "test")
+	r := StackHCIVirtualMachineInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("os_profile.0.admin_password", "http_proxy_configuration.0.http_proxy", "http_proxy_configuration.0.https_proxy"),
+	})
+}
+
+func TestAccStackHCIVirtualMachineInstance_complete(t *testing.T) {
+	if os.Getenv(customLocationIdEnv) == "" {
+		t.Skipf("skipping since %q has not been specified", customLocationIdEnv)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_virtual_machine_instance", "test")
+	r := StackHCIVirtualMachineInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("os_profile.0.admin_password", "http_proxy_configuration.0.http_proxy", "http_proxy_configuration.0.https_proxy"),
+	})
+}
+
+func TestAccStackHCIVirtualMachineInstance_update(t *testing.T) {
+	if os.Getenv(customLocationIdEnv) == "" {
+		t.Skipf("skipping since %q has not been specified", customLocationIdEnv)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_virtual_machine_instance", "test")
+	r := StackHCIVirtualMachineInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("os_profile.0.admin_password", "http_proxy_configuration.0.http_proxy", "http_proxy_configuration.0.https_proxy"),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("os_profile.0.admin_password", "http_proxy_configuration.0.http_proxy", "http_proxy_configuration.0.https_proxy"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("os_profile.0.admin_password", "http_proxy_configuration.0.http_proxy", "http_proxy_configuration.0.https_proxy"),
+	})
+}
+
+func TestAccStackHCIVirtualMachineInstance_requiresImport(t *testing.T) {
+	if os.Getenv(customLocationIdEnv) == "" {
+		t.Skipf("skipping since %q has not been specified", customLocationIdEnv)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_virtual_machine_instance", "test")
+	r := StackHCIVirtualMachineInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r StackHCIVirtualMachineInstanceResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StackHciVirtualMachineInstanceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	scopeId := commonids.NewScopeID(machines.NewMachineID(id.SubscriptionId, id.ResourceGroup, id.MachineName).ID())
+	resp, err := client.AzureStackHCI.VirtualMachineInstances.Get(ctx, scopeId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r StackHCIVirtualMachineInstanceResource) basic(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_virtual_machine_instance" "test" {
+  arc_machine_id     = azurerm_arc_machine.test.id
+  custom_location_id = %q
+
+  hardware_profile {
+    vm_size          = "Custom"
+    processor_number = 2
+    memory_in_mb     = 8192
+  }
+
+  network_profile {
+    network_interface_ids = [azurerm_stack_hci_network_interface.test.id]
+  }
+
+  os_profile {
+    admin_username = "adminuser"
+    admin_password = "!password!@#$"
+    computer_name  = "testvm"
+  }
+
+  storage_profile {
+    image_id = azurerm_stack_hci_marketplace_gallery_image.test.id
+  }
+}
+`, template, os.Getenv(customLocationIdEnv))
+}
+
+func (r StackHCIVirtualMachineInstanceResource) requiresImport(data acceptance.TestData) string {
+	config := r.basic(data)
+
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_virtual_machine_instance" "import" {
+  arc_machine_id     = azurerm_stack_hci_virtual_machine_instance.test.arc_machine_id
+  custom_location_id = azurerm_stack_hci_virtual_machine_instance.test.custom_location_id
+
+  hardware_profile {
+    vm_size          = "Custom"
+    processor_number = 2
+    memory_in_mb     = 8192
+  }
+
+  network_profile {
+    network_interface_ids = [azurerm_stack_hci_network_interface.test.id]
+  }
+
+  os_profile {
+    admin_username = "adminuser"
+    admin_password = "!password!@#$"
+    computer_name  = "testvm"
+  }
+
+  storage_profile {
+    image_id = azurerm_stack_hci_marketplace_gallery_image.test.id
+  }
+}
+`, config)
+}
+
+func (r StackHCIVirtualMachineInstanceResource) update(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_virtual_hard_disk" "test" {
+  name                = "acctest-vhd-${var.random_string}"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %[2]q
+  disk_size_in_gb     = 2
+}
+
+resource "azurerm_stack_hci_virtual_machine_instance" "test" {
+  arc_machine_id     = azurerm_arc_machine.test.id
+  custom_location_id = %[2]q
+
+  hardware_profile {
+    vm_size          = "Custom"
+    processor_number = 4
+    memory_in_mb     = 16384
+  }
+
+  network_profile {
+    network_interface_ids = [azurerm_stack_hci_network_interface.test.id]
+  }
+
+  os_profile {
+    admin_username = "adminuser"
+    admin_password = "!password!@#$"
+    computer_name  = "testvm"
+  }
+
+  storage_profile {
+    image_id      = azurerm_stack_hci_marketplace_gallery_image.test.id
+    data_disk_ids = [azurerm_stack_hci_virtual_hard_disk.test.id]
+  }
+}
+`, template, os.Getenv(customLocationIdEnv))
+}
+
+func (r StackHCIVirtualMachineInstanceResource) complete(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_virtual_hard_disk" "test" {
+  name                = "acctest-vhd-${var.random_string}"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %[2]q
+  disk_size_in_gb     = 2
+}
+
+resource "azurerm_stack_hci_virtual_machine_instance" "test" {
+  arc_machine_id      = azurerm_arc_machine.test.id
+  custom_location_id  = %[2]q
+  secure_boot_enabled = true
+  security_type       = "TrustedLaunch"
+  tpm_enabled         = true
+
+  hardware_profile {
+    vm_size = "Custom"
+
+    dynamic_memory {
+      maximum_memory_in_mb            = 16384
+      minimum_memory_in_mb            = 4096
+      target_memory_buffer_percentage = 20
+    }
+  }
+
+  http_proxy_configuration {
+    http_proxy  = "http://proxy.example.com:3128"
+    https_proxy = "http://proxy.example.com:3128"
+    no_proxy    = ["localhost", "127.0.0.1"]
+  }
+
+  network_profile {
+    network_interface_ids = [azurerm_stack_hci_network_interface.test.id]
+  }
+
+  os_profile {
+    admin_username = "adminuser"
+    admin_password = "!password!@#$"
+    computer_name  = "testvm"
+
+    windows_configuration {
+      automatic_update_enabled          = true
+      provision_vm_agent_enabled        = true
+      provision_vm_config_agent_enabled = true
+      time_zone                         = "UTC"
+    }
+  }
+
+  storage_profile {
+    image_id      = azurerm_stack_hci_marketplace_gallery_image.test.id
+    data_disk_ids = [azurerm_stack_hci_virtual_hard_disk.test.id]
+  }
+}
+`, template, os.Getenv(customLocationIdEnv))
+}
+
+func (r StackHCIVirtualMachineInstanceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_stack_hci_network_interface" "test" {
+  name                = "acctest-ni-${var.random_string}"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = azurerm_stack_hci_logical_network.test.custom_location_id
+
+  ip_configuration {
+    subnet_id = azurerm_stack_hci_logical_network.test.id
+  }
+}
+
+resource "azurerm_stack_hci_marketplace_gallery_image" "test" {
+  name                = "acctest-mgi-${var.random_string}"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %[2]q
+  hyperv_generation   = "V2"
+  os_type             = "Windows"
+  version             = "20348.2655.240810"
+
+  identifier {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2022-datacenter-azure-edition-core"
+  }
+}
+
+resource "azurerm_arc_machine" "test" {
+  name                = "acctest-hcm-${var.random_string}"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  kind                = "HCI"
+}
+`, StackHCINetworkInterfaceResource{}.template(data), os.Getenv(customLocationIdEnv))
+}
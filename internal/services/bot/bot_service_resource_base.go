@@ -309,7 +309,7 @@ func (br botBaseResource) updateFunc() sdk.ResourceFunc {
 				existing.Properties.IconURL = utils.String(metadata.ResourceData.Get("icon_url").(string))
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				existing.Tags = tags.Expand(metadata.ResourceData.Get("tags").(map[string]interface{}))
 			}
 
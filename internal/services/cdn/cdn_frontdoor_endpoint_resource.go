@@ -164,7 +164,7 @@ func resourceCdnFrontDoorEndpointUpdate(d *pluginsdk.ResourceData, meta interfac
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		props.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -12,11 +12,11 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/frontdoor/mgmt/2020-11-01/frontdoor" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -517,7 +517,7 @@ func resourceCdnFrontDoorFirewallPolicyCreate(d *pluginsdk.ResourceData, meta in
 			},
 			CustomRules: expandCdnFrontDoorFirewallCustomRules(customRules),
 		},
-		Tags: expandFrontDoorTags(tags.Expand(t)),
+		Tags: expandFrontDoorTags(tags.ExpandPointer(t)),
 	}
 
 	if managedRules != nil {
@@ -621,9 +621,9 @@ func resourceCdnFrontDoorFirewallPolicyUpdate(d *pluginsdk.ResourceData, meta in
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		t := d.Get("tags").(map[string]interface{})
-		existing.Tags = expandFrontDoorTags(tags.Expand(t))
+		existing.Tags = expandFrontDoorTags(tags.ExpandPointer(t))
 	}
 
 	existing.WebApplicationFirewallPolicyProperties = &props
@@ -690,7 +690,7 @@ func resourceCdnFrontDoorFirewallPolicyRead(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
-	if err := tags.FlattenAndSet(d, flattenFrontDoorTags(resp.Tags)); err != nil {
+	if err := tags.FlattenPointerAndSet(d, flattenFrontDoorTags(resp.Tags)); err != nil {
 		return err
 	}
 
@@ -126,7 +126,7 @@ func resourceCdnProfileUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	if !d.HasChange("tags") {
+	if !d.HasChanges("tags", "tags_all") {
 		return nil
 	}
 
@@ -23,7 +23,7 @@ var _ sdk.Resource = ChaosStudioCapabilityResource{}
 type ChaosStudioCapabilityResource struct{}
 
 func (r ChaosStudioCapabilityResource) ModelObject() interface{} {
-	return &ChaosStudioTargetResourceSchema{}
+	return &ChaosStudioCapabilityResourceSchema{}
 }
 
 type ChaosStudioCapabilityResourceSchema struct {
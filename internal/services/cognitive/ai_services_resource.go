@@ -519,7 +519,7 @@ func (AzureAIServicesResource) Update() sdk.ResourceFunc {
 				props.Properties.DisableLocalAuth = pointer.To(!model.LocalAuthorizationEnabled)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				props.Tags = pointer.To(model.Tags)
 			}
 
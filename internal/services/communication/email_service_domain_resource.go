@@ -200,7 +200,7 @@ func (r EmailCommunicationServiceDomainResource) Update() sdk.ResourceFunc {
 				props.UserEngagementTracking = pointer.To(userEngagementTracking)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				domain.Tags = pointer.To(model.Tags)
 			}
 
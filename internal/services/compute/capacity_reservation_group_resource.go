@@ -11,13 +11,13 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/capacityreservationgroups"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -80,7 +80,7 @@ func resourceCapacityReservationGroupCreate(d *pluginsdk.ResourceData, meta inte
 
 	parameters := capacityreservationgroups.CapacityReservationGroup{
 		Location: location.Normalize(d.Get("location").(string)),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.ExpandPointer(d.Get("tags").(map[string]interface{})),
 	}
 
 	zones := zones.ExpandUntyped(d.Get("zones").(*schema.Set).List())
@@ -122,7 +122,7 @@ func resourceCapacityReservationGroupRead(d *pluginsdk.ResourceData, meta interf
 	if model := resp.Model; model != nil {
 		d.Set("location", location.Normalize(model.Location))
 		d.Set("zones", utils.FlattenStringSlice(model.Zones))
-		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+		if err := tags.FlattenPointerAndSet(d, model.Tags); err != nil {
 			return fmt.Errorf("setting `tags`: %+v", err)
 		}
 	}
@@ -142,8 +142,8 @@ func resourceCapacityReservationGroupUpdate(d *pluginsdk.ResourceData, meta inte
 
 	parameters := capacityreservationgroups.CapacityReservationGroupUpdate{}
 
-	if d.HasChange("tags") {
-		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		parameters.Tags = tags.ExpandPointer(d.Get("tags").(map[string]interface{}))
 	}
 
 	if _, err := client.Update(ctx, *id, parameters); err != nil {
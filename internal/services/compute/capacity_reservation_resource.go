@@ -12,12 +12,12 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/capacityreservationgroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/capacityreservations"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -121,7 +121,7 @@ func resourceCapacityReservationCreate(d *pluginsdk.ResourceData, meta interface
 	payload := capacityreservations.CapacityReservation{
 		Location: location.Normalize(capacityReservationGroup.Model.Location),
 		Sku:      expandCapacityReservationSku(d.Get("sku").([]interface{})),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.ExpandPointer(d.Get("tags").(map[string]interface{})),
 	}
 	if v, ok := d.GetOk("zone"); ok {
 		payload.Zones = &[]string{
@@ -173,7 +173,7 @@ func resourceCapacityReservationRead(d *pluginsdk.ResourceData, meta interface{}
 		}
 		d.Set("zone", zone)
 
-		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+		if err := tags.FlattenPointerAndSet(d, model.Tags); err != nil {
 			return fmt.Errorf("setting `tags`: %+v", err)
 		}
 	}
@@ -195,8 +195,8 @@ func resourceCapacityReservationUpdate(d *pluginsdk.ResourceData, meta interface
 	if d.HasChange("sku") {
 		payload.Sku = pointer.To(expandCapacityReservationSku(d.Get("sku").([]interface{})))
 	}
-	if d.HasChange("tags") {
-		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		payload.Tags = tags.ExpandPointer(d.Get("tags").(map[string]interface{}))
 	}
 
 	if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
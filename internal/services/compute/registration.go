@@ -83,6 +83,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		OrchestratedVirtualMachineScaleSetDataSource{},
+		sdk.NewListDataSource(VirtualMachinesDataSource{}),
 	}
 }
 
@@ -12,11 +12,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/sshpublickeys"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -92,7 +92,7 @@ func resourceSshPublicKeyCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		Properties: &sshpublickeys.SshPublicKeyResourceProperties{
 			PublicKey: utils.String(d.Get("public_key").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandPointer(d.Get("tags").(map[string]interface{})),
 	}
 
 	if _, err := client.Create(ctx, id, payload); err != nil {
@@ -135,7 +135,7 @@ func resourceSshPublicKeyRead(d *pluginsdk.ResourceData, meta interface{}) error
 			d.Set("public_key", props.PublicKey)
 		}
 
-		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+		if err := tags.FlattenPointerAndSet(d, model.Tags); err != nil {
 			return err
 		}
 	}
@@ -164,9 +164,9 @@ func resourceSshPublicKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 			PublicKey: utils.String(d.Get("public_key").(string)),
 		}
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		tagsRaw := d.Get("tags").(map[string]interface{})
-		payload.Tags = tags.Expand(tagsRaw)
+		payload.Tags = tags.ExpandPointer(tagsRaw)
 	}
 
 	if _, err := client.Update(ctx, *id, payload); err != nil {
@@ -13,9 +13,9 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/restorepointcollections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -107,7 +107,7 @@ func (r VirtualMachineRestorePointCollectionResource) Create() sdk.ResourceFunc
 						Id: pointer.To(config.SourceVirtualMachineId),
 					},
 				},
-				Tags: tags.Expand(config.Tags),
+				Tags: tags.ExpandPointer(config.Tags),
 			}
 
 			if _, err = client.CreateOrUpdate(ctx, id, parameters); err != nil {
@@ -152,7 +152,7 @@ func (r VirtualMachineRestorePointCollectionResource) Read() sdk.ResourceFunc {
 					}
 				}
 
-				schema.Tags = tags.Flatten(model.Tags)
+				schema.Tags = tags.FlattenPointer(model.Tags)
 			}
 
 			return metadata.Encode(&schema)
@@ -187,8 +187,8 @@ func (r VirtualMachineRestorePointCollectionResource) Update() sdk.ResourceFunc
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				payload.Tags = tags.Expand(config.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				payload.Tags = tags.ExpandPointer(config.Tags)
 			}
 
 			if _, err = client.CreateOrUpdate(ctx, *id, payload); err != nil {
@@ -14,11 +14,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-03-01/virtualmachineruncommands"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -381,7 +381,7 @@ func (r VirtualMachineRunCommandResource) Create() sdk.ResourceFunc {
 
 			payload := virtualmachineruncommands.VirtualMachineRunCommand{
 				Location: location.Normalize(config.Location),
-				Tags:     tags.Expand(config.Tags),
+				Tags:     tags.ExpandPointer(config.Tags),
 				Properties: &virtualmachineruncommands.VirtualMachineRunCommandProperties{
 					ErrorBlobManagedIdentity:  expandVirtualMachineRunCommandBlobManagedIdentity(config.ErrorBlobManagedIdentity),
 					ErrorBlobUri:              pointer.To(config.ErrorBlobUri),
@@ -458,7 +458,7 @@ func (r VirtualMachineRunCommandResource) Read() sdk.ResourceFunc {
 
 			if model := resp.Model; model != nil {
 				schema.Location = model.Location
-				schema.Tags = tags.Flatten(model.Tags)
+				schema.Tags = tags.FlattenPointer(model.Tags)
 				if prop := model.Properties; prop != nil {
 					schema.Parameter = flattenVirtualMachineRunCommandInputParameter(prop.Parameters)
 					schema.RunAsUser = pointer.From(prop.RunAsUser)
@@ -572,8 +572,8 @@ func (r VirtualMachineRunCommandResource) Update() sdk.ResourceFunc {
 				payload.Properties.Source = expandVirtualMachineRunCommandSource(config.Source)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				payload.Tags = tags.Expand(config.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				payload.Tags = tags.ExpandPointer(config.Tags)
 			}
 
 			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
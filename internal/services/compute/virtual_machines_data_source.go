@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type VirtualMachinesDataSource struct{}
+
+var _ sdk.ListDataSource = VirtualMachinesDataSource{}
+
+type VirtualMachinesDataSourceModel struct {
+	ResourceGroupName string `tfschema:"resource_group_name"`
+}
+
+type VirtualMachinesDataSourceItemModel struct {
+	Id                string            `tfschema:"id"`
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Location          string            `tfschema:"location"`
+	OsType            string            `tfschema:"os_type"`
+	Size              string            `tfschema:"size"`
+	VirtualMachineId  string            `tfschema:"virtual_machine_id"`
+	Zones             []string          `tfschema:"zones"`
+	Tags              map[string]string `tfschema:"tags"`
+}
+
+func (VirtualMachinesDataSource) ResourceType() string {
+	return "azurerm_virtual_machines"
+}
+
+func (VirtualMachinesDataSource) ItemsAttribute() string {
+	return "virtual_machines"
+}
+
+func (VirtualMachinesDataSource) ItemModelObject() interface{} {
+	return &VirtualMachinesDataSourceItemModel{}
+}
+
+func (VirtualMachinesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"resource_group_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: resourcegroups.ValidateName,
+		},
+	}
+}
+
+func (VirtualMachinesDataSource) ItemAttributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"resource_group_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"location": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"os_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"size": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"virtual_machine_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"zones": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (VirtualMachinesDataSource) List() sdk.ListFunc {
+	return sdk.ListFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData, addPage sdk.ListPageFunc) error {
+			client := metadata.Client.Compute.VirtualMachinesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var state VirtualMachinesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			var items []virtualmachines.VirtualMachine
+			if state.ResourceGroupName != "" {
+				resourceGroupId := commonids.NewResourceGroupID(subscriptionId, state.ResourceGroupName)
+				resp, err := client.ListComplete(ctx, resourceGroupId, virtualmachines.DefaultListOperationOptions())
+				if err != nil {
+					return fmt.Errorf("listing Virtual Machines within %s: %+v", resourceGroupId, err)
+				}
+				items = resp.Items
+			} else {
+				id := commonids.NewSubscriptionID(subscriptionId)
+				resp, err := client.ListAllComplete(ctx, id, virtualmachines.DefaultListAllOperationOptions())
+				if err != nil {
+					return fmt.Errorf("listing Virtual Machines within %s: %+v", id, err)
+				}
+				items = resp.Items
+			}
+
+			page := make([]interface{}, 0, len(items))
+			for _, item := range items {
+				if item.Id == nil {
+					continue
+				}
+
+				id, err := virtualmachines.ParseVirtualMachineIDInsensitively(*item.Id)
+				if err != nil {
+					return err
+				}
+
+				model := VirtualMachinesDataSourceItemModel{
+					Id:                id.ID(),
+					Name:              id.VirtualMachineName,
+					ResourceGroupName: id.ResourceGroupName,
+					Location:          location.Normalize(item.Location),
+					Zones:             pointer.From(item.Zones),
+					Tags:              pointer.From(item.Tags),
+				}
+				if props := item.Properties; props != nil {
+					model.VirtualMachineId = pointer.From(props.VMId)
+					if hardware := props.HardwareProfile; hardware != nil {
+						model.Size = string(pointer.From(hardware.VMSize))
+					}
+					if storage := props.StorageProfile; storage != nil && storage.OsDisk != nil {
+						model.OsType = string(pointer.From(storage.OsDisk.OsType))
+					}
+				}
+				page = append(page, model)
+			}
+
+			return addPage(page...)
+		},
+	}
+}
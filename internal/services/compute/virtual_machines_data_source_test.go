@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type VirtualMachinesDataSource struct{}
+
+func TestAccVirtualMachinesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_machines", "test")
+	r := VirtualMachinesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("virtual_machines.#").HasValue("1"),
+				check.That(data.ResourceName).Key("virtual_machines.0.name").HasValue(fmt.Sprintf("acctestVM-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("virtual_machines.0.os_type").HasValue("Linux"),
+				check.That(data.ResourceName).Key("virtual_machines.0.size").HasValue("Standard_F2"),
+				check.That(data.ResourceName).Key("virtual_machines.0.virtual_machine_id").Exists(),
+			),
+		},
+	})
+}
+
+func (VirtualMachinesDataSource) basic(data acceptance.TestData) string {
+	template := LinuxVirtualMachineResource{}.authPassword(data)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_machines" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  name                = azurerm_linux_virtual_machine.test.name
+}
+`, template)
+}
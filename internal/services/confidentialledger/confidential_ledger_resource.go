@@ -11,11 +11,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/confidentialledger/2022-05-13/confidentialledger"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/confidentialledger/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -160,7 +160,7 @@ func resourceConfidentialLedgerCreate(d *pluginsdk.ResourceData, meta interface{
 			CertBasedSecurityPrincipals: certBasedUsers,
 			LedgerType:                  &ledgerType,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandPointer(d.Get("tags").(map[string]interface{})),
 	}
 
 	if err := client.LedgerCreateThenPoll(ctx, id, parameters); err != nil {
@@ -214,7 +214,7 @@ func resourceConfidentialLedgerRead(d *pluginsdk.ResourceData, meta interface{})
 			d.Set("ledger_endpoint", props.LedgerUri)
 			d.Set("identity_service_endpoint", props.IdentityServiceUri)
 		}
-		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+		if err := tags.FlattenPointerAndSet(d, model.Tags); err != nil {
 			return fmt.Errorf("setting `tags`: %+v", err)
 		}
 	}
@@ -263,8 +263,8 @@ func resourceConfidentialLedgerUpdate(d *pluginsdk.ResourceData, meta interface{
 		ledger.Properties.CertBasedSecurityPrincipals = certBasedUsers
 	}
 
-	if d.HasChange("tags") {
-		ledger.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		ledger.Tags = tags.ExpandPointer(d.Get("tags").(map[string]interface{}))
 	}
 
 	if err := client.LedgerUpdateThenPoll(ctx, *id, ledger); err != nil {
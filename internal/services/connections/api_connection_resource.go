@@ -11,13 +11,13 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2016-06-01/connections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2016-06-01/managedapis"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -136,7 +136,7 @@ func resourceConnectionCreate(d *schema.ResourceData, meta interface{}) error {
 			DisplayName:     utils.String(d.Get("display_name").(string)),
 			ParameterValues: parameterValues,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandPointer(d.Get("tags").(map[string]interface{})),
 	}
 	if v := d.Get("display_name").(string); v != "" {
 		model.Properties.DisplayName = utils.String(v)
@@ -188,7 +188,7 @@ func resourceConnectionRead(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 
-		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+		if err := tags.FlattenPointerAndSet(d, model.Tags); err != nil {
 			return err
 		}
 	}
@@ -214,7 +214,7 @@ func resourceConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
 		// > Status=400 Code="PatchApiConnectionPropertiesNotSupported"
 		// > Message="The request to patch API connection 'acctestconn-220307135205093274' is not supported.
 		// > None of the fields inside the properties object can be patched."
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandPointer(d.Get("tags").(map[string]interface{})),
 	}
 	if _, err := client.Update(ctx, *id, model); err != nil {
 		return fmt.Errorf("updating %s: %+v", *id, err)
@@ -11,10 +11,10 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/certificates"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -129,7 +129,7 @@ func (r ContainerAppEnvironmentCertificateDataSource) Read() sdk.ResourceFunc {
 			cert.ManagedEnvironmentId = envId.ID()
 
 			if model := existing.Model; model != nil {
-				cert.Tags = tags.Flatten(model.Tags)
+				cert.Tags = tags.FlattenPointer(model.Tags)
 
 				if props := model.Properties; props != nil {
 					cert.Issuer = pointer.From(props.Issuer)
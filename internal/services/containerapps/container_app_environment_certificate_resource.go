@@ -11,11 +11,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/certificates"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-03-01/managedenvironments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
@@ -156,7 +156,7 @@ func (r ContainerAppEnvironmentCertificateResource) Create() sdk.ResourceFunc {
 					Password: pointer.To(cert.CertificatePassword),
 					Value:    pointer.To(cert.CertificateBlob),
 				},
-				Tags: tags.Expand(cert.Tags),
+				Tags: tags.ExpandPointer(cert.Tags),
 			}
 
 			if _, err := client.CreateOrUpdate(ctx, id, model); err != nil {
@@ -195,7 +195,7 @@ func (r ContainerAppEnvironmentCertificateResource) Read() sdk.ResourceFunc {
 			state.ManagedEnvironmentId = certificates.NewManagedEnvironmentID(id.SubscriptionId, id.ResourceGroupName, id.ManagedEnvironmentName).ID()
 
 			if model := existing.Model; model != nil {
-				state.Tags = tags.Flatten(model.Tags)
+				state.Tags = tags.FlattenPointer(model.Tags)
 
 				// The Certificate Blob and Password are not retrievable in any way, so grab them back from config if we can. Imports will need `ignore_changes`.
 				if certBlob, ok := metadata.ResourceData.GetOk("certificate_blob_base64"); ok {
@@ -257,9 +257,9 @@ func (r ContainerAppEnvironmentCertificateResource) Update() sdk.ResourceFunc {
 				return err
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				patch := certificates.CertificatePatch{
-					Tags: tags.Expand(cert.Tags),
+					Tags: tags.ExpandPointer(cert.Tags),
 				}
 
 				if _, err = client.Update(ctx, *id, patch); err != nil {
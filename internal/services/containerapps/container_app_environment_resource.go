@@ -14,12 +14,12 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-03-01/managedenvironments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
@@ -53,6 +53,8 @@ var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentResource{}
 
 var _ sdk.ResourceWithCustomizeDiff = ContainerAppEnvironmentResource{}
 
+var _ sdk.ResourceWithAzureResourceType = ContainerAppEnvironmentResource{}
+
 func (r ContainerAppEnvironmentResource) ModelObject() interface{} {
 	return &ContainerAppEnvironmentModel{}
 }
@@ -61,6 +63,10 @@ func (r ContainerAppEnvironmentResource) ResourceType() string {
 	return "azurerm_container_app_environment"
 }
 
+func (r ContainerAppEnvironmentResource) AzureResourceType() string {
+	return "Microsoft.App/managedEnvironments"
+}
+
 func (r ContainerAppEnvironmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return managedenvironments.ValidateManagedEnvironmentID
 }
@@ -238,7 +244,7 @@ func (r ContainerAppEnvironmentResource) Create() sdk.ResourceFunc {
 						},
 					},
 				},
-				Tags: tags.Expand(containerAppEnvironment.Tags),
+				Tags: tags.ExpandPointer(containerAppEnvironment.Tags),
 			}
 
 			if containerAppEnvironment.DaprApplicationInsightsConnectionString != "" {
@@ -327,7 +333,7 @@ func (r ContainerAppEnvironmentResource) Read() sdk.ResourceFunc {
 				state.Name = id.ManagedEnvironmentName
 				state.ResourceGroup = id.ResourceGroupName
 				state.Location = location.Normalize(model.Location)
-				state.Tags = tags.Flatten(model.Tags)
+				state.Tags = tags.FlattenPointer(model.Tags)
 
 				if props := model.Properties; props != nil {
 					if vnet := props.VnetConfiguration; vnet != nil {
@@ -407,8 +413,8 @@ func (r ContainerAppEnvironmentResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("reading %s: %+v", *id, err)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				existing.Model.Tags = tags.Expand(state.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				existing.Model.Tags = tags.ExpandPointer(state.Tags)
 			}
 
 			if metadata.ResourceData.HasChange("workload_profile") {
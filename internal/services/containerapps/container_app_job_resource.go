@@ -14,7 +14,6 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/certificates"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/managedenvironmentsstorages"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-02-02-preview/jobs"
@@ -23,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
@@ -53,6 +53,7 @@ type ContainerAppJobModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = ContainerAppJobResource{}
+var _ sdk.ResourceWithAzureResourceType = ContainerAppJobResource{}
 
 func (r ContainerAppJobResource) ModelObject() interface{} {
 	return &ContainerAppJobModel{}
@@ -62,6 +63,10 @@ func (r ContainerAppJobResource) ResourceType() string {
 	return "azurerm_container_app_job"
 }
 
+func (r ContainerAppJobResource) AzureResourceType() string {
+	return "Microsoft.App/jobs"
+}
+
 func (r ContainerAppJobResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return jobs.ValidateJobID
 }
@@ -295,7 +300,7 @@ func (r ContainerAppJobResource) Create() sdk.ResourceFunc {
 					EnvironmentId: pointer.To(model.ContainerAppEnvironmentId),
 					Template:      helpers.ExpandContainerAppJobTemplate(model.Template),
 				},
-				Tags: tags.Expand(model.Tags),
+				Tags: tags.ExpandPointer(model.Tags),
 			}
 
 			var triggerType jobs.TriggerType
@@ -360,7 +365,7 @@ func (r ContainerAppJobResource) Read() sdk.ResourceFunc {
 
 			if model := existing.Model; model != nil {
 				state.Location = location.Normalize(model.Location)
-				state.Tags = tags.Flatten(model.Tags)
+				state.Tags = tags.FlattenPointer(model.Tags)
 				if model.Identity != nil {
 					if model.Identity != nil {
 						ident, err := identity.FlattenSystemAndUserAssignedMapToModel(pointer.To((identity.SystemAndUserAssignedMap)(*model.Identity)))
@@ -499,8 +504,8 @@ func (r ContainerAppJobResource) Update() sdk.ResourceFunc {
 				model.Properties.WorkloadProfileName = pointer.To(state.WorkloadProfileName)
 			}
 
-			if d.HasChange("tags") {
-				model.Tags = tags.Expand(state.Tags)
+			if d.HasChanges("tags", "tags_all") {
+				model.Tags = tags.ExpandPointer(state.Tags)
 			}
 
 			if !features.FourPointOhBeta() {
@@ -14,12 +14,12 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/containerapps"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-03-01/managedenvironments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
@@ -53,6 +53,8 @@ var _ sdk.ResourceWithUpdate = ContainerAppResource{}
 
 var _ sdk.ResourceWithCustomizeDiff = ContainerAppResource{}
 
+var _ sdk.ResourceWithAzureResourceType = ContainerAppResource{}
+
 func (r ContainerAppResource) ModelObject() interface{} {
 	return &ContainerAppModel{}
 }
@@ -61,6 +63,10 @@ func (r ContainerAppResource) ResourceType() string {
 	return "azurerm_container_app"
 }
 
+func (r ContainerAppResource) AzureResourceType() string {
+	return "Microsoft.App/containerApps"
+}
+
 func (r ContainerAppResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return containerapps.ValidateContainerAppID
 }
@@ -208,7 +214,7 @@ func (r ContainerAppResource) Create() sdk.ResourceFunc {
 					Template:             helpers.ExpandContainerAppTemplate(app.Template, metadata),
 					WorkloadProfileName:  pointer.To(app.WorkloadProfileName),
 				},
-				Tags: tags.Expand(app.Tags),
+				Tags: tags.ExpandPointer(app.Tags),
 			}
 
 			ident, err := identity.ExpandSystemAndUserAssignedMapFromModel(app.Identity)
@@ -256,7 +262,7 @@ func (r ContainerAppResource) Read() sdk.ResourceFunc {
 
 			if model := existing.Model; model != nil {
 				state.Location = location.Normalize(model.Location)
-				state.Tags = tags.Flatten(model.Tags)
+				state.Tags = tags.FlattenPointer(model.Tags)
 				if model.Identity != nil {
 					ident, err := identity.FlattenSystemAndUserAssignedMapToModel(pointer.To(identity.SystemAndUserAssignedMap(*model.Identity)))
 					if err != nil {
@@ -412,8 +418,8 @@ func (r ContainerAppResource) Update() sdk.ResourceFunc {
 				model.Properties.WorkloadProfileName = pointer.To(state.WorkloadProfileName)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				model.Tags = tags.Expand(state.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				model.Tags = tags.ExpandPointer(state.Tags)
 			}
 
 			model.Properties.Template = helpers.ExpandContainerAppTemplate(state.Template, metadata)
@@ -51,6 +51,21 @@ func TestAccContainerRegistryCacheRule_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccContainerRegistryCacheRule_credentialSet(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_cache_rule", "test")
+	r := ContainerRegistryCacheRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.credentialSet(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t ContainerRegistryCacheRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := cacherules.ParseCacheRuleID(state.ID)
 	if err != nil {
@@ -104,3 +119,29 @@ resource "azurerm_container_registry_cache_rule" "import" {
 }
 `, r.basic(data))
 }
+
+func (ContainerRegistryCacheRuleResource) credentialSet(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_access_policy" "credential_set" {
+  key_vault_id = azurerm_key_vault.test.id
+  tenant_id    = azurerm_container_registry_credential_set.test.identity[0].tenant_id
+  object_id    = azurerm_container_registry_credential_set.test.identity[0].principal_id
+
+  secret_permissions = [
+    "Get",
+  ]
+}
+
+resource "azurerm_container_registry_cache_rule" "test" {
+  name                  = "testacc-cr-cache-rule-%d"
+  container_registry_id = azurerm_container_registry.test.id
+  target_repo           = "target"
+  source_repo           = "docker.io/hello-world"
+  credential_set_id     = azurerm_container_registry_credential_set.test.id
+
+  depends_on = [azurerm_key_vault_access_policy.credential_set]
+}
+`, ContainerRegistryCredentialSetResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,310 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-06-01-preview/credentialsets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-06-01-preview/registries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = ContainerRegistryCredentialSetResource{}
+
+type ContainerRegistryCredentialSetResource struct{}
+
+type ContainerRegistryCredentialSetModel struct {
+	Name                      string                                         `tfschema:"name"`
+	ContainerRegistryId       string                                         `tfschema:"container_registry_id"`
+	LoginServer               string                                         `tfschema:"login_server"`
+	AuthenticationCredentials []ContainerRegistryCredentialSetAuthCredential `tfschema:"authentication_credentials"`
+	Identity                  []identity.ModelSystemAssigned                 `tfschema:"identity"`
+}
+
+type ContainerRegistryCredentialSetAuthCredential struct {
+	UsernameSecretId string `tfschema:"username_secret_id"`
+	PasswordSecretId string `tfschema:"password_secret_id"`
+}
+
+func (ContainerRegistryCredentialSetResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerRegistryCredentialSetName,
+		},
+
+		"container_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: registries.ValidateRegistryID,
+		},
+
+		"login_server": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"authentication_credentials": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"username_secret_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: keyVaultValidate.VersionlessNestedItemId,
+					},
+
+					"password_secret_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: keyVaultValidate.VersionlessNestedItemId,
+					},
+				},
+			},
+		},
+
+		"identity": commonschema.SystemAssignedIdentityRequired(),
+	}
+}
+
+func (ContainerRegistryCredentialSetResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (ContainerRegistryCredentialSetResource) ModelObject() interface{} {
+	return &ContainerRegistryCredentialSetModel{}
+}
+
+func (ContainerRegistryCredentialSetResource) ResourceType() string {
+	return "azurerm_container_registry_credential_set"
+}
+
+func (ContainerRegistryCredentialSetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return credentialsets.ValidateCredentialSetID
+}
+
+func (r ContainerRegistryCredentialSetResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2023_06_01_preview.CredentialSets
+
+			var config ContainerRegistryCredentialSetModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registryId, err := registries.ParseRegistryID(config.ContainerRegistryId)
+			if err != nil {
+				return err
+			}
+
+			id := credentialsets.NewCredentialSetID(registryId.SubscriptionId, registryId.ResourceGroupName, registryId.RegistryName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			expandedIdentity, err := expandContainerRegistryCredentialSetIdentity(config.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			parameters := credentialsets.CredentialSet{
+				Name:     pointer.To(id.CredentialSetName),
+				Identity: expandedIdentity,
+				Properties: &credentialsets.CredentialSetProperties{
+					LoginServer:     pointer.To(config.LoginServer),
+					AuthCredentials: expandContainerRegistryCredentialSetAuthCredentials(config.AuthenticationCredentials),
+				},
+			}
+
+			if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (ContainerRegistryCredentialSetResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2023_06_01_preview.CredentialSets
+
+			id, err := credentialsets.ParseCredentialSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerRegistryCredentialSetModel{
+				Name:                id.CredentialSetName,
+				ContainerRegistryId: registries.NewRegistryID(id.SubscriptionId, id.ResourceGroupName, id.RegistryName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Identity = flattenContainerRegistryCredentialSetIdentity(model.Identity)
+
+				if props := model.Properties; props != nil {
+					state.LoginServer = pointer.From(props.LoginServer)
+					state.AuthenticationCredentials = flattenContainerRegistryCredentialSetAuthCredentials(props.AuthCredentials)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (ContainerRegistryCredentialSetResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2023_06_01_preview.CredentialSets
+
+			id, err := credentialsets.ParseCredentialSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ContainerRegistryCredentialSetModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := credentialsets.CredentialSetUpdateParameters{
+				Properties: &credentialsets.CredentialSetUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("authentication_credentials") {
+				parameters.Properties.AuthCredentials = expandContainerRegistryCredentialSetAuthCredentials(config.AuthenticationCredentials)
+			}
+
+			if metadata.ResourceData.HasChange("identity") {
+				expandedIdentity, err := expandContainerRegistryCredentialSetIdentity(config.Identity)
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				parameters.Identity = expandedIdentity
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (ContainerRegistryCredentialSetResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2023_06_01_preview.CredentialSets
+
+			id, err := credentialsets.ParseCredentialSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerRegistryCredentialSetAuthCredentials(input []ContainerRegistryCredentialSetAuthCredential) *[]credentialsets.AuthCredential {
+	output := make([]credentialsets.AuthCredential, 0)
+
+	for _, v := range input {
+		output = append(output, credentialsets.AuthCredential{
+			// the API only supports a single set of credentials, which must be named `Credential1`
+			Name:                     pointer.To(credentialsets.CredentialNameCredentialOne),
+			UsernameSecretIdentifier: pointer.To(v.UsernameSecretId),
+			PasswordSecretIdentifier: pointer.To(v.PasswordSecretId),
+		})
+	}
+
+	return &output
+}
+
+func flattenContainerRegistryCredentialSetAuthCredentials(input *[]credentialsets.AuthCredential) []ContainerRegistryCredentialSetAuthCredential {
+	output := make([]ContainerRegistryCredentialSetAuthCredential, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, ContainerRegistryCredentialSetAuthCredential{
+			UsernameSecretId: pointer.From(v.UsernameSecretIdentifier),
+			PasswordSecretId: pointer.From(v.PasswordSecretIdentifier),
+		})
+	}
+
+	return output
+}
+
+func expandContainerRegistryCredentialSetIdentity(input []identity.ModelSystemAssigned) (*identity.SystemAndUserAssignedMap, error) {
+	expanded, err := identity.ExpandSystemAssignedFromModel(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &identity.SystemAndUserAssignedMap{
+		Type: expanded.Type,
+	}, nil
+}
+
+func flattenContainerRegistryCredentialSetIdentity(input *identity.SystemAndUserAssignedMap) []identity.ModelSystemAssigned {
+	if input == nil {
+		return []identity.ModelSystemAssigned{}
+	}
+
+	return identity.FlattenSystemAssignedToModel(&identity.SystemAssigned{
+		Type:        input.Type,
+		PrincipalId: input.PrincipalId,
+		TenantId:    input.TenantId,
+	})
+}
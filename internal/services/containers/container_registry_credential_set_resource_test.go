@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-06-01-preview/credentialsets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerRegistryCredentialSetResource struct{}
+
+func TestAccContainerRegistryCredentialSet_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_credential_set", "test")
+	r := ContainerRegistryCredentialSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity.0.principal_id").IsUUID(),
+				check.That(data.ResourceName).Key("identity.0.tenant_id").IsUUID(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerRegistryCredentialSet_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_credential_set", "test")
+	r := ContainerRegistryCredentialSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerRegistryCredentialSet_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_credential_set", "test")
+	r := ContainerRegistryCredentialSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ContainerRegistryCredentialSetResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := credentialsets.ParseCredentialSetID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.ContainerRegistryClient_v2023_06_01_preview.CredentialSets.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ContainerRegistryCredentialSetResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_credential_set" "test" {
+  name                  = "acctest-cs-%d"
+  container_registry_id = azurerm_container_registry.test.id
+  login_server          = "docker.io"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  authentication_credentials {
+    username_secret_id = azurerm_key_vault_secret.username.versionless_id
+    password_secret_id = azurerm_key_vault_secret.password.versionless_id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerRegistryCredentialSetResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_secret" "password2" {
+  name         = "password2"
+  value        = "updated-password"
+  key_vault_id = azurerm_key_vault.test.id
+}
+
+resource "azurerm_container_registry_credential_set" "test" {
+  name                  = "acctest-cs-%d"
+  container_registry_id = azurerm_container_registry.test.id
+  login_server          = "docker.io"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  authentication_credentials {
+    username_secret_id = azurerm_key_vault_secret.username.versionless_id
+    password_secret_id = azurerm_key_vault_secret.password2.versionless_id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerRegistryCredentialSetResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_credential_set" "import" {
+  name                  = azurerm_container_registry_credential_set.test.name
+  container_registry_id = azurerm_container_registry_credential_set.test.container_registry_id
+  login_server          = azurerm_container_registry_credential_set.test.login_server
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  authentication_credentials {
+    username_secret_id = azurerm_key_vault_secret.username.versionless_id
+    password_secret_id = azurerm_key_vault_secret.password.versionless_id
+  }
+}
+`, r.basic(data))
+}
+
+func (ContainerRegistryCredentialSetResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-acr-cs-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "testacccr%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Basic"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+
+  access_policy {
+    tenant_id = data.azurerm_client_config.current.tenant_id
+    object_id = data.azurerm_client_config.current.object_id
+    secret_permissions = [
+      "Get", "Set", "Delete", "Purge", "Recover",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_secret" "username" {
+  name         = "username"
+  value        = "testuser"
+  key_vault_id = azurerm_key_vault.test.id
+}
+
+resource "azurerm_key_vault_secret" "password" {
+  name         = "password"
+  value        = "test-password"
+  key_vault_id = azurerm_key_vault.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-06-01-preview/exportpipelines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-06-01-preview/registries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.Resource = ContainerRegistryExportPipelineResource{}
+
+type ContainerRegistryExportPipelineResource struct{}
+
+type ContainerRegistryExportPipelineModel struct {
+	Name                string                                     `tfschema:"name"`
+	ContainerRegistryId string                                     `tfschema:"container_registry_id"`
+	Location            string                                     `tfschema:"location"`
+	StorageContainerUri string                                     `tfschema:"storage_container_uri"`
+	SasTokenSecretId    string                                     `tfschema:"sas_token_secret_id"`
+	Options             []string                                   `tfschema:"options"`
+	Identity            []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+}
+
+// the ARM API only accepts an Azure Storage Blob Container as the target of an Export Pipeline
+const containerRegistryPipelineStorageTypeBlobContainer = "AzureStorageBlobContainer"
+
+func (ContainerRegistryExportPipelineResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerRegistryPipelineName,
+		},
+
+		"container_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: registries.ValidateRegistryID,
+		},
+
+		"location": commonschema.Location(),
+
+		"storage_container_uri": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"sas_token_secret_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: keyVaultValidate.VersionlessNestedItemId,
+		},
+
+		"options": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(exportpipelines.PipelineOptionsContinueOnErrors),
+					string(exportpipelines.PipelineOptionsOverwriteBlobs),
+				}, false),
+			},
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityRequiredForceNew(),
+	}
+}
+
+func (ContainerRegistryExportPipelineResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (ContainerRegistryExportPipelineResource) ModelObject() interface{} {
+	return &ContainerRegistryExportPipelineModel{}
+}
+
+func (ContainerRegistryExportPipelineResource) ResourceType() string {
+	return "azurerm_container_registry_export_pipeline"
+}
+
+func (ContainerRegistryExportPipelineResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return exportpipelines.ValidateExportPipelineID
+}
+
+func (r ContainerRegistryExportPipelineResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2023_06_01_preview.ExportPipelines
+
+			var config ContainerRegistryExportPipelineModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registryId, err := registries.ParseRegistryID(config.ContainerRegistryId)
+			if err != nil {
+				return err
+			}
+
+			id := exportpipelines.NewExportPipelineID(registryId.SubscriptionId, registryId.ResourceGroupName, registryId.RegistryName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			expandedIdentity, err := identity.ExpandSystemAndUserAssignedMapFromModel(config.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			options := make([]exportpipelines.PipelineOptions, 0)
+			for _, v := range config.Options {
+				options = append(options, exportpipelines.PipelineOptions(v))
+			}
+
+			parameters := exportpipelines.ExportPipeline{
+				Location: pointer.To(location.Normalize(config.Location)),
+				Identity: expandedIdentity,
+				Properties: &exportpipelines.ExportPipelineProperties{
+					Options: &options,
+					Target: exportpipelines.ExportPipelineTargetProperties{
+						KeyVaultUri: config.SasTokenSecretId,
+						Type:        pointer.To(containerRegistryPipelineStorageTypeBlobContainer),
+						Uri:         pointer.To(config.StorageContainerUri),
+					},
+				},
+			}
+
+			if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (ContainerRegistryExportPipelineResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2023_06_01_preview.ExportPipelines
+
+			id, err := exportpipelines.ParseExportPipelineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerRegistryExportPipelineModel{
+				Name:                id.ExportPipelineName,
+				ContainerRegistryId: registries.NewRegistryID(id.SubscriptionId, id.ResourceGroupName, id.RegistryName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.NormalizeNilable(model.Location)
+
+				flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMapToModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				state.Identity = pointer.From(flattenedIdentity)
+
+				if props := model.Properties; props != nil {
+					state.StorageContainerUri = pointer.From(props.Target.Uri)
+					state.SasTokenSecretId = props.Target.KeyVaultUri
+
+					options := make([]string, 0)
+					for _, v := range pointer.From(props.Options) {
+						options = append(options, string(v))
+					}
+					state.Options = options
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (ContainerRegistryExportPipelineResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2023_06_01_preview.ExportPipelines
+
+			id, err := exportpipelines.ParseExportPipelineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
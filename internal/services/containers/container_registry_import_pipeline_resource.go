@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-06-01-preview/importpipelines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-06-01-preview/registries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.Resource = ContainerRegistryImportPipelineResource{}
+
+type ContainerRegistryImportPipelineResource struct{}
+
+type ContainerRegistryImportPipelineModel struct {
+	Name                 string                                     `tfschema:"name"`
+	ContainerRegistryId  string                                     `tfschema:"container_registry_id"`
+	Location             string                                     `tfschema:"location"`
+	StorageContainerUri  string                                     `tfschema:"storage_container_uri"`
+	SasTokenSecretId     string                                     `tfschema:"sas_token_secret_id"`
+	Options              []string                                   `tfschema:"options"`
+	SourceTriggerEnabled bool                                       `tfschema:"source_trigger_enabled"`
+	Identity             []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+}
+
+func (ContainerRegistryImportPipelineResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerRegistryPipelineName,
+		},
+
+		"container_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: registries.ValidateRegistryID,
+		},
+
+		"location": commonschema.Location(),
+
+		"storage_container_uri": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"sas_token_secret_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: keyVaultValidate.VersionlessNestedItemId,
+		},
+
+		"options": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(importpipelines.PipelineOptionsContinueOnErrors),
+					string(importpipelines.PipelineOptionsDeleteSourceBlobOnSuccess),
+					string(importpipelines.PipelineOptionsOverwriteTags),
+				}, false),
+			},
+		},
+
+		"source_trigger_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  true,
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityRequiredForceNew(),
+	}
+}
+
+func (ContainerRegistryImportPipelineResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (ContainerRegistryImportPipelineResource) ModelObject() interface{} {
+	return &ContainerRegistryImportPipelineModel{}
+}
+
+func (ContainerRegistryImportPipelineResource) ResourceType() string {
+	return "azurerm_container_registry_import_pipeline"
+}
+
+func (ContainerRegistryImportPipelineResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return importpipelines.ValidateImportPipelineID
+}
+
+func (r ContainerRegistryImportPipelineResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2023_06_01_preview.ImportPipelines
+
+			var config ContainerRegistryImportPipelineModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registryId, err := registries.ParseRegistryID(config.ContainerRegistryId)
+			if err != nil {
+				return err
+			}
+
+			id := importpipelines.NewImportPipelineID(registryId.SubscriptionId, registryId.ResourceGroupName, registryId.RegistryName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			expandedIdentity, err := identity.ExpandSystemAndUserAssignedMapFromModel(config.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			options := make([]importpipelines.PipelineOptions, 0)
+			for _, v := range config.Options {
+				options = append(options, importpipelines.PipelineOptions(v))
+			}
+
+			triggerStatus := importpipelines.TriggerStatusDisabled
+			if config.SourceTriggerEnabled {
+				triggerStatus = importpipelines.TriggerStatusEnabled
+			}
+
+			parameters := importpipelines.ImportPipeline{
+				Location: pointer.To(location.Normalize(config.Location)),
+				Identity: expandedIdentity,
+				Properties: &importpipelines.ImportPipelineProperties{
+					Options: &options,
+					Source: importpipelines.ImportPipelineSourceProperties{
+						KeyVaultUri: config.SasTokenSecretId,
+						Type:        pointer.To(importpipelines.PipelineSourceTypeAzureStorageBlobContainer),
+						Uri:         pointer.To(config.StorageContainerUri),
+					},
+					Trigger: &importpipelines.PipelineTriggerProperties{
+						SourceTrigger: &importpipelines.PipelineSourceTriggerProperties{
+							Status: triggerStatus,
+						},
+					},
+				},
+			}
+
+			if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (ContainerRegistryImportPipelineResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2023_06_01_preview.ImportPipelines
+
+			id, err := importpipelines.ParseImportPipelineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerRegistryImportPipelineModel{
+				Name:                id.ImportPipelineName,
+				ContainerRegistryId: registries.NewRegistryID(id.SubscriptionId, id.ResourceGroupName, id.RegistryName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.NormalizeNilable(model.Location)
+
+				flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMapToModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				state.Identity = pointer.From(flattenedIdentity)
+
+				if props := model.Properties; props != nil {
+					state.StorageContainerUri = pointer.From(props.Source.Uri)
+					state.SasTokenSecretId = props.Source.KeyVaultUri
+
+					options := make([]string, 0)
+					for _, v := range pointer.From(props.Options) {
+						options = append(options, string(v))
+					}
+					state.Options = options
+
+					// the source trigger is enabled by default when it's omitted
+					state.SourceTriggerEnabled = true
+					if trigger := props.Trigger; trigger != nil && trigger.SourceTrigger != nil {
+						state.SourceTriggerEnabled = trigger.SourceTrigger.Status == importpipelines.TriggerStatusEnabled
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (ContainerRegistryImportPipelineResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2023_06_01_preview.ImportPipelines
+
+			id, err := importpipelines.ParseImportPipelineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-06-01-preview/importpipelines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerRegistryImportPipelineResource struct{}
+
+func TestAccContainerRegistryImportPipeline_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_import_pipeline", "test")
+	r := ContainerRegistryImportPipelineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("source_trigger_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerRegistryImportPipeline_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_import_pipeline", "test")
+	r := ContainerRegistryImportPipelineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerRegistryImportPipeline_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_import_pipeline", "test")
+	r := ContainerRegistryImportPipelineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("source_trigger_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ContainerRegistryImportPipelineResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := importpipelines.ParseImportPipelineID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.ContainerRegistryClient_v2023_06_01_preview.ImportPipelines.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (ContainerRegistryImportPipelineResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_import_pipeline" "test" {
+  name                  = "acctestimport%d"
+  container_registry_id = azurerm_container_registry.test.id
+  location              = azurerm_resource_group.test.location
+  storage_container_uri = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}"
+  sas_token_secret_id   = azurerm_key_vault_secret.test.versionless_id
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  depends_on = [azurerm_key_vault_access_policy.identity]
+}
+`, containerRegistryPipelineTemplate(data), data.RandomInteger)
+}
+
+func (ContainerRegistryImportPipelineResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_import_pipeline" "test" {
+  name                   = "acctestimport%d"
+  container_registry_id  = azurerm_container_registry.test.id
+  location               = azurerm_resource_group.test.location
+  storage_container_uri  = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}"
+  sas_token_secret_id    = azurerm_key_vault_secret.test.versionless_id
+  options                = ["ContinueOnErrors", "DeleteSourceBlobOnSuccess", "OverwriteTags"]
+  source_trigger_enabled = false
+
+  identity {
+    type         = "SystemAssigned, UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  depends_on = [azurerm_key_vault_access_policy.identity]
+}
+`, containerRegistryPipelineTemplate(data), data.RandomInteger)
+}
+
+func (r ContainerRegistryImportPipelineResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_import_pipeline" "import" {
+  name                  = azurerm_container_registry_import_pipeline.test.name
+  container_registry_id = azurerm_container_registry_import_pipeline.test.container_registry_id
+  location              = azurerm_container_registry_import_pipeline.test.location
+  storage_container_uri = azurerm_container_registry_import_pipeline.test.storage_container_uri
+  sas_token_secret_id   = azurerm_container_registry_import_pipeline.test.sas_token_secret_id
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+}
+`, r.basic(data))
+}
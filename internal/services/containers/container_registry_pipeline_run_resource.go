@@ -0,0 +1,293 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-06-01-preview/exportpipelines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-06-01-preview/importpipelines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-06-01-preview/pipelineruns"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-06-01-preview/registries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.Resource = ContainerRegistryPipelineRunResource{}
+
+type ContainerRegistryPipelineRunResource struct{}
+
+type ContainerRegistryPipelineRunModel struct {
+	Name                string   `tfschema:"name"`
+	ContainerRegistryId string   `tfschema:"container_registry_id"`
+	PipelineId          string   `tfschema:"pipeline_id"`
+	BlobName            string   `tfschema:"blob_name"`
+	Artifacts           []string `tfschema:"artifacts"`
+	CatalogDigest       string   `tfschema:"catalog_digest"`
+	Status              string   `tfschema:"status"`
+	StartTime           string   `tfschema:"start_time"`
+	FinishTime          string   `tfschema:"finish_time"`
+}
+
+func (ContainerRegistryPipelineRunResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerRegistryPipelineName,
+		},
+
+		"container_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: registries.ValidateRegistryID,
+		},
+
+		"pipeline_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.Any(
+				exportpipelines.ValidateExportPipelineID,
+				importpipelines.ValidateImportPipelineID,
+			),
+		},
+
+		"blob_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"artifacts": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"catalog_digest": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (ContainerRegistryPipelineRunResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"start_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"finish_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (ContainerRegistryPipelineRunResource) ModelObject() interface{} {
+	return &ContainerRegistryPipelineRunModel{}
+}
+
+func (ContainerRegistryPipelineRunResource) ResourceType() string {
+	return "azurerm_container_registry_pipeline_run"
+}
+
+func (ContainerRegistryPipelineRunResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return pipelineruns.ValidatePipelineRunID
+}
+
+func (r ContainerRegistryPipelineRunResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		// the transfer of artifacts can take a while, and the run only completes once it's finished
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2023_06_01_preview.PipelineRuns
+
+			var config ContainerRegistryPipelineRunModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registryId, err := registries.ParseRegistryID(config.ContainerRegistryId)
+			if err != nil {
+				return err
+			}
+
+			id := pipelineruns.NewPipelineRunID(registryId.SubscriptionId, registryId.ResourceGroupName, registryId.RegistryName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			request := pipelineruns.PipelineRunRequest{}
+
+			if len(config.Artifacts) > 0 {
+				request.Artifacts = pointer.To(config.Artifacts)
+			}
+
+			if config.CatalogDigest != "" {
+				request.CatalogDigest = pointer.To(config.CatalogDigest)
+			}
+
+			if exportPipelineId, err := exportpipelines.ParseExportPipelineID(config.PipelineId); err == nil {
+				if len(config.Artifacts) == 0 {
+					return fmt.Errorf("`artifacts` must be specified when `pipeline_id` refers to an Export Pipeline")
+				}
+
+				request.PipelineResourceId = pointer.To(exportPipelineId.ID())
+				request.Target = &pipelineruns.PipelineRunTargetProperties{
+					Name: pointer.To(config.BlobName),
+					Type: pointer.To(pipelineruns.PipelineRunTargetTypeAzureStorageBlob),
+				}
+			} else {
+				importPipelineId, err := importpipelines.ParseImportPipelineID(config.PipelineId)
+				if err != nil {
+					return err
+				}
+
+				request.PipelineResourceId = pointer.To(importPipelineId.ID())
+				request.Source = &pipelineruns.PipelineRunSourceProperties{
+					Name: pointer.To(config.BlobName),
+					Type: pointer.To(pipelineruns.PipelineRunSourceTypeAzureStorageBlob),
+				}
+			}
+
+			parameters := pipelineruns.PipelineRun{
+				Properties: &pipelineruns.PipelineRunProperties{
+					Request: &request,
+				},
+			}
+
+			if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			// the provisioning of the Pipeline Run can succeed whilst the transfer itself has failed
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Response != nil {
+				runResponse := model.Properties.Response
+				if strings.EqualFold(pointer.From(runResponse.Status), "Failed") {
+					return fmt.Errorf("transfer for %s failed: %s", id, pointer.From(runResponse.PipelineRunErrorMessage))
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (ContainerRegistryPipelineRunResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2023_06_01_preview.PipelineRuns
+
+			id, err := pipelineruns.ParsePipelineRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerRegistryPipelineRunModel{
+				Name:                id.PipelineRunName,
+				ContainerRegistryId: registries.NewRegistryID(id.SubscriptionId, id.ResourceGroupName, id.RegistryName).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				if request := model.Properties.Request; request != nil {
+					state.Artifacts = pointer.From(request.Artifacts)
+					state.CatalogDigest = pointer.From(request.CatalogDigest)
+
+					pipelineId := pointer.From(request.PipelineResourceId)
+					if exportPipelineId, err := exportpipelines.ParseExportPipelineIDInsensitively(pipelineId); err == nil {
+						state.PipelineId = exportPipelineId.ID()
+					} else if importPipelineId, err := importpipelines.ParseImportPipelineIDInsensitively(pipelineId); err == nil {
+						state.PipelineId = importPipelineId.ID()
+					} else {
+						return fmt.Errorf("parsing `pipelineResourceId` %q for %s", pipelineId, *id)
+					}
+
+					if target := request.Target; target != nil {
+						state.BlobName = pointer.From(target.Name)
+					}
+					if source := request.Source; source != nil {
+						state.BlobName = pointer.From(source.Name)
+					}
+				}
+
+				if runResponse := model.Properties.Response; runResponse != nil {
+					state.Status = pointer.From(runResponse.Status)
+					state.StartTime = pointer.From(runResponse.StartTime)
+					state.FinishTime = pointer.From(runResponse.FinishTime)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (ContainerRegistryPipelineRunResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2023_06_01_preview.PipelineRuns
+
+			id, err := pipelineruns.ParsePipelineRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
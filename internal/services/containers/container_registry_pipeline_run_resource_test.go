@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-06-01-preview/pipelineruns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerRegistryPipelineRunResource struct{}
+
+func preCheckContainerRegistryPipelineRun(t *testing.T) {
+	// - ARM_TEST_ACR_PIPELINE_RUN_REGISTRY_ID represents the ID of an existing Premium Container Registry to export from
+	// - ARM_TEST_ACR_PIPELINE_RUN_ARTIFACT represents an artifact within that Container Registry, e.g. `hello-world:latest`
+	variables := []string{
+		"ARM_TEST_ACR_PIPELINE_RUN_REGISTRY_ID",
+		"ARM_TEST_ACR_PIPELINE_RUN_ARTIFACT",
+	}
+
+	for _, variable := range variables {
+		value := os.Getenv(variable)
+		if value == "" {
+			t.Skipf("`%s` must be set for acceptance tests!", variable)
+		}
+	}
+}
+
+func TestAccContainerRegistryPipelineRun_export(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_pipeline_run", "export")
+	r := ContainerRegistryPipelineRunResource{}
+
+	preCheckContainerRegistryPipelineRun(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.export(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Succeeded"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerRegistryPipelineRun_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_pipeline_run", "export")
+	r := ContainerRegistryPipelineRunResource{}
+
+	preCheckContainerRegistryPipelineRun(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.export(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerRegistryPipelineRun_exportThenImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_pipeline_run", "import")
+	r := ContainerRegistryPipelineRunResource{}
+
+	preCheckContainerRegistryPipelineRun(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.exportThenImport(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Succeeded"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ContainerRegistryPipelineRunResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := pipelineruns.ParsePipelineRunID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.ContainerRegistryClient_v2023_06_01_preview.PipelineRuns.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (ContainerRegistryPipelineRunResource) export(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_container_registry_export_pipeline" "test" {
+  name                  = "acctestexport%[2]d"
+  container_registry_id = "%[3]s"
+  location              = azurerm_resource_group.test.location
+  storage_container_uri = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}"
+  sas_token_secret_id   = azurerm_key_vault_secret.test.versionless_id
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  depends_on = [azurerm_key_vault_access_policy.identity]
+}
+
+resource "azurerm_container_registry_pipeline_run" "export" {
+  name                  = "acctestexportrun%[2]d"
+  container_registry_id = azurerm_container_registry_export_pipeline.test.container_registry_id
+  pipeline_id           = azurerm_container_registry_export_pipeline.test.id
+  blob_name             = "acctest-%[2]d"
+  artifacts             = ["%[4]s"]
+}
+`, containerRegistryPipelineTemplate(data), data.RandomInteger, os.Getenv("ARM_TEST_ACR_PIPELINE_RUN_REGISTRY_ID"), os.Getenv("ARM_TEST_ACR_PIPELINE_RUN_ARTIFACT"))
+}
+
+func (r ContainerRegistryPipelineRunResource) exportThenImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_container_registry_import_pipeline" "test" {
+  name                   = "acctestimport%[2]d"
+  container_registry_id  = azurerm_container_registry.test.id
+  location               = azurerm_resource_group.test.location
+  storage_container_uri  = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}"
+  sas_token_secret_id    = azurerm_key_vault_secret.test.versionless_id
+  source_trigger_enabled = false
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  depends_on = [azurerm_key_vault_access_policy.identity]
+}
+
+resource "azurerm_container_registry_pipeline_run" "import" {
+  name                  = "acctestimportrun%[2]d"
+  container_registry_id = azurerm_container_registry.test.id
+  pipeline_id           = azurerm_container_registry_import_pipeline.test.id
+  blob_name             = azurerm_container_registry_pipeline_run.export.blob_name
+}
+`, r.export(data), data.RandomInteger)
+}
+
+func (r ContainerRegistryPipelineRunResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_pipeline_run" "import" {
+  name                  = azurerm_container_registry_pipeline_run.export.name
+  container_registry_id = azurerm_container_registry_pipeline_run.export.container_registry_id
+  pipeline_id           = azurerm_container_registry_pipeline_run.export.pipeline_id
+  blob_name             = azurerm_container_registry_pipeline_run.export.blob_name
+  artifacts             = azurerm_container_registry_pipeline_run.export.artifacts
+}
+`, r.export(data))
+}
@@ -11,14 +11,15 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2024-04-01/fleets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 var _ sdk.Resource = KubernetesFleetManagerResource{}
 var _ sdk.ResourceWithUpdate = KubernetesFleetManagerResource{}
+var _ sdk.ResourceWithAzureResourceType = KubernetesFleetManagerResource{}
 
 type KubernetesFleetManagerResource struct{}
 
@@ -39,6 +40,11 @@ func (r KubernetesFleetManagerResource) IDValidationFunc() pluginsdk.SchemaValid
 func (r KubernetesFleetManagerResource) ResourceType() string {
 	return "azurerm_kubernetes_fleet_manager"
 }
+
+func (r KubernetesFleetManagerResource) AzureResourceType() string {
+	return "Microsoft.ContainerService/fleets"
+}
+
 func (r KubernetesFleetManagerResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"location": commonschema.Location(),
@@ -201,7 +207,7 @@ func (r KubernetesFleetManagerResource) Update() sdk.ResourceFunc {
 
 func (r KubernetesFleetManagerResource) mapKubernetesFleetManagerResourceSchemaToFleet(input KubernetesFleetManagerResourceSchema, output *fleets.Fleet) {
 	output.Location = location.Normalize(input.Location)
-	output.Tags = tags.Expand(input.Tags)
+	output.Tags = tags.ExpandPointer(input.Tags)
 
 	if output.Properties == nil {
 		output.Properties = &fleets.FleetProperties{}
@@ -210,7 +216,7 @@ func (r KubernetesFleetManagerResource) mapKubernetesFleetManagerResourceSchemaT
 
 func (r KubernetesFleetManagerResource) mapFleetToKubernetesFleetManagerResourceSchema(input fleets.Fleet, output *KubernetesFleetManagerResourceSchema) {
 	output.Location = location.Normalize(input.Location)
-	output.Tags = tags.Flatten(input.Tags)
+	output.Tags = tags.FlattenPointer(input.Tags)
 
 	if input.Properties == nil {
 		input.Properties = &fleets.FleetProperties{}
@@ -70,6 +70,10 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	resources := []sdk.Resource{
 		ContainerRegistryCacheRule{},
+		ContainerRegistryCredentialSetResource{},
+		ContainerRegistryExportPipelineResource{},
+		ContainerRegistryImportPipelineResource{},
+		ContainerRegistryPipelineRunResource{},
 		ContainerRegistryTaskResource{},
 		ContainerRegistryTaskScheduleResource{},
 		ContainerRegistryTokenPasswordResource{},
@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func ContainerRegistryCredentialSetName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9]+(-[a-zA-Z0-9]+)*$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"alpha numeric characters optionally separated by '-' only are allowed in %q: %q", k, value))
+	}
+
+	if len(value) < 5 {
+		errors = append(errors, fmt.Errorf("%q cannot be less than 5 characters: %q", k, value))
+	}
+
+	if len(value) > 50 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 50 characters: %q", k, value))
+	}
+
+	return warnings, errors
+}
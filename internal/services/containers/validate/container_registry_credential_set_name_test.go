@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+)
+
+func TestContainerRegistryCredentialSetName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "four",
+			ErrCount: 1,
+		},
+		{
+			Value:    "5five",
+			ErrCount: 0,
+		},
+		{
+			Value:    "docker-hub",
+			ErrCount: 0,
+		},
+		{
+			Value:    "-docker-hub",
+			ErrCount: 1,
+		},
+		{
+			Value:    "docker-hub-",
+			ErrCount: 1,
+		},
+		{
+			Value:    "docker_hub",
+			ErrCount: 1,
+		},
+		{
+			Value:    strings.Repeat("a", 50),
+			ErrCount: 0,
+		},
+		{
+			Value:    strings.Repeat("a", 51),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validate.ContainerRegistryCredentialSetName(tc.Value, "name")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("expected %d errors for %q but got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
@@ -527,7 +527,7 @@ func (r CosmosDbPostgreSQLClusterResource) Update() sdk.ResourceFunc {
 				parameters.Properties.PostgresqlVersion = &model.SqlVersion
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				parameters.Tags = &model.Tags
 			}
 
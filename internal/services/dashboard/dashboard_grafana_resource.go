@@ -374,7 +374,7 @@ func (r DashboardGrafanaResource) Update() sdk.ResourceFunc {
 				properties.Properties.PublicNetworkAccess = &publicNetworkAccess
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				properties.Tags = &model.Tags
 			}
 
@@ -23,6 +23,7 @@ type AccessConnectorResource struct {
 }
 
 var _ sdk.ResourceWithUpdate = AccessConnectorResource{}
+var _ sdk.ResourceWithAzureResourceType = AccessConnectorResource{}
 
 type AccessConnectorResourceModel struct {
 	Name          string            `tfschema:"name"`
@@ -62,6 +63,10 @@ func (r AccessConnectorResource) ResourceType() string {
 	return "azurerm_databricks_access_connector"
 }
 
+func (r AccessConnectorResource) AzureResourceType() string {
+	return "Microsoft.Databricks/accessConnectors"
+}
+
 func (r AccessConnectorResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return accessconnector.ValidateAccessConnectorID
 }
@@ -140,7 +145,7 @@ func (r AccessConnectorResource) Update() sdk.ResourceFunc {
 				existing.Model.Identity = identityValue
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				existing.Model.Tags = &state.Tags
 			}
 
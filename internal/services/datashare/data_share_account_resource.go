@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	helperTags "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/account"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -90,7 +89,7 @@ func resourceDataShareAccountCreate(d *pluginsdk.ResourceData, meta interface{})
 		Name:     utils.String(id.AccountName),
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
 		Identity: *expandedIdentity,
-		Tags:     helperTags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.ExpandPointer(d.Get("tags").(map[string]interface{})),
 	}
 
 	if err := client.CreateThenPoll(ctx, id, account); err != nil {
@@ -129,7 +128,7 @@ func resourceDataShareAccountRead(d *pluginsdk.ResourceData, meta interface{}) e
 		if err := d.Set("identity", identity.FlattenSystemAssigned(&model.Identity)); err != nil {
 			return fmt.Errorf("setting `identity`: %+v", err)
 		}
-		return helperTags.FlattenAndSet(d, model.Tags)
+		return tags.FlattenPointerAndSet(d, model.Tags)
 	}
 	return nil
 }
@@ -146,8 +145,8 @@ func resourceDataShareAccountUpdate(d *pluginsdk.ResourceData, meta interface{})
 
 	props := account.AccountUpdateParameters{}
 
-	if d.HasChange("tags") {
-		props.Tags = helperTags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		props.Tags = tags.ExpandPointer(d.Get("tags").(map[string]interface{}))
 	}
 
 	if _, err = client.Update(ctx, *id, props); err != nil {
@@ -14,11 +14,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2022-02-10-preview/hostpool"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2022-02-10-preview/scalingplan"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -277,7 +277,7 @@ func resourceVirtualDesktopScalingPlanCreate(d *pluginsdk.ResourceData, meta int
 	payload := scalingplan.ScalingPlan{
 		Name:     utils.String(d.Get("name").(string)),
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandPointer(t),
 		Properties: &scalingplan.ScalingPlanProperties{
 			Description:        utils.String(d.Get("description").(string)),
 			FriendlyName:       utils.String(d.Get("friendly_name").(string)),
@@ -313,7 +313,7 @@ func resourceVirtualDesktopScalingPlanUpdate(d *pluginsdk.ResourceData, meta int
 	t := d.Get("tags").(map[string]interface{})
 
 	payload := scalingplan.ScalingPlanPatch{
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandPointer(t),
 		Properties: &scalingplan.ScalingPlanPatchProperties{
 			Description:        utils.String(d.Get("description").(string)),
 			FriendlyName:       utils.String(d.Get("friendly_name").(string)),
@@ -366,7 +366,7 @@ func resourceVirtualDesktopScalingPlanRead(d *pluginsdk.ResourceData, meta inter
 			d.Set("host_pool", flattenScalingHostpoolReference(props.HostPoolReferences))
 		}
 
-		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+		if err := tags.FlattenPointerAndSet(d, model.Tags); err != nil {
 			return err
 		}
 	}
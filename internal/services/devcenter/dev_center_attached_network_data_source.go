@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/attachednetworkconnections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/networkconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.DataSource = DevCenterAttachedNetworkDataSource{}
+
+type DevCenterAttachedNetworkDataSource struct{}
+
+type DevCenterAttachedNetworkDataSourceModel struct {
+	Name                string `tfschema:"name"`
+	DevCenterId         string `tfschema:"dev_center_id"`
+	NetworkConnectionId string `tfschema:"network_connection_id"`
+}
+
+func (DevCenterAttachedNetworkDataSource) ResourceType() string {
+	return "azurerm_dev_center_attached_network"
+}
+
+func (DevCenterAttachedNetworkDataSource) ModelObject() interface{} {
+	return &DevCenterAttachedNetworkDataSourceModel{}
+}
+
+func (DevCenterAttachedNetworkDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.DevCenterAttachedNetworkName,
+		},
+
+		"dev_center_id": commonschema.ResourceIDReferenceRequired(&attachednetworkconnections.DevCenterId{}),
+	}
+}
+
+func (DevCenterAttachedNetworkDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_connection_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r DevCenterAttachedNetworkDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := sdkhacks.NewAttachedNetworkConnectionsClient(metadata.Client.DevCenter.V20230401.AttachedNetworkConnections)
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var state DevCenterAttachedNetworkDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			devCenterId, err := attachednetworkconnections.ParseDevCenterID(state.DevCenterId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewDevCenterAttachedNetworkID(subscriptionId, devCenterId.ResourceGroupName, devCenterId.DevCenterName, state.Name)
+
+			resp, err := client.AttachedNetworksGet(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					networkConnectionId, err := networkconnections.ParseNetworkConnectionIDInsensitively(props.NetworkConnectionId)
+					if err != nil {
+						return err
+					}
+					state.NetworkConnectionId = networkConnectionId.ID()
+				}
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type DevCenterAttachedNetworkDataSource struct{}
+
+func TestAccDevCenterAttachedNetworkDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dev_center_attached_network", "test")
+	d := DevCenterAttachedNetworkDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("network_connection_id").Exists(),
+			),
+		},
+	})
+}
+
+func (d DevCenterAttachedNetworkDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_dev_center_attached_network" "test" {
+  name          = azurerm_dev_center_attached_network.test.name
+  dev_center_id = azurerm_dev_center_attached_network.test.dev_center_id
+}
+`, DevCenterAttachedNetworkTestResource{}.basic(data))
+}
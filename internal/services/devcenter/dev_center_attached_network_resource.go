@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/attachednetworkconnections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/networkconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.Resource = DevCenterAttachedNetworkResource{}
+
+type DevCenterAttachedNetworkResource struct{}
+
+func (r DevCenterAttachedNetworkResource) ModelObject() interface{} {
+	return &DevCenterAttachedNetworkResourceModel{}
+}
+
+type DevCenterAttachedNetworkResourceModel struct {
+	Name                string `tfschema:"name"`
+	DevCenterId         string `tfschema:"dev_center_id"`
+	NetworkConnectionId string `tfschema:"network_connection_id"`
+}
+
+func (r DevCenterAttachedNetworkResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.DevCenterAttachedNetworkID
+}
+
+func (r DevCenterAttachedNetworkResource) ResourceType() string {
+	return "azurerm_dev_center_attached_network"
+}
+
+func (r DevCenterAttachedNetworkResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DevCenterAttachedNetworkName,
+		},
+
+		"dev_center_id": commonschema.ResourceIDReferenceRequiredForceNew(&attachednetworkconnections.DevCenterId{}),
+
+		"network_connection_id": commonschema.ResourceIDReferenceRequiredForceNew(&networkconnections.NetworkConnectionId{}),
+	}
+}
+
+func (r DevCenterAttachedNetworkResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DevCenterAttachedNetworkResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := sdkhacks.NewAttachedNetworkConnectionsClient(metadata.Client.DevCenter.V20230401.AttachedNetworkConnections)
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model DevCenterAttachedNetworkResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			devCenterId, err := attachednetworkconnections.ParseDevCenterID(model.DevCenterId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewDevCenterAttachedNetworkID(subscriptionId, devCenterId.ResourceGroupName, devCenterId.DevCenterName, model.Name)
+
+			existing, err := client.AttachedNetworksGet(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := attachednetworkconnections.AttachedNetworkConnection{
+				Properties: &attachednetworkconnections.AttachedNetworkConnectionProperties{
+					NetworkConnectionId: model.NetworkConnectionId,
+				},
+			}
+
+			if err := client.AttachedNetworksCreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevCenterAttachedNetworkResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := sdkhacks.NewAttachedNetworkConnectionsClient(metadata.Client.DevCenter.V20230401.AttachedNetworkConnections)
+
+			id, err := parse.DevCenterAttachedNetworkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.AttachedNetworksGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DevCenterAttachedNetworkResourceModel{
+				Name:        id.AttachedNetworkName,
+				DevCenterId: attachednetworkconnections.NewDevCenterID(id.SubscriptionId, id.ResourceGroup, id.DevCenterName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					networkConnectionId, err := networkconnections.ParseNetworkConnectionIDInsensitively(props.NetworkConnectionId)
+					if err != nil {
+						return err
+					}
+					state.NetworkConnectionId = networkConnectionId.ID()
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DevCenterAttachedNetworkResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := sdkhacks.NewAttachedNetworkConnectionsClient(metadata.Client.DevCenter.V20230401.AttachedNetworkConnections)
+
+			id, err := parse.DevCenterAttachedNetworkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.AttachedNetworksDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -220,7 +220,7 @@ func (r DevCenterDevBoxDefinitionResource) Update() sdk.ResourceFunc {
 				parameters.Properties.Sku = expandDevCenterDevBoxDefinitionSku(model.SkuName)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				parameters.Tags = pointer.To(model.Tags)
 			}
 
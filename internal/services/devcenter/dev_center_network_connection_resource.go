@@ -271,7 +271,7 @@ func (r DevCenterNetworkConnectionResource) Update() sdk.ResourceFunc {
 				parameters.Properties.OrganizationUnit = pointer.To(model.OrganizationUnit)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				parameters.Tags = pointer.To(model.Tags)
 			}
 
@@ -281,7 +281,7 @@ func (r DevCenterProjectEnvironmentTypeResource) Update() sdk.ResourceFunc {
 				payload.Properties.UserRoleAssignments = userRoleAssignment
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				payload.Tags = pointer.To(model.Tags)
 			}
 
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type DevCenterProjectPoolDataSource struct{}
+
+func TestAccDevCenterProjectPoolDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dev_center_project_pool", "test")
+	d := DevCenterProjectPoolDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("location").Exists(),
+				check.That(data.ResourceName).Key("dev_box_definition_name").Exists(),
+				check.That(data.ResourceName).Key("dev_center_attached_network_name").Exists(),
+				check.That(data.ResourceName).Key("local_administrator_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("stop_on_disconnect_grace_period_minutes").HasValue("60"),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (d DevCenterProjectPoolDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_dev_center_project_pool" "test" {
+  name                  = azurerm_dev_center_project_pool.test.name
+  dev_center_project_id = azurerm_dev_center_project_pool.test.dev_center_project_id
+}
+`, DevCenterProjectPoolTestResource{}.complete(data))
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/schedules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.DataSource = DevCenterProjectPoolScheduleDataSource{}
+
+type DevCenterProjectPoolScheduleDataSource struct{}
+
+type DevCenterProjectPoolScheduleDataSourceModel struct {
+	Name                   string `tfschema:"name"`
+	DevCenterProjectPoolId string `tfschema:"dev_center_project_pool_id"`
+	State                  string `tfschema:"state"`
+	Time                   string `tfschema:"time"`
+	TimeZone               string `tfschema:"time_zone"`
+}
+
+func (DevCenterProjectPoolScheduleDataSource) ResourceType() string {
+	return "azurerm_dev_center_project_pool_schedule"
+}
+
+func (DevCenterProjectPoolScheduleDataSource) ModelObject() interface{} {
+	return &DevCenterProjectPoolScheduleDataSourceModel{}
+}
+
+func (DevCenterProjectPoolScheduleDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.DevCenterProjectPoolScheduleName,
+		},
+
+		"dev_center_project_pool_id": commonschema.ResourceIDReferenceRequired(&schedules.PoolId{}),
+	}
+}
+
+func (DevCenterProjectPoolScheduleDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"time_zone": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r DevCenterProjectPoolScheduleDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20230401.Schedules
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var state DevCenterProjectPoolScheduleDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			poolId, err := schedules.ParsePoolID(state.DevCenterProjectPoolId)
+			if err != nil {
+				return err
+			}
+
+			id := schedules.NewScheduleID(subscriptionId, poolId.ResourceGroupName, poolId.ProjectName, poolId.PoolName, state.Name)
+
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.State = string(pointer.From(props.State))
+					state.Time = pointer.From(props.Time)
+					state.TimeZone = pointer.From(props.TimeZone)
+				}
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
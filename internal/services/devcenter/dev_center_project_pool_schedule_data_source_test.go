@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type DevCenterProjectPoolScheduleDataSource struct{}
+
+func TestAccDevCenterProjectPoolScheduleDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dev_center_project_pool_schedule", "test")
+	d := DevCenterProjectPoolScheduleDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("state").HasValue("Enabled"),
+				check.That(data.ResourceName).Key("time").HasValue("23:59"),
+				check.That(data.ResourceName).Key("time_zone").HasValue("America/Los_Angeles"),
+			),
+		},
+	})
+}
+
+func (d DevCenterProjectPoolScheduleDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_dev_center_project_pool_schedule" "test" {
+  name                       = azurerm_dev_center_project_pool_schedule.test.name
+  dev_center_project_pool_id = azurerm_dev_center_project_pool_schedule.test.dev_center_project_pool_id
+}
+`, DevCenterProjectPoolScheduleTestResource{}.basic(data))
+}
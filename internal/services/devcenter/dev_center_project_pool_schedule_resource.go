@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/schedules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.Resource = DevCenterProjectPoolScheduleResource{}
+var _ sdk.ResourceWithUpdate = DevCenterProjectPoolScheduleResource{}
+
+type DevCenterProjectPoolScheduleResource struct{}
+
+func (r DevCenterProjectPoolScheduleResource) ModelObject() interface{} {
+	return &DevCenterProjectPoolScheduleResourceModel{}
+}
+
+type DevCenterProjectPoolScheduleResourceModel struct {
+	Name                   string `tfschema:"name"`
+	DevCenterProjectPoolId string `tfschema:"dev_center_project_pool_id"`
+	State                  string `tfschema:"state"`
+	Time                   string `tfschema:"time"`
+	TimeZone               string `tfschema:"time_zone"`
+}
+
+func (r DevCenterProjectPoolScheduleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return schedules.ValidateScheduleID
+}
+
+func (r DevCenterProjectPoolScheduleResource) ResourceType() string {
+	return "azurerm_dev_center_project_pool_schedule"
+}
+
+func (r DevCenterProjectPoolScheduleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DevCenterProjectPoolScheduleName,
+		},
+
+		"dev_center_project_pool_id": commonschema.ResourceIDReferenceRequiredForceNew(&schedules.PoolId{}),
+
+		"time": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d$`), "`time` must be in the format `HH:MM`"),
+		},
+
+		"time_zone": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"state": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(schedules.ScheduleEnableStatusEnabled),
+			ValidateFunc: validation.StringInSlice(schedules.PossibleValuesForScheduleEnableStatus(), false),
+		},
+	}
+}
+
+func (r DevCenterProjectPoolScheduleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DevCenterProjectPoolScheduleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20230401.Schedules
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model DevCenterProjectPoolScheduleResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			poolId, err := schedules.ParsePoolID(model.DevCenterProjectPoolId)
+			if err != nil {
+				return err
+			}
+
+			id := schedules.NewScheduleID(subscriptionId, poolId.ResourceGroupName, poolId.ProjectName, poolId.PoolName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// the API only supports stopping Dev Boxes on a daily schedule at this time
+			parameters := schedules.Schedule{
+				Properties: &schedules.ScheduleProperties{
+					Frequency: pointer.To(schedules.ScheduledFrequencyDaily),
+					State:     pointer.To(schedules.ScheduleEnableStatus(model.State)),
+					Time:      pointer.To(model.Time),
+					TimeZone:  pointer.To(model.TimeZone),
+					Type:      pointer.To(schedules.ScheduledTypeStopDevBox),
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevCenterProjectPoolScheduleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20230401.Schedules
+
+			id, err := schedules.ParseScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DevCenterProjectPoolScheduleResourceModel{
+				Name:                   id.ScheduleName,
+				DevCenterProjectPoolId: schedules.NewPoolID(id.SubscriptionId, id.ResourceGroupName, id.ProjectName, id.PoolName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.State = string(pointer.From(props.State))
+					state.Time = pointer.From(props.Time)
+					state.TimeZone = pointer.From(props.TimeZone)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DevCenterProjectPoolScheduleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20230401.Schedules
+
+			id, err := schedules.ParseScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DevCenterProjectPoolScheduleResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := schedules.ScheduleUpdate{
+				Properties: &schedules.ScheduleUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("state") {
+				parameters.Properties.State = pointer.To(schedules.ScheduleEnableStatus(model.State))
+			}
+
+			if metadata.ResourceData.HasChange("time") {
+				parameters.Properties.Time = pointer.To(model.Time)
+			}
+
+			if metadata.ResourceData.HasChange("time_zone") {
+				parameters.Properties.TimeZone = pointer.To(model.TimeZone)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevCenterProjectPoolScheduleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20230401.Schedules
+
+			id, err := schedules.ParseScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -12,9 +12,9 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/projects"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -200,7 +200,7 @@ func (r DevCenterProjectResource) Update() sdk.ResourceFunc {
 
 func (r DevCenterProjectResource) mapDevCenterProjectResourceSchemaToProject(input DevCenterProjectResourceSchema, output *projects.Project) error {
 	output.Location = location.Normalize(input.Location)
-	output.Tags = tags.Expand(input.Tags)
+	output.Tags = tags.ExpandPointer(input.Tags)
 
 	if output.Properties == nil {
 		output.Properties = &projects.ProjectProperties{}
@@ -214,7 +214,7 @@ func (r DevCenterProjectResource) mapDevCenterProjectResourceSchemaToProject(inp
 
 func (r DevCenterProjectResource) mapProjectToDevCenterProjectResourceSchema(input projects.Project, output *DevCenterProjectResourceSchema) error {
 	output.Location = location.Normalize(input.Location)
-	output.Tags = tags.Flatten(input.Tags)
+	output.Tags = tags.FlattenPointer(input.Tags)
 
 	if input.Properties == nil {
 		input.Properties = &projects.ProjectProperties{}
@@ -243,7 +243,7 @@ func (r DevCenterProjectResource) mapProjectPropertiesToDevCenterProjectResource
 }
 
 func (r DevCenterProjectResource) mapDevCenterProjectResourceSchemaToProjectUpdate(input DevCenterProjectResourceSchema, output *projects.ProjectUpdate) error {
-	output.Tags = tags.Expand(input.Tags)
+	output.Tags = tags.ExpandPointer(input.Tags)
 
 	if output.Properties == nil {
 		output.Properties = &projects.ProjectUpdateProperties{}
@@ -256,7 +256,7 @@ func (r DevCenterProjectResource) mapDevCenterProjectResourceSchemaToProjectUpda
 }
 
 func (r DevCenterProjectResource) mapProjectUpdateToDevCenterProjectResourceSchema(input projects.ProjectUpdate, output *DevCenterProjectResourceSchema) error {
-	output.Tags = tags.Flatten(input.Tags)
+	output.Tags = tags.FlattenPointer(input.Tags)
 
 	if input.Properties == nil {
 		input.Properties = &projects.ProjectUpdateProperties{}
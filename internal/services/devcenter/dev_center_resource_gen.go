@@ -13,9 +13,9 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/devcenters"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -201,7 +201,7 @@ func (r DevCenterResource) mapDevCenterResourceSchemaToDevCenter(input DevCenter
 	output.Identity = identity
 
 	output.Location = location.Normalize(input.Location)
-	output.Tags = tags.Expand(input.Tags)
+	output.Tags = tags.ExpandPointer(input.Tags)
 
 	if output.Properties == nil {
 		output.Properties = &devcenters.DevCenterProperties{}
@@ -222,7 +222,7 @@ func (r DevCenterResource) mapDevCenterToDevCenterResourceSchema(input devcenter
 	output.Identity = *identity
 
 	output.Location = location.Normalize(input.Location)
-	output.Tags = tags.Flatten(input.Tags)
+	output.Tags = tags.FlattenPointer(input.Tags)
 
 	if input.Properties == nil {
 		input.Properties = &devcenters.DevCenterProperties{}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type DevCenterAttachedNetworkId struct {
+	SubscriptionId      string
+	ResourceGroup       string
+	DevCenterName       string
+	AttachedNetworkName string
+}
+
+func NewDevCenterAttachedNetworkID(subscriptionId, resourceGroup, devCenterName, attachedNetworkName string) DevCenterAttachedNetworkId {
+	return DevCenterAttachedNetworkId{
+		SubscriptionId:      subscriptionId,
+		ResourceGroup:       resourceGroup,
+		DevCenterName:       devCenterName,
+		AttachedNetworkName: attachedNetworkName,
+	}
+}
+
+func (id DevCenterAttachedNetworkId) String() string {
+	segments := []string{
+		fmt.Sprintf("Attached Network Name %q", id.AttachedNetworkName),
+		fmt.Sprintf("Dev Center Name %q", id.DevCenterName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Dev Center Attached Network", segmentsStr)
+}
+
+func (id DevCenterAttachedNetworkId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevCenter/devCenters/%s/attachedNetworks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DevCenterName, id.AttachedNetworkName)
+}
+
+// DevCenterAttachedNetworkID parses a DevCenterAttachedNetwork ID into an DevCenterAttachedNetworkId struct
+func DevCenterAttachedNetworkID(input string) (*DevCenterAttachedNetworkId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an DevCenterAttachedNetwork ID: %+v", input, err)
+	}
+
+	resourceId := DevCenterAttachedNetworkId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DevCenterName, err = id.PopSegment("devCenters"); err != nil {
+		return nil, err
+	}
+	if resourceId.AttachedNetworkName, err = id.PopSegment("attachedNetworks"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
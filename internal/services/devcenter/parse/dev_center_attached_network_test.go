@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = DevCenterAttachedNetworkId{}
+
+func TestDevCenterAttachedNetworkIDFormatter(t *testing.T) {
+	actual := NewDevCenterAttachedNetworkID("12345678-1234-9876-4563-123456789012", "resGroup1", "devCenter1", "attachedNetwork1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DevCenter/devCenters/devCenter1/attachedNetworks/attachedNetwork1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestDevCenterAttachedNetworkID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *DevCenterAttachedNetworkId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/",
+			Error: true,
+		},
+
+		{
+			// missing value for ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/",
+			Error: true,
+		},
+
+		{
+			// missing DevCenterName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DevCenter/",
+			Error: true,
+		},
+
+		{
+			// missing value for DevCenterName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DevCenter/devCenters/",
+			Error: true,
+		},
+
+		{
+			// missing AttachedNetworkName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DevCenter/devCenters/devCenter1/",
+			Error: true,
+		},
+
+		{
+			// missing value for AttachedNetworkName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DevCenter/devCenters/devCenter1/attachedNetworks/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DevCenter/devCenters/devCenter1/attachedNetworks/attachedNetwork1",
+			Expected: &DevCenterAttachedNetworkId{
+				SubscriptionId:      "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:       "resGroup1",
+				DevCenterName:       "devCenter1",
+				AttachedNetworkName: "attachedNetwork1",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DEVCENTER/DEVCENTERS/DEVCENTER1/ATTACHEDNETWORKS/ATTACHEDNETWORK1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := DevCenterAttachedNetworkID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.DevCenterName != v.Expected.DevCenterName {
+			t.Fatalf("Expected %q but got %q for DevCenterName", v.Expected.DevCenterName, actual.DevCenterName)
+		}
+		if actual.AttachedNetworkName != v.Expected.AttachedNetworkName {
+			t.Fatalf("Expected %q but got %q for AttachedNetworkName", v.Expected.AttachedNetworkName, actual.AttachedNetworkName)
+		}
+	}
+}
@@ -26,17 +26,25 @@ func (r Registration) WebsiteCategories() []string {
 }
 
 func (r Registration) DataSources() []sdk.DataSource {
-	return r.autoRegistration.DataSources()
+	dataSources := []sdk.DataSource{
+		DevCenterAttachedNetworkDataSource{},
+		DevCenterProjectPoolDataSource{},
+		DevCenterProjectPoolScheduleDataSource{},
+	}
+	return append(dataSources, r.autoRegistration.DataSources()...)
 }
 
 func (r Registration) Resources() []sdk.Resource {
 	resources := []sdk.Resource{
+		DevCenterAttachedNetworkResource{},
 		DevCenterGalleryResource{},
 		DevCenterCatalogsResource{},
 		DevCenterDevBoxDefinitionResource{},
 		DevCenterEnvironmentTypeResource{},
 		DevCenterNetworkConnectionResource{},
 		DevCenterProjectEnvironmentTypeResource{},
+		DevCenterProjectPoolResource{},
+		DevCenterProjectPoolScheduleResource{},
 	}
 	return append(resources, r.autoRegistration.Resources()...)
 }
@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=DevCenterAttachedNetwork -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DevCenter/devCenters/devCenter1/attachedNetworks/attachedNetwork1
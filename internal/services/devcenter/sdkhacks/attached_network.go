@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/attachednetworkconnections"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/parse"
+)
+
+// AttachedNetworkConnectionsClient works around the vendored SDK only exposing the List operations
+// for Attached Networks, whilst the API also supports creating, retrieving and deleting them
+type AttachedNetworkConnectionsClient struct {
+	client *attachednetworkconnections.AttachedNetworkConnectionsClient
+}
+
+func NewAttachedNetworkConnectionsClient(client *attachednetworkconnections.AttachedNetworkConnectionsClient) AttachedNetworkConnectionsClient {
+	return AttachedNetworkConnectionsClient{
+		client: client,
+	}
+}
+
+type AttachedNetworksGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *attachednetworkconnections.AttachedNetworkConnection
+}
+
+func (c AttachedNetworkConnectionsClient) AttachedNetworksGet(ctx context.Context, id parse.DevCenterAttachedNetworkId) (result AttachedNetworksGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.client.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model attachednetworkconnections.AttachedNetworkConnection
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+func (c AttachedNetworkConnectionsClient) AttachedNetworksCreateOrUpdateThenPoll(ctx context.Context, id parse.DevCenterAttachedNetworkId, input attachednetworkconnections.AttachedNetworkConnection) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	return c.executeThenPoll(ctx, opts, &input)
+}
+
+func (c AttachedNetworkConnectionsClient) AttachedNetworksDeleteThenPoll(ctx context.Context, id parse.DevCenterAttachedNetworkId) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodDelete,
+		Path:       id.ID(),
+	}
+
+	return c.executeThenPoll(ctx, opts, nil)
+}
+
+func (c AttachedNetworkConnectionsClient) executeThenPoll(ctx context.Context, opts client.RequestOptions, input *attachednetworkconnections.AttachedNetworkConnection) error {
+	req, err := c.client.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("building request: %+v", err)
+	}
+
+	if input != nil {
+		if err := req.Marshal(input); err != nil {
+			return fmt.Errorf("marshaling request: %+v", err)
+		}
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing %s: %+v", opts.HttpMethod, err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.client.Client)
+	if err != nil {
+		return fmt.Errorf("building poller: %+v", err)
+	}
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after %s: %+v", opts.HttpMethod, err)
+	}
+
+	return nil
+}
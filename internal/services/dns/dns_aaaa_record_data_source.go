@@ -9,9 +9,9 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/set"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -102,7 +102,7 @@ func dataSourceDnsAAAARecordRead(d *pluginsdk.ResourceData, meta interface{}) er
 			}
 			d.Set("target_resource_id", targetResourceId)
 
-			return tags.FlattenAndSet(d, props.Metadata)
+			return tags.FlattenPointerAndSet(d, props.Metadata)
 		}
 	}
 
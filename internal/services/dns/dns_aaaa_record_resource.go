@@ -9,12 +9,12 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/migration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/set"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -132,7 +132,7 @@ func resourceDnsAaaaRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface
 	parameters := recordsets.RecordSet{
 		Name: &name,
 		Properties: &recordsets.RecordSetProperties{
-			Metadata:       tags.Expand(t),
+			Metadata:       tags.ExpandPointer(t),
 			TTL:            &ttl,
 			AAAARecords:    expandAzureRmDnsAaaaRecords(recordsRaw),
 			TargetResource: &recordsets.SubResource{},
@@ -194,7 +194,7 @@ func resourceDnsAaaaRecordRead(d *pluginsdk.ResourceData, meta interface{}) erro
 			}
 			d.Set("target_resource_id", targetResourceId)
 
-			if err := tags.FlattenAndSet(d, props.Metadata); err != nil {
+			if err := tags.FlattenPointerAndSet(d, props.Metadata); err != nil {
 				return err
 			}
 		}
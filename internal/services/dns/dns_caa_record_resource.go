@@ -10,11 +10,11 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/migration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -139,7 +139,7 @@ func resourceDnsCaaRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 	parameters := recordsets.RecordSet{
 		Name: &name,
 		Properties: &recordsets.RecordSetProperties{
-			Metadata:   tags.Expand(t),
+			Metadata:   tags.ExpandPointer(t),
 			TTL:        &ttl,
 			CaaRecords: expandAzureRmDnsCaaRecords(d),
 		},
@@ -185,7 +185,7 @@ func resourceDnsCaaRecordRead(d *pluginsdk.ResourceData, meta interface{}) error
 			if err := d.Set("record", flattenAzureRmDnsCaaRecords(props.CaaRecords)); err != nil {
 				return err
 			}
-			if err := tags.FlattenAndSet(d, props.Metadata); err != nil {
+			if err := tags.FlattenPointerAndSet(d, props.Metadata); err != nil {
 				return err
 			}
 		}
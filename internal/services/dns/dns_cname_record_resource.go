@@ -10,7 +10,6 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/trafficmanager/2022-04-01/endpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -19,6 +18,7 @@ import (
 	cdn "github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/migration"
 	frontdoor "github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
@@ -127,7 +127,7 @@ func resourceDnsCNameRecordCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	parameters := recordsets.RecordSet{
 		Name: &name,
 		Properties: &recordsets.RecordSetProperties{
-			Metadata:       tags.Expand(t),
+			Metadata:       tags.ExpandPointer(t),
 			TTL:            &ttl,
 			CNAMERecord:    &recordsets.CnameRecord{},
 			TargetResource: &recordsets.SubResource{},
@@ -201,7 +201,7 @@ func resourceDnsCNameRecordRead(d *pluginsdk.ResourceData, meta interface{}) err
 			}
 			d.Set("target_resource_id", targetResourceId)
 
-			if err := tags.FlattenAndSet(d, props.Metadata); err != nil {
+			if err := tags.FlattenPointerAndSet(d, props.Metadata); err != nil {
 				return err
 			}
 		}
@@ -253,8 +253,8 @@ func resourceDnsCNameRecordUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		payload.Properties.TTL = pointer.To(int64(d.Get("ttl").(int)))
 	}
 
-	if d.HasChange("tags") {
-		payload.Properties.Metadata = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		payload.Properties.Metadata = tags.ExpandPointer(d.Get("tags").(map[string]interface{}))
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, *id, *payload, recordsets.DefaultCreateOrUpdateOperationOptions()); err != nil {
@@ -11,11 +11,11 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/migration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
@@ -131,7 +131,7 @@ func resourceDnsMxRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 	parameters := recordsets.RecordSet{
 		Name: &name,
 		Properties: &recordsets.RecordSetProperties{
-			Metadata:  tags.Expand(t),
+			Metadata:  tags.ExpandPointer(t),
 			TTL:       &ttl,
 			MXRecords: expandAzureRmDnsMxRecords(d),
 		},
@@ -176,7 +176,7 @@ func resourceDnsMxRecordRead(d *pluginsdk.ResourceData, meta interface{}) error
 			if err := d.Set("record", flattenAzureRmDnsMxRecords(props.MXRecords)); err != nil {
 				return err
 			}
-			if err := tags.FlattenAndSet(d, props.Metadata); err != nil {
+			if err := tags.FlattenPointerAndSet(d, props.Metadata); err != nil {
 				return err
 			}
 		}
@@ -9,11 +9,11 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/migration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -117,7 +117,7 @@ func resourceDnsNsRecordCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 	parameters := recordsets.RecordSet{
 		Name: &name,
 		Properties: &recordsets.RecordSetProperties{
-			Metadata:  tags.Expand(t),
+			Metadata:  tags.ExpandPointer(t),
 			TTL:       &ttl,
 			NSRecords: records,
 		},
@@ -158,9 +158,9 @@ func resourceDnsNsRecordUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		existing.Model.Properties.NSRecords = records
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		t := d.Get("tags").(map[string]interface{})
-		existing.Model.Properties.Metadata = tags.Expand(t)
+		existing.Model.Properties.Metadata = tags.ExpandPointer(t)
 	}
 
 	if d.HasChange("ttl") {
@@ -206,7 +206,7 @@ func resourceDnsNsRecordRead(d *pluginsdk.ResourceData, meta interface{}) error
 				return fmt.Errorf("settings `records`: %+v", err)
 			}
 
-			if err := tags.FlattenAndSet(d, props.Metadata); err != nil {
+			if err := tags.FlattenPointerAndSet(d, props.Metadata); err != nil {
 				return err
 			}
 		}
@@ -11,11 +11,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/migration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
@@ -138,7 +138,7 @@ func resourceDnsSrvRecordCreate(d *pluginsdk.ResourceData, meta interface{}) err
 	parameters := recordsets.RecordSet{
 		Name: &name,
 		Properties: &recordsets.RecordSetProperties{
-			Metadata:   tags.Expand(t),
+			Metadata:   tags.ExpandPointer(t),
 			TTL:        pointer.To(ttl),
 			SRVRecords: expandAzureRmDnsSrvRecords(d),
 		},
@@ -185,7 +185,7 @@ func resourceDnsSrvRecordRead(d *pluginsdk.ResourceData, meta interface{}) error
 			if err := d.Set("record", flattenAzureRmDnsSrvRecords(props.SRVRecords)); err != nil {
 				return err
 			}
-			if err := tags.FlattenAndSet(d, props.Metadata); err != nil {
+			if err := tags.FlattenPointerAndSet(d, props.Metadata); err != nil {
 				return err
 			}
 		}
@@ -227,8 +227,8 @@ func resourceDnsSrvRecordUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 		payload.Properties.TTL = pointer.To(int64(d.Get("ttl").(int)))
 	}
 
-	if d.HasChange("tags") {
-		payload.Properties.Metadata = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		payload.Properties.Metadata = tags.ExpandPointer(d.Get("tags").(map[string]interface{}))
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, *id, payload, recordsets.DefaultCreateOrUpdateOperationOptions()); err != nil {
@@ -12,7 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	helperTags "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -178,7 +179,7 @@ func resourceDnsZoneCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 
 	parameters := zones.Zone{
 		Location: location.Normalize("global"),
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandPointer(t),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, id, parameters, zones.DefaultCreateOrUpdateOperationOptions()); err != nil {
@@ -206,7 +207,7 @@ func resourceDnsZoneCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 		rsParameters := recordsets.RecordSet{
 			Properties: &recordsets.RecordSetProperties{
 				TTL:       utils.Int64(int64(soaRecord["ttl"].(int))),
-				Metadata:  tags.Expand(soaRecord["tags"].(map[string]interface{})),
+				Metadata:  helperTags.Expand(soaRecord["tags"].(map[string]interface{})),
 				SOARecord: inputSOARecord,
 			},
 		}
@@ -273,7 +274,7 @@ func resourceDnsZoneRead(d *pluginsdk.ResourceData, meta interface{}) error {
 			}
 		}
 
-		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+		if err := tags.FlattenPointerAndSet(d, model.Tags); err != nil {
 			return err
 		}
 	}
@@ -326,7 +327,7 @@ func flattenArmDNSZoneSOARecord(input *recordsets.RecordSet) []interface{} {
 
 			metaData := make(map[string]interface{})
 			if props.Metadata != nil {
-				metaData = tags.Flatten(props.Metadata)
+				metaData = helperTags.Flatten(props.Metadata)
 			}
 
 			fqdn := ""
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DnsZonesDataSource struct{}
+
+var _ sdk.ListDataSource = DnsZonesDataSource{}
+
+type DnsZonesDataSourceModel struct {
+	ResourceGroupName string `tfschema:"resource_group_name"`
+}
+
+type DnsZonesDataSourceItemModel struct {
+	Id                    string            `tfschema:"id"`
+	Name                  string            `tfschema:"name"`
+	ResourceGroupName     string            `tfschema:"resource_group_name"`
+	MaxNumberOfRecordSets int64             `tfschema:"max_number_of_record_sets"`
+	NameServers           []string          `tfschema:"name_servers"`
+	NumberOfRecordSets    int64             `tfschema:"number_of_record_sets"`
+	Tags                  map[string]string `tfschema:"tags"`
+}
+
+func (DnsZonesDataSource) ResourceType() string {
+	return "azurerm_dns_zones"
+}
+
+func (DnsZonesDataSource) ItemsAttribute() string {
+	return "dns_zones"
+}
+
+func (DnsZonesDataSource) ItemModelObject() interface{} {
+	return &DnsZonesDataSourceItemModel{}
+}
+
+func (DnsZonesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"resource_group_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: resourcegroups.ValidateName,
+		},
+	}
+}
+
+func (DnsZonesDataSource) ItemAttributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"resource_group_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"max_number_of_record_sets": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"name_servers": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"number_of_record_sets": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (DnsZonesDataSource) List() sdk.ListFunc {
+	return sdk.ListFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData, addPage sdk.ListPageFunc) error {
+			client := metadata.Client.Dns.Zones
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var state DnsZonesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			var items []zones.Zone
+			if state.ResourceGroupName != "" {
+				resourceGroupId := commonids.NewResourceGroupID(subscriptionId, state.ResourceGroupName)
+				resp, err := client.ListByResourceGroupComplete(ctx, resourceGroupId, zones.DefaultListByResourceGroupOperationOptions())
+				if err != nil {
+					return fmt.Errorf("listing DNS Zones within %s: %+v", resourceGroupId, err)
+				}
+				items = resp.Items
+			} else {
+				id := commonids.NewSubscriptionID(subscriptionId)
+				resp, err := client.ListComplete(ctx, id, zones.DefaultListOperationOptions())
+				if err != nil {
+					return fmt.Errorf("listing DNS Zones within %s: %+v", id, err)
+				}
+				items = resp.Items
+			}
+
+			page := make([]interface{}, 0, len(items))
+			for _, item := range items {
+				if item.Id == nil {
+					continue
+				}
+
+				id, err := zones.ParseDnsZoneIDInsensitively(*item.Id)
+				if err != nil {
+					return err
+				}
+
+				model := DnsZonesDataSourceItemModel{
+					Id:                id.ID(),
+					Name:              id.DnsZoneName,
+					ResourceGroupName: id.ResourceGroupName,
+					Tags:              pointer.From(item.Tags),
+				}
+				if props := item.Properties; props != nil {
+					model.MaxNumberOfRecordSets = pointer.From(props.MaxNumberOfRecordSets)
+					model.NameServers = pointer.From(props.NameServers)
+					model.NumberOfRecordSets = pointer.From(props.NumberOfRecordSets)
+				}
+				page = append(page, model)
+			}
+
+			return addPage(page...)
+		},
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type DnsZonesDataSource struct{}
+
+func TestAccDnsZonesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dns_zones", "test")
+	r := DnsZonesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("dns_zones.#").HasValue("2"),
+				check.That(data.ResourceName).Key("dns_zones.0.name").HasValue(fmt.Sprintf("acctestzone%d-a.com", data.RandomInteger)),
+				check.That(data.ResourceName).Key("dns_zones.1.name").HasValue(fmt.Sprintf("acctestzone%d-b.com", data.RandomInteger)),
+				check.That(data.ResourceName).Key("dns_zones.0.name_servers.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDnsZonesDataSource_filtered(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dns_zones", "test")
+	r := DnsZonesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.filtered(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("dns_zones.#").HasValue("1"),
+				check.That(data.ResourceName).Key("dns_zones.0.name").HasValue(fmt.Sprintf("acctestzone%d-b.com", data.RandomInteger)),
+				check.That(data.ResourceName).Key("dns_zones.0.tags.hello").HasValue("world"),
+			),
+		},
+	})
+}
+
+func (DnsZonesDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_dns_zone" "a" {
+  name                = "acctestzone%[1]d-a.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_dns_zone" "b" {
+  name                = "acctestzone%[1]d-b.com"
+  resource_group_name = azurerm_resource_group.test.name
+
+  tags = {
+    hello = "world"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r DnsZonesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_dns_zones" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+
+  depends_on = [azurerm_dns_zone.a, azurerm_dns_zone.b]
+}
+`, r.template(data))
+}
+
+func (r DnsZonesDataSource) filtered(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_dns_zones" "test" {
+  name_regex  = "^acctestzone%d-"
+  max_results = 1
+
+  required_tags = {
+    hello = "world"
+  }
+
+  depends_on = [azurerm_dns_zone.a, azurerm_dns_zone.b]
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -12,19 +12,20 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2023-01-01/elasticsans"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elasticsan/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
 var (
-	_ sdk.Resource                  = ElasticSANResource{}
-	_ sdk.ResourceWithUpdate        = ElasticSANResource{}
-	_ sdk.ResourceWithCustomizeDiff = ElasticSANResource{}
+	_ sdk.Resource                      = ElasticSANResource{}
+	_ sdk.ResourceWithUpdate            = ElasticSANResource{}
+	_ sdk.ResourceWithCustomizeDiff     = ElasticSANResource{}
+	_ sdk.ResourceWithAzureResourceType = ElasticSANResource{}
 )
 
 type ElasticSANResource struct{}
@@ -62,6 +63,10 @@ func (r ElasticSANResource) ResourceType() string {
 	return "azurerm_elastic_san"
 }
 
+func (r ElasticSANResource) AzureResourceType() string {
+	return "Microsoft.ElasticSan/elasticSans"
+}
+
 func (r ElasticSANResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -205,7 +210,7 @@ func (r ElasticSANResource) Create() sdk.ResourceFunc {
 
 			payload := elasticsans.ElasticSan{
 				Location: location.Normalize(config.Location),
-				Tags:     tags.Expand(config.Tags),
+				Tags:     tags.ExpandPointer(config.Tags),
 				Properties: elasticsans.ElasticSanProperties{
 					BaseSizeTiB:             config.BaseSizeInTiB,
 					ExtendedCapacitySizeTiB: config.ExtendedSizeInTiB,
@@ -253,7 +258,7 @@ func (r ElasticSANResource) Read() sdk.ResourceFunc {
 
 			if model := resp.Model; model != nil {
 				state.Location = location.Normalize(model.Location)
-				state.Tags = tags.Flatten(model.Tags)
+				state.Tags = tags.FlattenPointer(model.Tags)
 
 				prop := model.Properties
 				state.Sku = FlattenSku(prop.Sku)
@@ -324,8 +329,8 @@ func (r ElasticSANResource) Update() sdk.ResourceFunc {
 				payload.Properties.ExtendedCapacitySizeTiB = pointer.To(config.ExtendedSizeInTiB)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				payload.Tags = tags.Expand(config.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				payload.Tags = tags.ExpandPointer(config.Tags)
 			}
 
 			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
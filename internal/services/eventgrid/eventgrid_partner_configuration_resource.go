@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15/partnerconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.Resource           = EventGridPartnerConfigurationResource{}
+	_ sdk.ResourceWithUpdate = EventGridPartnerConfigurationResource{}
+)
+
+type EventGridPartnerConfigurationResource struct{}
+
+type EventGridPartnerConfigurationResourceModel struct {
+	ResourceGroupName                  string                                       `tfschema:"resource_group_name"`
+	DefaultMaximumExpirationTimeInDays int64                                        `tfschema:"default_maximum_expiration_time_in_days"`
+	PartnerAuthorization               []EventGridPartnerConfigurationAuthorization `tfschema:"partner_authorization"`
+	Tags                               map[string]interface{}                       `tfschema:"tags"`
+}
+
+type EventGridPartnerConfigurationAuthorization struct {
+	PartnerRegistrationId            string `tfschema:"partner_registration_id"`
+	PartnerName                      string `tfschema:"partner_name"`
+	AuthorizationExpirationTimeInUtc string `tfschema:"authorization_expiration_time_in_utc"`
+}
+
+func (EventGridPartnerConfigurationResource) ResourceType() string {
+	return "azurerm_eventgrid_partner_configuration"
+}
+
+func (EventGridPartnerConfigurationResource) ModelObject() interface{} {
+	return &EventGridPartnerConfigurationResourceModel{}
+}
+
+func (EventGridPartnerConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.PartnerConfigurationID
+}
+
+func (EventGridPartnerConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"default_maximum_expiration_time_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      7,
+			ValidateFunc: validation.IntBetween(1, 365),
+		},
+
+		"partner_authorization": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"partner_registration_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsUUID,
+					},
+
+					"partner_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"authorization_expiration_time_in_utc": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (EventGridPartnerConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridPartnerConfigurationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.PartnerConfigurations
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config EventGridPartnerConfigurationResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// there's a single Partner Configuration per Resource Group, which is always named `default`
+			id := parse.NewPartnerConfigurationID(subscriptionId, config.ResourceGroupName, "default")
+			resourceGroupId := commonids.NewResourceGroupID(id.SubscriptionId, id.ResourceGroup)
+
+			existing, err := client.Get(ctx, resourceGroupId)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, resourceGroupId, expandEventGridPartnerConfiguration(config)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridPartnerConfigurationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.PartnerConfigurations
+
+			id, err := parse.PartnerConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, commonids.NewResourceGroupID(id.SubscriptionId, id.ResourceGroup))
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridPartnerConfigurationResourceModel{
+				ResourceGroupName: id.ResourceGroup,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil && props.PartnerAuthorization != nil {
+					state.DefaultMaximumExpirationTimeInDays = pointer.From(props.PartnerAuthorization.DefaultMaximumExpirationTimeInDays)
+					state.PartnerAuthorization = flattenEventGridPartnerConfigurationPartnerAuthorizations(props.PartnerAuthorization.AuthorizedPartnersList)
+				}
+
+				state.Tags = tags.FlattenPointer(model.Tags)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridPartnerConfigurationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.PartnerConfigurations
+
+			id, err := parse.PartnerConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config EventGridPartnerConfigurationResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the Partner Configuration is small enough that it's sent in full, rather than patched
+			if err := client.CreateOrUpdateThenPoll(ctx, commonids.NewResourceGroupID(id.SubscriptionId, id.ResourceGroup), expandEventGridPartnerConfiguration(config)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridPartnerConfigurationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.PartnerConfigurations
+
+			id, err := parse.PartnerConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, commonids.NewResourceGroupID(id.SubscriptionId, id.ResourceGroup)); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandEventGridPartnerConfiguration(input EventGridPartnerConfigurationResourceModel) partnerconfigurations.PartnerConfiguration {
+	partners := make([]partnerconfigurations.Partner, 0)
+	for _, v := range input.PartnerAuthorization {
+		partner := partnerconfigurations.Partner{
+			PartnerName:                    pointer.To(v.PartnerName),
+			PartnerRegistrationImmutableId: pointer.To(v.PartnerRegistrationId),
+		}
+		if v.AuthorizationExpirationTimeInUtc != "" {
+			partner.AuthorizationExpirationTimeInUtc = pointer.To(v.AuthorizationExpirationTimeInUtc)
+		}
+
+		partners = append(partners, partner)
+	}
+
+	// Partner Configurations are a global resource
+	return partnerconfigurations.PartnerConfiguration{
+		Location: pointer.To("global"),
+		Properties: &partnerconfigurations.PartnerConfigurationProperties{
+			PartnerAuthorization: &partnerconfigurations.PartnerAuthorization{
+				AuthorizedPartnersList:             &partners,
+				DefaultMaximumExpirationTimeInDays: pointer.To(input.DefaultMaximumExpirationTimeInDays),
+			},
+		},
+		Tags: tags.ExpandPointer(input.Tags),
+	}
+}
+
+func flattenEventGridPartnerConfigurationPartnerAuthorizations(input *[]partnerconfigurations.Partner) []EventGridPartnerConfigurationAuthorization {
+	output := make([]EventGridPartnerConfigurationAuthorization, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, partner := range *input {
+		expiration := ""
+		if partner.AuthorizationExpirationTimeInUtc != nil {
+			t, err := partner.GetAuthorizationExpirationTimeInUtcAsTime()
+			if err == nil {
+				expiration = t.Format(time.RFC3339)
+			}
+		}
+
+		output = append(output, EventGridPartnerConfigurationAuthorization{
+			AuthorizationExpirationTimeInUtc: expiration,
+			PartnerName:                      pointer.From(partner.PartnerName),
+			PartnerRegistrationId:            pointer.From(partner.PartnerRegistrationImmutableId),
+		})
+	}
+
+	return output
+}
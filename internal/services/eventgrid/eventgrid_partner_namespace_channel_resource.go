@@ -0,0 +1,420 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15/channels"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.Resource           = EventGridPartnerNamespaceChannelResource{}
+	_ sdk.ResourceWithUpdate = EventGridPartnerNamespaceChannelResource{}
+)
+
+type EventGridPartnerNamespaceChannelResource struct{}
+
+type EventGridPartnerNamespaceChannelResourceModel struct {
+	Name                              string                                         `tfschema:"name"`
+	PartnerNamespaceId                string                                         `tfschema:"partner_namespace_id"`
+	ChannelType                       string                                         `tfschema:"channel_type"`
+	PartnerTopic                      []EventGridPartnerNamespaceChannelPartnerTopic `tfschema:"partner_topic"`
+	ExpirationTimeIfNotActivatedInUtc string                                         `tfschema:"expiration_time_if_not_activated_in_utc"`
+	MessageForActivation              string                                         `tfschema:"message_for_activation"`
+	ReadinessState                    string                                         `tfschema:"readiness_state"`
+}
+
+type EventGridPartnerNamespaceChannelPartnerTopic struct {
+	Name              string                                      `tfschema:"name"`
+	SubscriptionId    string                                      `tfschema:"subscription_id"`
+	ResourceGroupName string                                      `tfschema:"resource_group_name"`
+	Source            string                                      `tfschema:"source"`
+	EventType         []EventGridPartnerNamespaceChannelEventType `tfschema:"event_type"`
+}
+
+type EventGridPartnerNamespaceChannelEventType struct {
+	Name             string `tfschema:"name"`
+	DisplayName      string `tfschema:"display_name"`
+	Description      string `tfschema:"description"`
+	DataSchemaUrl    string `tfschema:"data_schema_url"`
+	DocumentationUrl string `tfschema:"documentation_url"`
+}
+
+func (EventGridPartnerNamespaceChannelResource) ResourceType() string {
+	return "azurerm_eventgrid_partner_namespace_channel"
+}
+
+func (EventGridPartnerNamespaceChannelResource) ModelObject() interface{} {
+	return &EventGridPartnerNamespaceChannelResourceModel{}
+}
+
+func (EventGridPartnerNamespaceChannelResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return channels.ValidateChannelID
+}
+
+func (EventGridPartnerNamespaceChannelResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.All(
+				validation.StringIsNotEmpty,
+				validation.StringMatch(
+					regexp.MustCompile("^[-a-zA-Z0-9]{3,50}$"),
+					"EventGrid Partner Namespace Channel name must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+				),
+			),
+		},
+
+		"partner_namespace_id": commonschema.ResourceIDReferenceRequiredForceNew(&channels.PartnerNamespaceId{}),
+
+		"channel_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(channels.ChannelTypePartnerTopic),
+			ValidateFunc: validation.StringInSlice(channels.PossibleValuesForChannelType(), false),
+		},
+
+		"partner_topic": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"subscription_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsUUID,
+					},
+
+					"resource_group_name": commonschema.ResourceGroupName(),
+
+					"source": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"event_type": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"display_name": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"description": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"data_schema_url": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+								},
+
+								"documentation_url": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"expiration_time_if_not_activated_in_utc": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"message_for_activation": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (EventGridPartnerNamespaceChannelResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"readiness_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r EventGridPartnerNamespaceChannelResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.Channels
+
+			var config EventGridPartnerNamespaceChannelResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			partnerNamespaceId, err := channels.ParsePartnerNamespaceID(config.PartnerNamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := channels.NewChannelID(partnerNamespaceId.SubscriptionId, partnerNamespaceId.ResourceGroupName, partnerNamespaceId.PartnerNamespaceName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := channels.Channel{
+				Properties: &channels.ChannelProperties{
+					ChannelType:      pointer.To(channels.ChannelType(config.ChannelType)),
+					PartnerTopicInfo: expandPartnerNamespaceChannelPartnerTopicInfo(config.PartnerTopic),
+				},
+			}
+
+			if config.ExpirationTimeIfNotActivatedInUtc != "" {
+				payload.Properties.ExpirationTimeIfNotActivatedUtc = pointer.To(config.ExpirationTimeIfNotActivatedInUtc)
+			}
+
+			if config.MessageForActivation != "" {
+				payload.Properties.MessageForActivation = pointer.To(config.MessageForActivation)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridPartnerNamespaceChannelResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.Channels
+
+			id, err := channels.ParseChannelID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridPartnerNamespaceChannelResourceModel{
+				Name:               id.ChannelName,
+				PartnerNamespaceId: channels.NewPartnerNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.PartnerNamespaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ChannelType = string(pointer.From(props.ChannelType))
+					state.MessageForActivation = pointer.From(props.MessageForActivation)
+					state.ReadinessState = string(pointer.From(props.ReadinessState))
+					state.PartnerTopic = flattenPartnerNamespaceChannelPartnerTopicInfo(props.PartnerTopicInfo)
+
+					if props.ExpirationTimeIfNotActivatedUtc != nil {
+						t, err := props.GetExpirationTimeIfNotActivatedUtcAsTime()
+						if err == nil {
+							state.ExpirationTimeIfNotActivatedInUtc = t.Format(time.RFC3339)
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridPartnerNamespaceChannelResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.Channels
+
+			id, err := channels.ParseChannelID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config EventGridPartnerNamespaceChannelResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := channels.ChannelUpdateParameters{
+				Properties: &channels.ChannelUpdateParametersProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("expiration_time_if_not_activated_in_utc") {
+				payload.Properties.ExpirationTimeIfNotActivatedUtc = pointer.To(config.ExpirationTimeIfNotActivatedInUtc)
+			}
+
+			if metadata.ResourceData.HasChange("partner_topic.0.event_type") && len(config.PartnerTopic) > 0 {
+				payload.Properties.PartnerTopicInfo = &channels.PartnerUpdateTopicInfo{
+					EventTypeInfo: expandPartnerNamespaceChannelEventTypeInfo(config.PartnerTopic[0].EventType),
+				}
+			}
+
+			if _, err := client.Update(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridPartnerNamespaceChannelResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.Channels
+
+			id, err := channels.ParseChannelID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandPartnerNamespaceChannelPartnerTopicInfo(input []EventGridPartnerNamespaceChannelPartnerTopic) *channels.PartnerTopicInfo {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	return &channels.PartnerTopicInfo{
+		AzureSubscriptionId: pointer.To(v.SubscriptionId),
+		EventTypeInfo:       expandPartnerNamespaceChannelEventTypeInfo(v.EventType),
+		Name:                pointer.To(v.Name),
+		ResourceGroupName:   pointer.To(v.ResourceGroupName),
+		Source:              pointer.To(v.Source),
+	}
+}
+
+func expandPartnerNamespaceChannelEventTypeInfo(input []EventGridPartnerNamespaceChannelEventType) *channels.EventTypeInfo {
+	if len(input) == 0 {
+		return nil
+	}
+
+	eventTypes := make(map[string]channels.InlineEventProperties)
+	for _, v := range input {
+		properties := channels.InlineEventProperties{}
+		if v.DisplayName != "" {
+			properties.DisplayName = pointer.To(v.DisplayName)
+		}
+		if v.Description != "" {
+			properties.Description = pointer.To(v.Description)
+		}
+		if v.DataSchemaUrl != "" {
+			properties.DataSchemaUrl = pointer.To(v.DataSchemaUrl)
+		}
+		if v.DocumentationUrl != "" {
+			properties.DocumentationUrl = pointer.To(v.DocumentationUrl)
+		}
+
+		eventTypes[v.Name] = properties
+	}
+
+	return &channels.EventTypeInfo{
+		InlineEventTypes: &eventTypes,
+		Kind:             pointer.To(channels.EventDefinitionKindInline),
+	}
+}
+
+func flattenPartnerNamespaceChannelPartnerTopicInfo(input *channels.PartnerTopicInfo) []EventGridPartnerNamespaceChannelPartnerTopic {
+	if input == nil {
+		return []EventGridPartnerNamespaceChannelPartnerTopic{}
+	}
+
+	return []EventGridPartnerNamespaceChannelPartnerTopic{
+		{
+			EventType:         flattenPartnerNamespaceChannelEventTypeInfo(input.EventTypeInfo),
+			Name:              pointer.From(input.Name),
+			ResourceGroupName: pointer.From(input.ResourceGroupName),
+			Source:            pointer.From(input.Source),
+			SubscriptionId:    pointer.From(input.AzureSubscriptionId),
+		},
+	}
+}
+
+func flattenPartnerNamespaceChannelEventTypeInfo(input *channels.EventTypeInfo) []EventGridPartnerNamespaceChannelEventType {
+	output := make([]EventGridPartnerNamespaceChannelEventType, 0)
+	if input == nil || input.InlineEventTypes == nil {
+		return output
+	}
+
+	for name, properties := range *input.InlineEventTypes {
+		output = append(output, EventGridPartnerNamespaceChannelEventType{
+			Name:             name,
+			DisplayName:      pointer.From(properties.DisplayName),
+			Description:      pointer.From(properties.Description),
+			DataSchemaUrl:    pointer.From(properties.DataSchemaUrl),
+			DocumentationUrl: pointer.From(properties.DocumentationUrl),
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15/channels"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type EventGridPartnerNamespaceChannelResource struct{}
+
+func TestAccEventGridPartnerNamespaceChannel_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_partner_namespace_channel", "test")
+	r := EventGridPartnerNamespaceChannelResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("readiness_state").HasValue("NeverActivated"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventGridPartnerNamespaceChannel_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_partner_namespace_channel", "test")
+	r := EventGridPartnerNamespaceChannelResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurerm_eventgrid_partner_namespace_channel"),
+		},
+	})
+}
+
+func TestAccEventGridPartnerNamespaceChannel_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_partner_namespace_channel", "test")
+	r := EventGridPartnerNamespaceChannelResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("partner_topic.0.event_type.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (EventGridPartnerNamespaceChannelResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := channels.ParseChannelID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.EventGrid.Channels.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r EventGridPartnerNamespaceChannelResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_partner_namespace_channel" "test" {
+  name                 = "acctest-egch-%[2]d"
+  partner_namespace_id = azurerm_eventgrid_partner_namespace.test.id
+
+  partner_topic {
+    name                = "acctest-egpt-%[2]d"
+    subscription_id     = data.azurerm_client_config.current.subscription_id
+    resource_group_name = azurerm_resource_group.test.name
+    source              = "acctest-source-%[2]d"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r EventGridPartnerNamespaceChannelResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_partner_namespace_channel" "import" {
+  name                 = azurerm_eventgrid_partner_namespace_channel.test.name
+  partner_namespace_id = azurerm_eventgrid_partner_namespace_channel.test.partner_namespace_id
+
+  partner_topic {
+    name                = azurerm_eventgrid_partner_namespace_channel.test.partner_topic.0.name
+    subscription_id     = azurerm_eventgrid_partner_namespace_channel.test.partner_topic.0.subscription_id
+    resource_group_name = azurerm_eventgrid_partner_namespace_channel.test.partner_topic.0.resource_group_name
+    source              = azurerm_eventgrid_partner_namespace_channel.test.partner_topic.0.source
+  }
+}
+`, r.basic(data))
+}
+
+func (r EventGridPartnerNamespaceChannelResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_partner_namespace_channel" "test" {
+  name                                    = "acctest-egch-%[2]d"
+  partner_namespace_id                    = azurerm_eventgrid_partner_namespace.test.id
+  expiration_time_if_not_activated_in_utc = "2099-01-01T00:00:00Z"
+
+  partner_topic {
+    name                = "acctest-egpt-%[2]d"
+    subscription_id     = data.azurerm_client_config.current.subscription_id
+    resource_group_name = azurerm_resource_group.test.name
+    source              = "acctest-source-%[2]d"
+
+    event_type {
+      name         = "Example.Created"
+      display_name = "Created"
+      description  = "Raised when an example is created."
+    }
+
+    event_type {
+      name              = "Example.Deleted"
+      documentation_url = "https://example.com/docs/deleted"
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (EventGridPartnerNamespaceChannelResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_eventgrid_partner_namespace" "test" {
+  name                    = "acctest-egpn-%d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  partner_registration_id = azurerm_eventgrid_partner_registration.test.id
+}
+`, EventGridPartnerNamespaceResource{}.template(data), data.RandomInteger)
+}
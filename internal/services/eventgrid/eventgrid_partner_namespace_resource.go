@@ -0,0 +1,354 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15/partnernamespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15/partnerregistrations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.Resource           = EventGridPartnerNamespaceResource{}
+	_ sdk.ResourceWithUpdate = EventGridPartnerNamespaceResource{}
+)
+
+type EventGridPartnerNamespaceResource struct{}
+
+type EventGridPartnerNamespaceResourceModel struct {
+	Name                       string                                   `tfschema:"name"`
+	Location                   string                                   `tfschema:"location"`
+	ResourceGroupName          string                                   `tfschema:"resource_group_name"`
+	PartnerRegistrationId      string                                   `tfschema:"partner_registration_id"`
+	PartnerTopicRoutingMode    string                                   `tfschema:"partner_topic_routing_mode"`
+	PublicNetworkAccessEnabled bool                                     `tfschema:"public_network_access_enabled"`
+	LocalAuthEnabled           bool                                     `tfschema:"local_auth_enabled"`
+	InboundIPRules             []EventGridPartnerNamespaceInboundIPRule `tfschema:"inbound_ip_rule"`
+	Endpoint                   string                                   `tfschema:"endpoint"`
+	Tags                       map[string]interface{}                   `tfschema:"tags"`
+}
+
+type EventGridPartnerNamespaceInboundIPRule struct {
+	IPMask string `tfschema:"ip_mask"`
+	Action string `tfschema:"action"`
+}
+
+func (EventGridPartnerNamespaceResource) ResourceType() string {
+	return "azurerm_eventgrid_partner_namespace"
+}
+
+func (EventGridPartnerNamespaceResource) ModelObject() interface{} {
+	return &EventGridPartnerNamespaceResourceModel{}
+}
+
+func (EventGridPartnerNamespaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return partnernamespaces.ValidatePartnerNamespaceID
+}
+
+func (EventGridPartnerNamespaceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.All(
+				validation.StringIsNotEmpty,
+				validation.StringMatch(
+					regexp.MustCompile("^[-a-zA-Z0-9]{3,50}$"),
+					"EventGrid Partner Namespace name must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+				),
+			),
+		},
+
+		"location": commonschema.Location(),
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"partner_registration_id": commonschema.ResourceIDReferenceRequiredForceNew(&partnerregistrations.PartnerRegistrationId{}),
+
+		"partner_topic_routing_mode": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(partnernamespaces.PartnerTopicRoutingModeSourceEventAttribute),
+			ValidateFunc: validation.StringInSlice(partnernamespaces.PossibleValuesForPartnerTopicRoutingMode(), false),
+		},
+
+		"public_network_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"local_auth_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"inbound_ip_rule": {
+			Type:       pluginsdk.TypeList,
+			Optional:   true,
+			ConfigMode: pluginsdk.SchemaConfigModeAttr,
+			MaxItems:   128,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"ip_mask": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"action": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(partnernamespaces.IPActionTypeAllow),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(partnernamespaces.IPActionTypeAllow),
+						}, false),
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (EventGridPartnerNamespaceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"endpoint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r EventGridPartnerNamespaceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.PartnerNamespaces
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config EventGridPartnerNamespaceResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := partnernamespaces.NewPartnerNamespaceID(subscriptionId, config.ResourceGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			publicNetworkAccess := partnernamespaces.PublicNetworkAccessDisabled
+			if config.PublicNetworkAccessEnabled {
+				publicNetworkAccess = partnernamespaces.PublicNetworkAccessEnabled
+			}
+
+			payload := partnernamespaces.PartnerNamespace{
+				Location: location.Normalize(config.Location),
+				Properties: &partnernamespaces.PartnerNamespaceProperties{
+					DisableLocalAuth:                    pointer.To(!config.LocalAuthEnabled),
+					InboundIPRules:                      expandPartnerNamespaceInboundIPRules(config.InboundIPRules),
+					PartnerRegistrationFullyQualifiedId: pointer.To(config.PartnerRegistrationId),
+					PartnerTopicRoutingMode:             pointer.To(partnernamespaces.PartnerTopicRoutingMode(config.PartnerTopicRoutingMode)),
+					PublicNetworkAccess:                 pointer.To(publicNetworkAccess),
+				},
+				Tags: tags.ExpandPointer(config.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridPartnerNamespaceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.PartnerNamespaces
+
+			id, err := partnernamespaces.ParsePartnerNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridPartnerNamespaceResourceModel{
+				Name:              id.PartnerNamespaceName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if props := model.Properties; props != nil {
+					state.Endpoint = pointer.From(props.Endpoint)
+
+					if props.PartnerRegistrationFullyQualifiedId != nil {
+						partnerRegistrationId, err := partnerregistrations.ParsePartnerRegistrationIDInsensitively(*props.PartnerRegistrationFullyQualifiedId)
+						if err != nil {
+							return err
+						}
+						state.PartnerRegistrationId = partnerRegistrationId.ID()
+					}
+
+					state.PartnerTopicRoutingMode = string(partnernamespaces.PartnerTopicRoutingModeSourceEventAttribute)
+					if props.PartnerTopicRoutingMode != nil {
+						state.PartnerTopicRoutingMode = string(*props.PartnerTopicRoutingMode)
+					}
+
+					state.PublicNetworkAccessEnabled = true
+					if props.PublicNetworkAccess != nil && *props.PublicNetworkAccess == partnernamespaces.PublicNetworkAccessDisabled {
+						state.PublicNetworkAccessEnabled = false
+					}
+
+					state.LocalAuthEnabled = true
+					if props.DisableLocalAuth != nil {
+						state.LocalAuthEnabled = !*props.DisableLocalAuth
+					}
+
+					state.InboundIPRules = flattenPartnerNamespaceInboundIPRules(props.InboundIPRules)
+				}
+
+				state.Tags = tags.FlattenPointer(model.Tags)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridPartnerNamespaceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.PartnerNamespaces
+
+			id, err := partnernamespaces.ParsePartnerNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config EventGridPartnerNamespaceResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := partnernamespaces.PartnerNamespaceUpdateParameters{
+				Properties: &partnernamespaces.PartnerNamespaceUpdateParameterProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("public_network_access_enabled") {
+				publicNetworkAccess := partnernamespaces.PublicNetworkAccessDisabled
+				if config.PublicNetworkAccessEnabled {
+					publicNetworkAccess = partnernamespaces.PublicNetworkAccessEnabled
+				}
+
+				payload.Properties.PublicNetworkAccess = pointer.To(publicNetworkAccess)
+			}
+
+			if metadata.ResourceData.HasChange("local_auth_enabled") {
+				payload.Properties.DisableLocalAuth = pointer.To(!config.LocalAuthEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("inbound_ip_rule") {
+				// an empty list must be sent to remove the existing rules
+				payload.Properties.InboundIPRules = pointer.To([]partnernamespaces.InboundIPRule{})
+				if rules := expandPartnerNamespaceInboundIPRules(config.InboundIPRules); rules != nil {
+					payload.Properties.InboundIPRules = rules
+				}
+			}
+
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				payload.Tags = tags.ExpandPointer(config.Tags)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridPartnerNamespaceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.PartnerNamespaces
+
+			id, err := partnernamespaces.ParsePartnerNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandPartnerNamespaceInboundIPRules(input []EventGridPartnerNamespaceInboundIPRule) *[]partnernamespaces.InboundIPRule {
+	if len(input) == 0 {
+		return nil
+	}
+
+	rules := make([]partnernamespaces.InboundIPRule, 0)
+	for _, rule := range input {
+		rules = append(rules, partnernamespaces.InboundIPRule{
+			Action: pointer.To(partnernamespaces.IPActionType(rule.Action)),
+			IPMask: pointer.To(rule.IPMask),
+		})
+	}
+	return &rules
+}
+
+func flattenPartnerNamespaceInboundIPRules(input *[]partnernamespaces.InboundIPRule) []EventGridPartnerNamespaceInboundIPRule {
+	rules := make([]EventGridPartnerNamespaceInboundIPRule, 0)
+	if input == nil {
+		return rules
+	}
+
+	for _, r := range *input {
+		rules = append(rules, EventGridPartnerNamespaceInboundIPRule{
+			Action: string(pointer.From(r.Action)),
+			IPMask: pointer.From(r.IPMask),
+		})
+	}
+	return rules
+}
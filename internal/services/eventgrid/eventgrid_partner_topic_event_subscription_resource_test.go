@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15/eventsubscriptions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type EventGridPartnerTopicEventSubscriptionResource struct{}
+
+func TestAccEventGridPartnerTopicEventSubscription_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_partner_topic_event_subscription", "test")
+	r := EventGridPartnerTopicEventSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("event_delivery_schema").HasValue("EventGridSchema"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventGridPartnerTopicEventSubscription_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_partner_topic_event_subscription", "test")
+	r := EventGridPartnerTopicEventSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurerm_eventgrid_partner_topic_event_subscription"),
+		},
+	})
+}
+
+func TestAccEventGridPartnerTopicEventSubscription_filter(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_partner_topic_event_subscription", "test")
+	r := EventGridPartnerTopicEventSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.filter(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("included_event_types.0").HasValue("Example.Created"),
+				check.That(data.ResourceName).Key("subject_filter.0.subject_begins_with").HasValue("test/test"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (EventGridPartnerTopicEventSubscriptionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := eventsubscriptions.ParsePartnerTopicEventSubscriptionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.EventGrid.EventSubscriptions.PartnerTopicEventSubscriptionsGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r EventGridPartnerTopicEventSubscriptionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_partner_topic_event_subscription" "test" {
+  name                = "acctesteg-%d"
+  partner_topic       = azurerm_eventgrid_partner_topic.test.name
+  resource_group_name = azurerm_resource_group.test.name
+
+  storage_queue_endpoint {
+    storage_account_id = azurerm_storage_account.test.id
+    queue_name         = azurerm_storage_queue.test.name
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r EventGridPartnerTopicEventSubscriptionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_partner_topic_event_subscription" "import" {
+  name                = azurerm_eventgrid_partner_topic_event_subscription.test.name
+  partner_topic       = azurerm_eventgrid_partner_topic_event_subscription.test.partner_topic
+  resource_group_name = azurerm_eventgrid_partner_topic_event_subscription.test.resource_group_name
+
+  storage_queue_endpoint {
+    storage_account_id = azurerm_storage_account.test.id
+    queue_name         = azurerm_storage_queue.test.name
+  }
+}
+`, r.basic(data))
+}
+
+func (r EventGridPartnerTopicEventSubscriptionResource) filter(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_partner_topic_event_subscription" "test" {
+  name                = "acctesteg-%d"
+  partner_topic       = azurerm_eventgrid_partner_topic.test.name
+  resource_group_name = azurerm_resource_group.test.name
+
+  storage_queue_endpoint {
+    storage_account_id = azurerm_storage_account.test.id
+    queue_name         = azurerm_storage_queue.test.name
+  }
+
+  included_event_types = ["Example.Created"]
+
+  subject_filter {
+    subject_begins_with = "test/test"
+    subject_ends_with   = ".jpg"
+  }
+
+  retry_policy {
+    event_time_to_live    = 11
+    max_delivery_attempts = 11
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (EventGridPartnerTopicEventSubscriptionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_queue" "test" {
+  name                 = "mysamplequeue-%d"
+  storage_account_name = azurerm_storage_account.test.name
+}
+`, EventGridPartnerTopicResource{}.complete(data, true), data.RandomString, data.RandomInteger)
+}
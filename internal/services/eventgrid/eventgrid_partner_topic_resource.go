@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15/partnertopics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.Resource           = EventGridPartnerTopicResource{}
+	_ sdk.ResourceWithUpdate = EventGridPartnerTopicResource{}
+)
+
+type EventGridPartnerTopicResource struct{}
+
+type EventGridPartnerTopicResourceModel struct {
+	Name                              string                                     `tfschema:"name"`
+	Location                          string                                     `tfschema:"location"`
+	ResourceGroupName                 string                                     `tfschema:"resource_group_name"`
+	Source                            string                                     `tfschema:"source"`
+	PartnerRegistrationId             string                                     `tfschema:"partner_registration_id"`
+	ExpirationTimeIfNotActivatedInUtc string                                     `tfschema:"expiration_time_if_not_activated_in_utc"`
+	MessageForActivation              string                                     `tfschema:"message_for_activation"`
+	PartnerTopicFriendlyDescription   string                                     `tfschema:"partner_topic_friendly_description"`
+	Activated                         bool                                       `tfschema:"activated"`
+	Identity                          []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	Tags                              map[string]interface{}                     `tfschema:"tags"`
+}
+
+func (EventGridPartnerTopicResource) ResourceType() string {
+	return "azurerm_eventgrid_partner_topic"
+}
+
+func (EventGridPartnerTopicResource) ModelObject() interface{} {
+	return &EventGridPartnerTopicResourceModel{}
+}
+
+func (EventGridPartnerTopicResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return partnertopics.ValidatePartnerTopicID
+}
+
+func (EventGridPartnerTopicResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.All(
+				validation.StringIsNotEmpty,
+				validation.StringMatch(
+					regexp.MustCompile("^[-a-zA-Z0-9]{3,50}$"),
+					"EventGrid Partner Topic name must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+				),
+			),
+		},
+
+		"location": commonschema.Location(),
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"source": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"partner_registration_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"expiration_time_if_not_activated_in_utc": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"message_for_activation": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"partner_topic_friendly_description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"activated": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"identity": commonschema.SystemOrUserAssignedIdentityOptional(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (EventGridPartnerTopicResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridPartnerTopicResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.PartnerTopics
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config EventGridPartnerTopicResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := partnertopics.NewPartnerTopicID(subscriptionId, config.ResourceGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			expandedIdentity, err := identity.ExpandSystemAndUserAssignedMapFromModel(config.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			payload := partnertopics.PartnerTopic{
+				Identity: expandedIdentity,
+				Location: location.Normalize(config.Location),
+				Properties: &partnertopics.PartnerTopicProperties{
+					Source: pointer.To(config.Source),
+				},
+				Tags: tags.ExpandPointer(config.Tags),
+			}
+
+			if config.PartnerRegistrationId != "" {
+				payload.Properties.PartnerRegistrationImmutableId = pointer.To(config.PartnerRegistrationId)
+			}
+
+			if config.ExpirationTimeIfNotActivatedInUtc != "" {
+				payload.Properties.ExpirationTimeIfNotActivatedUtc = pointer.To(config.ExpirationTimeIfNotActivatedInUtc)
+			}
+
+			if config.MessageForActivation != "" {
+				payload.Properties.MessageForActivation = pointer.To(config.MessageForActivation)
+			}
+
+			if config.PartnerTopicFriendlyDescription != "" {
+				payload.Properties.PartnerTopicFriendlyDescription = pointer.To(config.PartnerTopicFriendlyDescription)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if config.Activated {
+				if _, err := client.Activate(ctx, id); err != nil {
+					return fmt.Errorf("activating %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridPartnerTopicResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.PartnerTopics
+
+			id, err := partnertopics.ParsePartnerTopicID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridPartnerTopicResourceModel{
+				Name:              id.PartnerTopicName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if props := model.Properties; props != nil {
+					state.Activated = pointer.From(props.ActivationState) == partnertopics.PartnerTopicActivationStateActivated
+					state.MessageForActivation = pointer.From(props.MessageForActivation)
+					state.PartnerRegistrationId = pointer.From(props.PartnerRegistrationImmutableId)
+					state.PartnerTopicFriendlyDescription = pointer.From(props.PartnerTopicFriendlyDescription)
+					state.Source = pointer.From(props.Source)
+
+					if props.ExpirationTimeIfNotActivatedUtc != nil {
+						t, err := props.GetExpirationTimeIfNotActivatedUtcAsTime()
+						if err == nil {
+							state.ExpirationTimeIfNotActivatedInUtc = t.Format(time.RFC3339)
+						}
+					}
+				}
+
+				flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMapToModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				state.Identity = pointer.From(flattenedIdentity)
+
+				state.Tags = tags.FlattenPointer(model.Tags)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridPartnerTopicResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.PartnerTopics
+
+			id, err := partnertopics.ParsePartnerTopicID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config EventGridPartnerTopicResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChanges("identity", "tags", "tags_all") {
+				payload := partnertopics.PartnerTopicUpdateParameters{}
+
+				if metadata.ResourceData.HasChange("identity") {
+					expandedIdentity, err := identity.ExpandSystemAndUserAssignedMapFromModel(config.Identity)
+					if err != nil {
+						return fmt.Errorf("expanding `identity`: %+v", err)
+					}
+					payload.Identity = expandedIdentity
+				}
+
+				if metadata.ResourceData.HasChanges("tags", "tags_all") {
+					payload.Tags = tags.ExpandPointer(config.Tags)
+				}
+
+				if _, err := client.Update(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("activated") {
+				if config.Activated {
+					if _, err := client.Activate(ctx, *id); err != nil {
+						return fmt.Errorf("activating %s: %+v", *id, err)
+					}
+				} else {
+					if _, err := client.Deactivate(ctx, *id); err != nil {
+						return fmt.Errorf("deactivating %s: %+v", *id, err)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridPartnerTopicResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.PartnerTopics
+
+			id, err := partnertopics.ParsePartnerTopicID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
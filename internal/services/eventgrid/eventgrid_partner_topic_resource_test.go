@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15/partnertopics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type EventGridPartnerTopicResource struct{}
+
+func TestAccEventGridPartnerTopic_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_partner_topic", "test")
+	r := EventGridPartnerTopicResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventGridPartnerTopic_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_partner_topic", "test")
+	r := EventGridPartnerTopicResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurerm_eventgrid_partner_topic"),
+		},
+	})
+}
+
+func TestAccEventGridPartnerTopic_activation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_partner_topic", "test")
+	r := EventGridPartnerTopicResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("activated").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("activated").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("activated").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (EventGridPartnerTopicResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := partnertopics.ParsePartnerTopicID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.EventGrid.PartnerTopics.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r EventGridPartnerTopicResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_partner_topic" "test" {
+  name                    = "acctest-egpt-%[2]d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  source                  = "acctest-source-%[2]d"
+  partner_registration_id = azurerm_eventgrid_partner_registration.test.partner_registration_id
+}
+`, EventGridPartnerNamespaceResource{}.template(data), data.RandomInteger)
+}
+
+func (r EventGridPartnerTopicResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_partner_topic" "import" {
+  name                    = azurerm_eventgrid_partner_topic.test.name
+  location                = azurerm_eventgrid_partner_topic.test.location
+  resource_group_name     = azurerm_eventgrid_partner_topic.test.resource_group_name
+  source                  = azurerm_eventgrid_partner_topic.test.source
+  partner_registration_id = azurerm_eventgrid_partner_topic.test.partner_registration_id
+}
+`, r.basic(data))
+}
+
+func (r EventGridPartnerTopicResource) complete(data acceptance.TestData, activated bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_partner_topic" "test" {
+  name                    = "acctest-egpt-%[2]d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  source                  = "acctest-source-%[2]d"
+  partner_registration_id = azurerm_eventgrid_partner_registration.test.partner_registration_id
+  activated               = %[3]t
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  tags = {
+    Foo = "Bar"
+  }
+}
+`, EventGridPartnerNamespaceResource{}.template(data), data.RandomInteger, activated)
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15/verifiedpartners"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.DataSource = EventGridVerifiedPartnersDataSource{}
+
+type EventGridVerifiedPartnersDataSource struct{}
+
+type EventGridVerifiedPartnersDataSourceModel struct {
+	VerifiedPartners []EventGridVerifiedPartner `tfschema:"verified_partner"`
+}
+
+type EventGridVerifiedPartner struct {
+	Name                        string `tfschema:"name"`
+	OrganizationName            string `tfschema:"organization_name"`
+	PartnerDisplayName          string `tfschema:"partner_display_name"`
+	PartnerRegistrationId       string `tfschema:"partner_registration_id"`
+	PartnerTopicDescription     string `tfschema:"partner_topic_description"`
+	PartnerTopicLongDescription string `tfschema:"partner_topic_long_description"`
+	PartnerTopicSetupUri        string `tfschema:"partner_topic_setup_uri"`
+}
+
+func (EventGridVerifiedPartnersDataSource) ResourceType() string {
+	return "azurerm_eventgrid_verified_partners"
+}
+
+func (EventGridVerifiedPartnersDataSource) ModelObject() interface{} {
+	return &EventGridVerifiedPartnersDataSourceModel{}
+}
+
+func (EventGridVerifiedPartnersDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (EventGridVerifiedPartnersDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"verified_partner": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"organization_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"partner_display_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"partner_registration_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"partner_topic_description": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"partner_topic_long_description": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"partner_topic_setup_uri": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (EventGridVerifiedPartnersDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.VerifiedPartners
+
+			resp, err := client.ListComplete(ctx, verifiedpartners.DefaultListOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing EventGrid Verified Partners: %+v", err)
+			}
+
+			state := EventGridVerifiedPartnersDataSourceModel{
+				VerifiedPartners: flattenEventGridVerifiedPartners(resp.Items),
+			}
+
+			// Verified Partners are a tenant-level (read-only) collection, so the collection path is used as the ID
+			metadata.ResourceData.SetId("/providers/Microsoft.EventGrid/verifiedPartners")
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenEventGridVerifiedPartners(input []verifiedpartners.VerifiedPartner) []EventGridVerifiedPartner {
+	output := make([]EventGridVerifiedPartner, 0)
+
+	for _, item := range input {
+		partner := EventGridVerifiedPartner{
+			Name: pointer.From(item.Name),
+		}
+
+		if props := item.Properties; props != nil {
+			partner.OrganizationName = pointer.From(props.OrganizationName)
+			partner.PartnerDisplayName = pointer.From(props.PartnerDisplayName)
+			partner.PartnerRegistrationId = pointer.From(props.PartnerRegistrationImmutableId)
+
+			if details := props.PartnerTopicDetails; details != nil {
+				partner.PartnerTopicDescription = pointer.From(details.Description)
+				partner.PartnerTopicLongDescription = pointer.From(details.LongDescription)
+				partner.PartnerTopicSetupUri = pointer.From(details.SetupUri)
+			}
+		}
+
+		output = append(output, partner)
+	}
+
+	return output
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type EventGridVerifiedPartnersDataSource struct{}
+
+func TestAccEventGridVerifiedPartnersDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_eventgrid_verified_partners", "test")
+	r := EventGridVerifiedPartnersDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("verified_partner.0.name").Exists(),
+				check.That(data.ResourceName).Key("verified_partner.0.partner_registration_id").IsUUID(),
+			),
+		},
+	})
+}
+
+func (EventGridVerifiedPartnersDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_eventgrid_verified_partners" "test" {}
+`
+}
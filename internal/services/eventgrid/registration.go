@@ -10,7 +10,10 @@ import (
 
 type Registration struct{}
 
-var _ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+var (
+	_ sdk.TypedServiceRegistration                   = Registration{}
+	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+)
 
 func (r Registration) AssociatedGitHubLabel() string {
 	return "service/event-grid"
@@ -49,3 +52,22 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_eventgrid_system_topic_event_subscription": resourceEventGridSystemTopicEventSubscription(),
 	}
 }
+
+// DataSources returns a list of Data Sources supported by this Service
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{
+		EventGridVerifiedPartnersDataSource{},
+	}
+}
+
+// Resources returns a list of Resources supported by this Service
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		EventGridPartnerConfigurationResource{},
+		EventGridPartnerNamespaceChannelResource{},
+		EventGridPartnerNamespaceResource{},
+		EventGridPartnerRegistrationResource{},
+		EventGridPartnerTopicEventSubscriptionResource{},
+		EventGridPartnerTopicResource{},
+	}
+}
@@ -202,7 +202,7 @@ func (s Server) Update() sdk.ResourceFunc {
 			}
 
 			payload := fluidrelayservers.FluidRelayServerUpdate{}
-			if meta.ResourceData.HasChange("tags") {
+			if meta.ResourceData.HasChanges("tags", "tags_all") {
 				payload.Tags = &model.Tags
 			}
 			if meta.ResourceData.HasChange("identity") {
@@ -12,10 +12,10 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/graphservices/2023-04-13/graphservicesprods"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -149,7 +149,7 @@ func (r AccountResource) Create() sdk.ResourceFunc {
 
 			payload := graphservicesprods.AccountResource{
 				Location: pointer.To(location.Normalize("global")),
-				Tags:     tags.Expand(config.Tags),
+				Tags:     tags.ExpandPointer(config.Tags),
 				Properties: graphservicesprods.AccountResourceProperties{
 					AppId: config.ApplicationId,
 				},
@@ -193,7 +193,7 @@ func (r AccountResource) Read() sdk.ResourceFunc {
 				ApplicationId:     model.Properties.AppId,
 				Name:              id.AccountName,
 				ResourceGroupName: id.ResourceGroupName,
-				Tags:              tags.Flatten(model.Tags),
+				Tags:              tags.FlattenPointer(model.Tags),
 			}
 			if model.Properties.BillingPlanId != nil {
 				schema.BillingPlanId = pointer.From(model.Properties.BillingPlanId)
@@ -249,8 +249,8 @@ func (r AccountResource) Update() sdk.ResourceFunc {
 			}
 
 			payload := graphservicesprods.TagUpdate{}
-			if metadata.ResourceData.HasChange("tags") {
-				payload.Tags = tags.Expand(config.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				payload.Tags = tags.ExpandPointer(config.Tags)
 			}
 
 			if _, err := client.AccountsUpdate(ctx, *id, payload); err != nil {
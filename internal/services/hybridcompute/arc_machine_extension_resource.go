@@ -299,7 +299,7 @@ func (r ArcMachineExtensionResource) Update() sdk.ResourceFunc {
 
 			properties.SystemData = nil
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				properties.Tags = &model.Tags
 			}
 
@@ -851,7 +851,7 @@ func resourceIotHubUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		iothub.Identity = identity
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		iothub.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -654,9 +654,9 @@ func resourceKeyVaultCertificateUpdate(d *schema.ResourceData, meta interface{})
 		d.SetId(certificateId.ID())
 	}
 
-	if updateLifetime := !cmp.Equal(lifeTimeOld, lifeTimeNew); d.HasChange("tags") || updateLifetime {
+	if updateLifetime := !cmp.Equal(lifeTimeOld, lifeTimeNew); d.HasChanges("tags", "tags_all") || updateLifetime {
 		patch := keyvault.CertificateUpdateParameters{}
-		if d.HasChange("tags") {
+		if d.HasChanges("tags", "tags_all") {
 			if t, ok := d.GetOk("tags"); ok {
 				patch.Tags = tags.Expand(t.(map[string]interface{}))
 			}
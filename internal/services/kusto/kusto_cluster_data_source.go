@@ -12,9 +12,9 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
@@ -91,7 +91,7 @@ func dataSourceKustoClusterRead(d *pluginsdk.ResourceData, meta interface{}) err
 			d.Set("uri", clusterProperties.Uri)
 			d.Set("data_ingestion_uri", clusterProperties.DataIngestionUri)
 		}
-		if err := tags.FlattenAndSet(d, resp.Model.Tags); err != nil {
+		if err := tags.FlattenPointerAndSet(d, resp.Model.Tags); err != nil {
 			return fmt.Errorf("setting `tags`: %s", err)
 		}
 	}
@@ -13,10 +13,10 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/loadtestservice/2022-12-01/loadtests"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
@@ -302,7 +302,7 @@ func (r LoadTestResource) mapLoadTestResourceSchemaToLoadTestResource(input Load
 	output.Identity = identity
 
 	output.Location = location.Normalize(input.Location)
-	output.Tags = tags.Expand(input.Tags)
+	output.Tags = tags.ExpandPointer(input.Tags)
 
 	if output.Properties == nil {
 		output.Properties = &loadtests.LoadTestProperties{}
@@ -323,7 +323,7 @@ func (r LoadTestResource) mapLoadTestResourceToLoadTestResourceSchema(input load
 	output.Identity = identity
 
 	output.Location = location.Normalize(input.Location)
-	output.Tags = tags.Flatten(input.Tags)
+	output.Tags = tags.FlattenPointer(input.Tags)
 
 	if input.Properties == nil {
 		input.Properties = &loadtests.LoadTestProperties{}
@@ -343,7 +343,7 @@ func (r LoadTestResource) mapLoadTestResourceSchemaToLoadTestResourceUpdate(inpu
 	}
 	output.Identity = identity
 
-	output.Tags = tags.Expand(input.Tags)
+	output.Tags = tags.ExpandPointer(input.Tags)
 	return nil
 }
 
@@ -356,6 +356,6 @@ func (r LoadTestResource) mapLoadTestResourceUpdateToLoadTestResourceSchema(inpu
 	}
 	output.Identity = identity
 
-	output.Tags = tags.Flatten(input.Tags)
+	output.Tags = tags.FlattenPointer(input.Tags)
 	return nil
 }
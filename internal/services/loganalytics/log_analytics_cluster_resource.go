@@ -234,7 +234,7 @@ func (r LogAnalyticsClusterResource) Update() sdk.ResourceFunc {
 				model.Sku.Capacity = pointer.To(clusters.Capacity(config.SizeGB))
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				model.Tags = pointer.To(config.Tags)
 			}
 
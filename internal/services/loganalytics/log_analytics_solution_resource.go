@@ -299,7 +299,7 @@ func (s LogAnalyticsSolutionResource) Update() sdk.ResourceFunc {
 
 			payload := solution.SolutionPatch{}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				payload.Tags = pointer.To(config.Tags)
 			}
 
@@ -156,7 +156,7 @@ func (r KeyVaultMHSMKeyResource) CustomizeDiff() sdk.ResourceFunc {
 			diff := metadata.ResourceDiff
 
 			// if any value has changed, we need to SetNewComputed on versioned_id as any change to the key is a new version
-			if diff.HasChanges("key_opts", "not_before_date", "tags", "expiration_date") {
+			if diff.HasChanges("key_opts", "not_before_date", "tags", "tags_all", "expiration_date") {
 				return diff.SetNewComputed("versioned_id")
 			}
 
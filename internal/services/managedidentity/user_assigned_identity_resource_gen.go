@@ -13,9 +13,9 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/managedidentity/2023-01-31/managedidentities"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -194,7 +194,7 @@ func (r UserAssignedIdentityResource) Update() sdk.ResourceFunc {
 
 func (r UserAssignedIdentityResource) mapUserAssignedIdentityResourceSchemaToIdentity(input UserAssignedIdentityResourceSchema, output *managedidentities.Identity) error {
 	output.Location = location.Normalize(input.Location)
-	output.Tags = tags.Expand(input.Tags)
+	output.Tags = tags.ExpandPointer(input.Tags)
 
 	if output.Properties == nil {
 		output.Properties = &managedidentities.UserAssignedIdentityProperties{}
@@ -208,7 +208,7 @@ func (r UserAssignedIdentityResource) mapUserAssignedIdentityResourceSchemaToIde
 
 func (r UserAssignedIdentityResource) mapIdentityToUserAssignedIdentityResourceSchema(input managedidentities.Identity, output *UserAssignedIdentityResourceSchema) error {
 	output.Location = location.Normalize(input.Location)
-	output.Tags = tags.Flatten(input.Tags)
+	output.Tags = tags.FlattenPointer(input.Tags)
 
 	if input.Properties == nil {
 		input.Properties = &managedidentities.UserAssignedIdentityProperties{}
@@ -221,7 +221,7 @@ func (r UserAssignedIdentityResource) mapIdentityToUserAssignedIdentityResourceS
 }
 
 func (r UserAssignedIdentityResource) mapUserAssignedIdentityResourceSchemaToIdentityUpdate(input UserAssignedIdentityResourceSchema, output *managedidentities.IdentityUpdate) error {
-	output.Tags = tags.Expand(input.Tags)
+	output.Tags = tags.ExpandPointer(input.Tags)
 
 	if output.Properties == nil {
 		output.Properties = &managedidentities.UserAssignedIdentityProperties{}
@@ -234,7 +234,7 @@ func (r UserAssignedIdentityResource) mapUserAssignedIdentityResourceSchemaToIde
 }
 
 func (r UserAssignedIdentityResource) mapIdentityUpdateToUserAssignedIdentityResourceSchema(input managedidentities.IdentityUpdate, output *UserAssignedIdentityResourceSchema) error {
-	output.Tags = tags.Flatten(input.Tags)
+	output.Tags = tags.FlattenPointer(input.Tags)
 
 	if input.Properties == nil {
 		input.Properties = &managedidentities.UserAssignedIdentityProperties{}
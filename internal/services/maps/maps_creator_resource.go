@@ -10,11 +10,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/maps/2023-06-01/accounts"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/maps/2023-06-01/creators"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -96,7 +96,7 @@ func resourceMapsCreatorCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 		Properties: creators.CreatorProperties{
 			StorageUnits: int64(d.Get("storage_units").(int)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandPointer(d.Get("tags").(map[string]interface{})),
 	}
 	if _, err := client.CreateOrUpdate(ctx, id, props); err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
@@ -132,7 +132,7 @@ func resourceMapsCreatorRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("location", location.Normalize(model.Location))
 		props := model.Properties
 		d.Set("storage_units", props.StorageUnits)
-		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+		if err := tags.FlattenPointerAndSet(d, model.Tags); err != nil {
 			return err
 		}
 	}
@@ -154,7 +154,7 @@ func resourceMapsCreatorUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		Properties: &creators.CreatorProperties{
 			StorageUnits: int64(d.Get("storage_units").(int)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandPointer(d.Get("tags").(map[string]interface{})),
 	}
 
 	if _, err := client.Update(ctx, *id, props); err != nil {
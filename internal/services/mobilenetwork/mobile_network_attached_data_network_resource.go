@@ -12,11 +12,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/attacheddatanetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/packetcoredataplane"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
@@ -258,7 +258,7 @@ func (r AttachedDataNetworkResource) Create() sdk.ResourceFunc {
 					UserPlaneDataInterface: attacheddatanetwork.InterfaceProperties{},
 					NaptConfiguration:      expandNaptConfiguration(model.NaptConfiguration),
 				},
-				Tags: tags.Expand(model.Tags),
+				Tags: tags.ExpandPointer(model.Tags),
 			}
 
 			// if we pass an empty array the service will return an error
@@ -382,9 +382,9 @@ func (r AttachedDataNetworkResource) Update() sdk.ResourceFunc {
 				}
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				// pass empty array instead of nil to remove all tags
-				attachedDataNetwork.Tags = tags.Expand(plan.Tags)
+				attachedDataNetwork.Tags = tags.ExpandPointer(plan.Tags)
 			}
 
 			if err := client.CreateOrUpdateThenPoll(ctx, *id, attachedDataNetwork); err != nil {
@@ -433,7 +433,7 @@ func (r AttachedDataNetworkResource) Read() sdk.ResourceFunc {
 				state.UserPlaneAccessIPv4Gateway = pointer.From(props.UserPlaneDataInterface.IPv4Gateway)
 				state.UserPlaneAccessIPv4Subnet = pointer.From(props.UserPlaneDataInterface.IPv4Subnet)
 				state.UserPlaneAccessName = pointer.From(props.UserPlaneDataInterface.Name)
-				state.Tags = tags.Flatten(model.Tags)
+				state.Tags = tags.FlattenPointer(model.Tags)
 			}
 
 			return metadata.Encode(&state)
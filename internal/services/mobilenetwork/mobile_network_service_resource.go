@@ -428,7 +428,7 @@ func (r ServiceResource) Update() sdk.ResourceFunc {
 				properties.Properties.ServiceQosPolicy = expandQosPolicyResourceModel(model.ServiceQosPolicy)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				properties.Tags = &model.Tags
 			}
 
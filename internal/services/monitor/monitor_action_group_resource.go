@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -67,7 +68,7 @@ func resourceMonitorActionGroup() *pluginsdk.Resource {
 				ForceNew: true,
 				Default:  "global",
 				ValidateFunc: validation.Any(
-					location.EnhancedValidate,
+					resourceproviders.EnhancedValidateLocation,
 					validation.StringInSlice([]string{
 						"global",
 					}, false),
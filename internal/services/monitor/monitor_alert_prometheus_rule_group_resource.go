@@ -349,7 +349,7 @@ func (r AlertPrometheusRuleGroupResource) Update() sdk.ResourceFunc {
 			if metadata.ResourceData.HasChange("scopes") {
 				properties.Properties.Scopes = model.Scopes
 			}
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				properties.Tags = pointer.To(model.Tags)
 			}
 
@@ -10,10 +10,10 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-06-01/datacollectionendpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -138,7 +138,7 @@ func (r DataCollectionEndpointResource) Create() sdk.ResourceFunc {
 						PublicNetworkAccess: expandDataCollectionEndpointPublicNetworkAccess(state.PublicNetworkAccessEnabled),
 					},
 				},
-				Tags: tags.Expand(state.Tags),
+				Tags: tags.ExpandPointer(state.Tags),
 			}
 
 			if _, err := client.Create(ctx, id, input); err != nil {
@@ -176,7 +176,7 @@ func (r DataCollectionEndpointResource) Read() sdk.ResourceFunc {
 			if model := resp.Model; model != nil {
 				kind = flattenDataCollectionEndpointKind(model.Kind)
 				location = azure.NormalizeLocation(model.Location)
-				tag = tags.Flatten(model.Tags)
+				tag = tags.FlattenPointer(model.Tags)
 				if prop := model.Properties; prop != nil {
 					description = flattenDataCollectionEndpointDescription(prop.Description)
 					if networkAcls := prop.NetworkAcls; networkAcls != nil {
@@ -255,8 +255,8 @@ func (r DataCollectionEndpointResource) Update() sdk.ResourceFunc {
 				}
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				existing.Tags = tags.Expand(state.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				existing.Tags = tags.ExpandPointer(state.Tags)
 			}
 
 			if _, err := client.Create(ctx, *id, *existing); err != nil {
@@ -13,7 +13,6 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-06-01/datacollectionendpoints"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-06-01/datacollectionrules"
@@ -21,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -972,7 +972,7 @@ func (r DataCollectionRuleResource) Create() sdk.ResourceFunc {
 					Destinations:       expandDataCollectionRuleDestinations(state.Destinations),
 					StreamDeclarations: expandDataCollectionRuleStreamDeclarations(state.StreamDeclaration),
 				},
-				Tags: tags.Expand(state.Tags),
+				Tags: tags.ExpandPointer(state.Tags),
 			}
 
 			if state.DataCollectionEndpointId != "" {
@@ -1019,7 +1019,7 @@ func (r DataCollectionRuleResource) Read() sdk.ResourceFunc {
 			if model := resp.Model; model != nil {
 				kind = flattenDataCollectionRuleKind(model.Kind)
 				location = azure.NormalizeLocation(model.Location)
-				tag = tags.Flatten(model.Tags)
+				tag = tags.FlattenPointer(model.Tags)
 
 				identityValue, err := identity.FlattenLegacySystemAndUserAssignedMap(model.Identity)
 				if err != nil {
@@ -1091,8 +1091,8 @@ func (r DataCollectionRuleResource) Update() sdk.ResourceFunc {
 				existing.Kind = expandDataCollectionRuleKind(state.Kind)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				existing.Tags = tags.Expand(state.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				existing.Tags = tags.ExpandPointer(state.Tags)
 			}
 
 			if metadata.ResourceData.HasChange("data_flow") {
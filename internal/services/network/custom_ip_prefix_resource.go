@@ -16,10 +16,10 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/customipprefixes"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
@@ -203,7 +203,7 @@ func (r CustomIpPrefixResource) Create() sdk.ResourceFunc {
 			payload := customipprefixes.CustomIPPrefix{
 				Name:             &model.Name,
 				Location:         pointer.To(location.Normalize(model.Location)),
-				Tags:             tags.Expand(model.Tags),
+				Tags:             tags.ExpandPointer(model.Tags),
 				ExtendedLocation: nil,
 				Properties: &customipprefixes.CustomIPPrefixPropertiesFormat{
 					Cidr:              &model.CIDR,
@@ -341,7 +341,7 @@ func (r CustomIpPrefixResource) Read() sdk.ResourceFunc {
 
 			if model := existing.Model; model != nil {
 				state.Location = location.NormalizeNilable(model.Location)
-				state.Tags = tags.Flatten(model.Tags)
+				state.Tags = tags.FlattenPointer(model.Tags)
 				state.Zones = pointer.From(model.Zones)
 
 				if props := model.Properties; props != nil {
@@ -59,6 +59,7 @@ func resourceNATGatewayPublicIpAssociationCreate(d *pluginsdk.ResourceData, meta
 	client := meta.(*clients.Client).Network.Client.NatGateways
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithOwner(ctx)
 
 	publicIpAddressId, err := commonids.ParsePublicIPAddressID(d.Get("public_ip_address_id").(string))
 	if err != nil {
@@ -70,7 +71,9 @@ func resourceNATGatewayPublicIpAssociationCreate(d *pluginsdk.ResourceData, meta
 		return err
 	}
 
-	locks.ByName(natGatewayId.NatGatewayName, natGatewayResourceName)
+	if err := locks.ByNameWithContext(ctx, natGatewayId.NatGatewayName, natGatewayResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(natGatewayId.NatGatewayName, natGatewayResourceName)
 
 	natGateway, err := client.Get(ctx, *natGatewayId, natgateways.DefaultGetOperationOptions())
@@ -177,13 +180,16 @@ func resourceNATGatewayPublicIpAssociationDelete(d *pluginsdk.ResourceData, meta
 	client := meta.(*clients.Client).Network.Client.NatGateways
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithOwner(ctx)
 
 	id, err := commonids.ParseCompositeResourceID(d.Id(), &natgateways.NatGatewayId{}, &commonids.PublicIPAddressId{})
 	if err != nil {
 		return err
 	}
 
-	locks.ByName(id.First.NatGatewayName, natGatewayResourceName)
+	if err := locks.ByNameWithContext(ctx, id.First.NatGatewayName, natGatewayResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.First.NatGatewayName, natGatewayResourceName)
 
 	natGateway, err := client.Get(ctx, *id.First, natgateways.DefaultGetOperationOptions())
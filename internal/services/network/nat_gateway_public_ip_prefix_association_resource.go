@@ -60,6 +60,7 @@ func resourceNATGatewayPublicIpPrefixAssociationCreate(d *pluginsdk.ResourceData
 	client := meta.(*clients.Client).Network.Client.NatGateways
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithOwner(ctx)
 
 	publicIpPrefixId, err := publicipprefixes.ParsePublicIPPrefixID(d.Get("public_ip_prefix_id").(string))
 	if err != nil {
@@ -71,7 +72,9 @@ func resourceNATGatewayPublicIpPrefixAssociationCreate(d *pluginsdk.ResourceData
 		return err
 	}
 
-	locks.ByName(natGatewayId.NatGatewayName, natGatewayResourceName)
+	if err := locks.ByNameWithContext(ctx, natGatewayId.NatGatewayName, natGatewayResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(natGatewayId.NatGatewayName, natGatewayResourceName)
 
 	natGateway, err := client.Get(ctx, *natGatewayId, natgateways.DefaultGetOperationOptions())
@@ -178,13 +181,16 @@ func resourceNATGatewayPublicIpPrefixAssociationDelete(d *pluginsdk.ResourceData
 	client := meta.(*clients.Client).Network.Client.NatGateways
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithOwner(ctx)
 
 	id, err := commonids.ParseCompositeResourceID(d.Id(), &natgateways.NatGatewayId{}, &publicipprefixes.PublicIPPrefixId{})
 	if err != nil {
 		return err
 	}
 
-	locks.ByName(id.First.NatGatewayName, natGatewayResourceName)
+	if err := locks.ByNameWithContext(ctx, id.First.NatGatewayName, natGatewayResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.First.NatGatewayName, natGatewayResourceName)
 
 	natGateway, err := client.Get(ctx, *id.First, natgateways.DefaultGetOperationOptions())
@@ -67,6 +67,7 @@ func resourceNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationCrea
 	client := meta.(*clients.Client).Network.Client.NetworkInterfaces
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithOwner(ctx)
 
 	ipConfigurationName := d.Get("ip_configuration_name").(string)
 
@@ -75,7 +76,9 @@ func resourceNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationCrea
 		return err
 	}
 
-	locks.ByName(networkInterfaceId.NetworkInterfaceName, networkInterfaceResourceName)
+	if err := locks.ByNameWithContext(ctx, networkInterfaceId.NetworkInterfaceName, networkInterfaceResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(networkInterfaceId.NetworkInterfaceName, networkInterfaceResourceName)
 
 	resp, err := client.Get(ctx, *networkInterfaceId, networkinterfaces.DefaultGetOperationOptions())
@@ -214,13 +217,16 @@ func resourceNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationDele
 	client := meta.(*clients.Client).Network.Client.NetworkInterfaces
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithOwner(ctx)
 
 	id, err := commonids.ParseCompositeResourceID(d.Id(), &commonids.NetworkInterfaceIPConfigurationId{}, &parse.ApplicationGatewayBackendAddressPoolId{})
 	if err != nil {
 		return err
 	}
 
-	locks.ByName(id.First.NetworkInterfaceName, networkInterfaceResourceName)
+	if err := locks.ByNameWithContext(ctx, id.First.NetworkInterfaceName, networkInterfaceResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.First.NetworkInterfaceName, networkInterfaceResourceName)
 
 	networkInterfaceId := commonids.NewNetworkInterfaceID(id.First.SubscriptionId, id.First.ResourceGroupName, id.First.NetworkInterfaceName)
@@ -64,6 +64,7 @@ func resourceNetworkInterfaceApplicationSecurityGroupAssociationCreate(d *plugin
 	client := meta.(*clients.Client).Network.NetworkInterfaces
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithOwner(ctx)
 
 	log.Printf("[INFO] preparing arguments for Network Interface <-> Application Security Group Association creation.")
 
@@ -76,7 +77,9 @@ func resourceNetworkInterfaceApplicationSecurityGroupAssociationCreate(d *plugin
 		return err
 	}
 
-	locks.ByName(networkInterfaceId.NetworkInterfaceName, networkInterfaceResourceName)
+	if err := locks.ByNameWithContext(ctx, networkInterfaceId.NetworkInterfaceName, networkInterfaceResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(networkInterfaceId.NetworkInterfaceName, networkInterfaceResourceName)
 
 	read, err := client.Get(ctx, *networkInterfaceId, networkinterfaces.DefaultGetOperationOptions())
@@ -175,13 +178,16 @@ func resourceNetworkInterfaceApplicationSecurityGroupAssociationDelete(d *plugin
 	client := meta.(*clients.Client).Network.NetworkInterfaces
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithOwner(ctx)
 
 	id, err := commonids.ParseCompositeResourceID(d.Id(), &commonids.NetworkInterfaceId{}, &applicationsecuritygroups.ApplicationSecurityGroupId{})
 	if err != nil {
 		return err
 	}
 
-	locks.ByName(id.First.NetworkInterfaceName, networkInterfaceResourceName)
+	if err := locks.ByNameWithContext(ctx, id.First.NetworkInterfaceName, networkInterfaceResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.First.NetworkInterfaceName, networkInterfaceResourceName)
 
 	read, err := client.Get(ctx, *id.First, networkinterfaces.DefaultGetOperationOptions())
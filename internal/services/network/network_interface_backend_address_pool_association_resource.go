@@ -66,6 +66,7 @@ func resourceNetworkInterfaceBackendAddressPoolAssociationCreate(d *pluginsdk.Re
 	client := meta.(*clients.Client).Network.Client.NetworkInterfaces
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithOwner(ctx)
 
 	networkInterfaceId, err := commonids.ParseNetworkInterfaceID(d.Get("network_interface_id").(string))
 	if err != nil {
@@ -77,7 +78,9 @@ func resourceNetworkInterfaceBackendAddressPoolAssociationCreate(d *pluginsdk.Re
 	}
 	ipConfigId := commonids.NewNetworkInterfaceIPConfigurationID(networkInterfaceId.SubscriptionId, networkInterfaceId.ResourceGroupName, networkInterfaceId.NetworkInterfaceName, d.Get("ip_configuration_name").(string))
 
-	locks.ByName(networkInterfaceId.NetworkInterfaceName, networkInterfaceResourceName)
+	if err := locks.ByNameWithContext(ctx, networkInterfaceId.NetworkInterfaceName, networkInterfaceResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(networkInterfaceId.NetworkInterfaceName, networkInterfaceResourceName)
 
 	read, err := client.Get(ctx, *networkInterfaceId, networkinterfaces.DefaultGetOperationOptions())
@@ -209,6 +212,7 @@ func resourceNetworkInterfaceBackendAddressPoolAssociationDelete(d *pluginsdk.Re
 	client := meta.(*clients.Client).Network.Client.NetworkInterfaces
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithOwner(ctx)
 
 	id, err := commonids.ParseCompositeResourceID(d.Id(), &commonids.NetworkInterfaceIPConfigurationId{}, &loadbalancers.BackendAddressPoolId{})
 	if err != nil {
@@ -217,7 +221,9 @@ func resourceNetworkInterfaceBackendAddressPoolAssociationDelete(d *pluginsdk.Re
 
 	networkInterfaceId := commonids.NewNetworkInterfaceID(id.First.SubscriptionId, id.First.ResourceGroupName, id.First.NetworkInterfaceName)
 
-	locks.ByName(id.First.NetworkInterfaceName, networkInterfaceResourceName)
+	if err := locks.ByNameWithContext(ctx, id.First.NetworkInterfaceName, networkInterfaceResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.First.NetworkInterfaceName, networkInterfaceResourceName)
 
 	read, err := client.Get(ctx, networkInterfaceId, networkinterfaces.DefaultGetOperationOptions())
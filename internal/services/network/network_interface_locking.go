@@ -4,6 +4,8 @@
 package network
 
 import (
+	"context"
+
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networkinterfaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -15,9 +17,16 @@ type networkInterfaceIPConfigurationLockingDetails struct {
 	virtualNetworkNamesToLock []string
 }
 
-func (details networkInterfaceIPConfigurationLockingDetails) lock() {
-	locks.MultipleByName(&details.virtualNetworkNamesToLock, VirtualNetworkResourceName)
-	locks.MultipleByName(&details.subnetNamesToLock, SubnetResourceName)
+// lock acquires the locks for the Virtual Networks and Subnets, or none of them if an error is returned
+func (details networkInterfaceIPConfigurationLockingDetails) lock(ctx context.Context) error {
+	if err := locks.MultipleByNameWithContext(ctx, &details.virtualNetworkNamesToLock, VirtualNetworkResourceName); err != nil {
+		return err
+	}
+	if err := locks.MultipleByNameWithContext(ctx, &details.subnetNamesToLock, SubnetResourceName); err != nil {
+		locks.UnlockMultipleByName(&details.virtualNetworkNamesToLock, VirtualNetworkResourceName)
+		return err
+	}
+	return nil
 }
 
 func (details networkInterfaceIPConfigurationLockingDetails) unlock() {
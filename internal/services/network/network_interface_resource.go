@@ -13,13 +13,13 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networkinterfaces"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	lbvalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -245,6 +245,7 @@ func resourceNetworkInterfaceCreate(d *pluginsdk.ResourceData, meta interface{})
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithOwner(ctx)
 
 	id := commonids.NewNetworkInterfaceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 	existing, err := client.Get(ctx, id, networkinterfaces.DefaultGetOperationOptions())
@@ -276,7 +277,9 @@ func resourceNetworkInterfaceCreate(d *pluginsdk.ResourceData, meta interface{})
 		EnableAcceleratedNetworking: &enableAcceleratedNetworking,
 	}
 
-	locks.ByName(id.NetworkInterfaceName, networkInterfaceResourceName)
+	if err := locks.ByNameWithContext(ctx, id.NetworkInterfaceName, networkInterfaceResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.NetworkInterfaceName, networkInterfaceResourceName)
 
 	if auxiliaryMode, hasAuxiliaryMode := d.GetOk("auxiliary_mode"); hasAuxiliaryMode {
@@ -315,7 +318,9 @@ func resourceNetworkInterfaceCreate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("determining locking details: %+v", err)
 	}
 
-	lockingDetails.lock()
+	if err := lockingDetails.lock(ctx); err != nil {
+		return err
+	}
 	defer lockingDetails.unlock()
 
 	if len(*ipConfigs) > 0 {
@@ -327,7 +332,7 @@ func resourceNetworkInterfaceCreate(d *pluginsdk.ResourceData, meta interface{})
 		ExtendedLocation: expandEdgeZoneModel(d.Get("edge_zone").(string)),
 		Location:         pointer.To(location.Normalize(d.Get("location").(string))),
 		Properties:       &properties,
-		Tags:             tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:             tags.ExpandPointer(d.Get("tags").(map[string]interface{})),
 	}
 
 	err = client.CreateOrUpdateThenPoll(ctx, id, iface)
@@ -343,13 +348,16 @@ func resourceNetworkInterfaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 	client := meta.(*clients.Client).Network.NetworkInterfaces
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithOwner(ctx)
 
 	id, err := commonids.ParseNetworkInterfaceID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	locks.ByName(id.NetworkInterfaceName, networkInterfaceResourceName)
+	if err := locks.ByNameWithContext(ctx, id.NetworkInterfaceName, networkInterfaceResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.NetworkInterfaceName, networkInterfaceResourceName)
 
 	// first get the existing one so that we can pull things as needed
@@ -425,7 +433,9 @@ func resourceNetworkInterfaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("determining locking details: %+v", err)
 		}
 
-		lockingDetails.lock()
+		if err := lockingDetails.lock(ctx); err != nil {
+			return err
+		}
 		defer lockingDetails.unlock()
 
 		// then map the fields managed in other resources back
@@ -434,9 +444,9 @@ func resourceNetworkInterfaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 		payload.Properties.IPConfigurations = ipConfigs
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		tagsRaw := d.Get("tags").(map[string]interface{})
-		payload.Tags = tags.Expand(tagsRaw)
+		payload.Tags = tags.ExpandPointer(tagsRaw)
 	}
 
 	err = client.CreateOrUpdateThenPoll(ctx, *id, *payload)
@@ -557,7 +567,7 @@ func resourceNetworkInterfaceRead(d *pluginsdk.ResourceData, meta interface{}) e
 			}
 		}
 
-		return tags.FlattenAndSet(d, model.Tags)
+		return tags.FlattenPointerAndSet(d, model.Tags)
 	}
 
 	return nil
@@ -567,13 +577,16 @@ func resourceNetworkInterfaceDelete(d *pluginsdk.ResourceData, meta interface{})
 	client := meta.(*clients.Client).Network.NetworkInterfaces
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithOwner(ctx)
 
 	id, err := commonids.ParseNetworkInterfaceID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	locks.ByName(id.NetworkInterfaceName, networkInterfaceResourceName)
+	if err := locks.ByNameWithContext(ctx, id.NetworkInterfaceName, networkInterfaceResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.NetworkInterfaceName, networkInterfaceResourceName)
 
 	existing, err := client.Get(ctx, *id, networkinterfaces.DefaultGetOperationOptions())
@@ -602,7 +615,9 @@ func resourceNetworkInterfaceDelete(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("determining locking details: %+v", err)
 	}
 
-	lockingDetails.lock()
+	if err := lockingDetails.lock(ctx); err != nil {
+		return err
+	}
 	defer lockingDetails.unlock()
 
 	err = client.DeleteThenPoll(ctx, *id)
@@ -287,7 +287,7 @@ func (r ManagerResource) Update() sdk.ResourceFunc {
 				existing.Model.Properties.NetworkManagerScopeAccesses = expandNetworkManagerScopeAccesses(state.ScopeAccesses)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				existing.Model.Tags = utils.ExpandPtrMapStringString(state.Tags)
 			}
 
@@ -12,12 +12,12 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networksecuritygroups"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/set"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -188,6 +188,7 @@ func resourceNetworkSecurityGroupCreate(d *pluginsdk.ResourceData, meta interfac
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithOwner(ctx)
 
 	id := networksecuritygroups.NewNetworkSecurityGroupID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
@@ -207,7 +208,9 @@ func resourceNetworkSecurityGroupCreate(d *pluginsdk.ResourceData, meta interfac
 		return fmt.Errorf("building list of Network Security Group Rules: %+v", sgErr)
 	}
 
-	locks.ByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
+	if err := locks.ByNameWithContext(ctx, id.NetworkSecurityGroupName, networkSecurityGroupResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
 
 	sg := networksecuritygroups.NetworkSecurityGroup{
@@ -216,7 +219,7 @@ func resourceNetworkSecurityGroupCreate(d *pluginsdk.ResourceData, meta interfac
 		Properties: &networksecuritygroups.NetworkSecurityGroupPropertiesFormat{
 			SecurityRules: &sgRules,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandPointer(d.Get("tags").(map[string]interface{})),
 	}
 
 	if err := client.CreateOrUpdateThenPoll(ctx, id, sg); err != nil {
@@ -232,6 +235,7 @@ func resourceNetworkSecurityGroupUpdate(d *pluginsdk.ResourceData, meta interfac
 	client := meta.(*clients.Client).Network.Client.NetworkSecurityGroups
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithOwner(ctx)
 
 	id, err := networksecuritygroups.ParseNetworkSecurityGroupID(d.Id())
 	if err != nil {
@@ -261,11 +265,13 @@ func resourceNetworkSecurityGroupUpdate(d *pluginsdk.ResourceData, meta interfac
 		payload.Properties.SecurityRules = pointer.To(sgRules)
 	}
 
-	if d.HasChange("tags") {
-		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		payload.Tags = tags.ExpandPointer(d.Get("tags").(map[string]interface{}))
 	}
 
-	locks.ByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
+	if err := locks.ByNameWithContext(ctx, id.NetworkSecurityGroupName, networkSecurityGroupResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
 
 	if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
@@ -307,7 +313,7 @@ func resourceNetworkSecurityGroupRead(d *pluginsdk.ResourceData, meta interface{
 				return fmt.Errorf("setting `security_rule`: %+v", err)
 			}
 		}
-		return tags.FlattenAndSet(d, model.Tags)
+		return tags.FlattenPointerAndSet(d, model.Tags)
 	}
 
 	return nil
@@ -60,6 +60,7 @@ func resourceSubnetNatGatewayAssociationCreate(d *pluginsdk.ResourceData, meta i
 	vnetClient := meta.(*clients.Client).Network.VirtualNetworks
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithOwner(ctx)
 
 	subnetId, err := commonids.ParseSubnetID(d.Get("subnet_id").(string))
 	if err != nil {
@@ -71,11 +72,17 @@ func resourceSubnetNatGatewayAssociationCreate(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
-	locks.ByName(gatewayId.NatGatewayName, natGatewayResourceName)
+	if err := locks.ByNameWithContext(ctx, gatewayId.NatGatewayName, natGatewayResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(gatewayId.NatGatewayName, natGatewayResourceName)
-	locks.ByName(subnetId.VirtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ByNameWithContext(ctx, subnetId.VirtualNetworkName, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(subnetId.VirtualNetworkName, VirtualNetworkResourceName)
-	locks.ByName(subnetId.SubnetName, SubnetResourceName)
+	if err := locks.ByNameWithContext(ctx, subnetId.SubnetName, SubnetResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(subnetId.SubnetName, SubnetResourceName)
 
 	subnet, err := client.Get(ctx, *subnetId, subnets.DefaultGetOperationOptions())
@@ -183,6 +190,7 @@ func resourceSubnetNatGatewayAssociationDelete(d *pluginsdk.ResourceData, meta i
 	client := meta.(*clients.Client).Network.Client.Subnets
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithOwner(ctx)
 
 	id, err := commonids.ParseSubnetID(d.Id())
 	if err != nil {
@@ -216,9 +224,13 @@ func resourceSubnetNatGatewayAssociationDelete(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
-	locks.ByName(gatewayId.NatGatewayName, natGatewayResourceName)
+	if err := locks.ByNameWithContext(ctx, gatewayId.NatGatewayName, natGatewayResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(gatewayId.NatGatewayName, natGatewayResourceName)
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ByNameWithContext(ctx, id.VirtualNetworkName, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 
 	subnet, err = client.Get(ctx, *id, subnets.DefaultGetOperationOptions())
@@ -60,6 +60,7 @@ func resourceSubnetNetworkSecurityGroupAssociationCreate(d *pluginsdk.ResourceDa
 	vnetClient := meta.(*clients.Client).Network.VirtualNetworks
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithOwner(ctx)
 
 	subnetId, err := commonids.ParseSubnetID(d.Get("subnet_id").(string))
 	if err != nil {
@@ -71,13 +72,19 @@ func resourceSubnetNetworkSecurityGroupAssociationCreate(d *pluginsdk.ResourceDa
 		return err
 	}
 
-	locks.ByName(networkSecurityGroupId.NetworkSecurityGroupName, networkSecurityGroupResourceName)
+	if err := locks.ByNameWithContext(ctx, networkSecurityGroupId.NetworkSecurityGroupName, networkSecurityGroupResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(networkSecurityGroupId.NetworkSecurityGroupName, networkSecurityGroupResourceName)
 
-	locks.ByName(subnetId.VirtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ByNameWithContext(ctx, subnetId.VirtualNetworkName, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(subnetId.VirtualNetworkName, VirtualNetworkResourceName)
 
-	locks.ByName(subnetId.SubnetName, SubnetResourceName)
+	if err := locks.ByNameWithContext(ctx, subnetId.SubnetName, SubnetResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(subnetId.SubnetName, SubnetResourceName)
 
 	subnet, err := client.Get(ctx, *subnetId, subnets.DefaultGetOperationOptions())
@@ -185,6 +192,7 @@ func resourceSubnetNetworkSecurityGroupAssociationDelete(d *pluginsdk.ResourceDa
 	client := meta.(*clients.Client).Network.Client.Subnets
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithOwner(ctx)
 
 	id, err := commonids.ParseSubnetID(d.Id())
 	if err != nil {
@@ -223,13 +231,19 @@ func resourceSubnetNetworkSecurityGroupAssociationDelete(d *pluginsdk.ResourceDa
 		return err
 	}
 
-	locks.ByName(networkSecurityGroupId.NetworkSecurityGroupName, networkSecurityGroupResourceName)
+	if err := locks.ByNameWithContext(ctx, networkSecurityGroupId.NetworkSecurityGroupName, networkSecurityGroupResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(networkSecurityGroupId.NetworkSecurityGroupName, networkSecurityGroupResourceName)
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ByNameWithContext(ctx, id.VirtualNetworkName, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 
-	locks.ByName(id.SubnetName, SubnetResourceName)
+	if err := locks.ByNameWithContext(ctx, id.SubnetName, SubnetResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.SubnetName, SubnetResourceName)
 
 	// then re-retrieve it to ensure we've got the latest state
@@ -304,6 +304,7 @@ func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithOwner(ctx)
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Subnet creation.")
 
@@ -319,7 +320,9 @@ func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		return tf.ImportAsExistsError("azurerm_subnet", id.ID())
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ByNameWithContext(ctx, id.VirtualNetworkName, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 
 	properties := subnets.SubnetPropertiesFormat{}
@@ -468,16 +471,21 @@ func resourceSubnetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	vnetClient := meta.(*clients.Client).Network.VirtualNetworks
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithOwner(ctx)
 
 	id, err := commonids.ParseSubnetID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ByNameWithContext(ctx, id.VirtualNetworkName, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 
-	locks.ByName(id.SubnetName, SubnetResourceName)
+	if err := locks.ByNameWithContext(ctx, id.SubnetName, SubnetResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.SubnetName, SubnetResourceName)
 
 	existing, err := client.Get(ctx, *id, subnets.DefaultGetOperationOptions())
@@ -691,16 +699,21 @@ func resourceSubnetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.Client.Subnets
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithOwner(ctx)
 
 	id, err := commonids.ParseSubnetID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ByNameWithContext(ctx, id.VirtualNetworkName, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 
-	locks.ByName(id.SubnetName, SubnetResourceName)
+	if err := locks.ByNameWithContext(ctx, id.SubnetName, SubnetResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.SubnetName, SubnetResourceName)
 
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
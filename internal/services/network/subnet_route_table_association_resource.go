@@ -60,6 +60,7 @@ func resourceSubnetRouteTableAssociationCreate(d *pluginsdk.ResourceData, meta i
 	vnetClient := meta.(*clients.Client).Network.VirtualNetworks
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithOwner(ctx)
 
 	log.Printf("[INFO] preparing arguments for Subnet <-> Route Table Association creation.")
 
@@ -73,10 +74,14 @@ func resourceSubnetRouteTableAssociationCreate(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
-	locks.ByName(routeTableId.RouteTableName, routeTableResourceName)
+	if err := locks.ByNameWithContext(ctx, routeTableId.RouteTableName, routeTableResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(routeTableId.RouteTableName, routeTableResourceName)
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ByNameWithContext(ctx, id.VirtualNetworkName, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 
 	subnet, err := client.Get(ctx, *id, subnets.DefaultGetOperationOptions())
@@ -184,6 +189,7 @@ func resourceSubnetRouteTableAssociationDelete(d *pluginsdk.ResourceData, meta i
 	client := meta.(*clients.Client).Network.Client.Subnets
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithOwner(ctx)
 
 	id, err := commonids.ParseSubnetID(d.Id())
 	if err != nil {
@@ -222,10 +228,14 @@ func resourceSubnetRouteTableAssociationDelete(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
-	locks.ByName(parsedRouteTableId.RouteTableName, routeTableResourceName)
+	if err := locks.ByNameWithContext(ctx, parsedRouteTableId.RouteTableName, routeTableResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(parsedRouteTableId.RouteTableName, routeTableResourceName)
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ByNameWithContext(ctx, id.VirtualNetworkName, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 
 	// then re-retrieve it to ensure we've got the latest state
@@ -14,11 +14,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/networkfunction/2022-11-01/azuretrafficcollectors"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/networkfunction/2022-11-01/collectorpolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
@@ -162,7 +162,7 @@ func (r NetworkFunctionCollectorPolicyResource) Create() sdk.ResourceFunc {
 					},
 				},
 
-				Tags: tags.Expand(model.Tags),
+				Tags: tags.ExpandPointer(model.Tags),
 			}
 
 			if err := client.CreateOrUpdateThenPoll(ctx, id, *properties); err != nil {
@@ -201,8 +201,8 @@ func (r NetworkFunctionCollectorPolicyResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("retrieving %s: properties was nil", id)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
-				properties.Tags = tags.Expand(model.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				properties.Tags = tags.ExpandPointer(model.Tags)
 			}
 
 			if err := client.CreateOrUpdateThenPoll(ctx, *id, *properties); err != nil {
@@ -249,7 +249,7 @@ func (r NetworkFunctionCollectorPolicyResource) Read() sdk.ResourceFunc {
 
 				}
 
-				state.Tags = tags.Flatten(model.Tags)
+				state.Tags = tags.FlattenPointer(model.Tags)
 			}
 
 			return metadata.Encode(&state)
@@ -702,7 +702,7 @@ func (m DeploymentResource) Update() sdk.ResourceFunc {
 				req.Sku = &nginxdeployment.ResourceSku{Name: model.Sku}
 			}
 
-			if meta.ResourceData.HasChange("tags") {
+			if meta.ResourceData.HasChanges("tags", "tags_all") {
 				req.Tags = pointer.FromMapOfStringStrings(model.Tags)
 			}
 
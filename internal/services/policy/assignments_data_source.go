@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	assignments "github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-06-01/policyassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type AssignmentsDataSource struct{}
+
+var _ sdk.ListDataSource = AssignmentsDataSource{}
+
+type AssignmentsDataSourceModel struct {
+	ScopeId string `tfschema:"scope_id"`
+}
+
+type AssignmentsDataSourceItemModel struct {
+	Id                 string   `tfschema:"id"`
+	Name               string   `tfschema:"name"`
+	Description        string   `tfschema:"description"`
+	DisplayName        string   `tfschema:"display_name"`
+	Enforce            bool     `tfschema:"enforce"`
+	Location           string   `tfschema:"location"`
+	NotScopes          []string `tfschema:"not_scopes"`
+	PolicyDefinitionId string   `tfschema:"policy_definition_id"`
+	Scope              string   `tfschema:"scope"`
+}
+
+func (AssignmentsDataSource) ResourceType() string {
+	return "azurerm_policy_assignments"
+}
+
+func (AssignmentsDataSource) ItemsAttribute() string {
+	return "policy_assignments"
+}
+
+func (AssignmentsDataSource) ItemModelObject() interface{} {
+	return &AssignmentsDataSourceItemModel{}
+}
+
+func (AssignmentsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"scope_id": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ValidateFunc: validation.Any(
+				commonids.ValidateManagementGroupID,
+				commonids.ValidateSubscriptionID,
+				commonids.ValidateResourceGroupID,
+				azure.ValidateResourceID,
+			),
+		},
+	}
+}
+
+func (AssignmentsDataSource) ItemAttributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"description": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"enforce": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"location": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"not_scopes": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"policy_definition_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"scope": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (AssignmentsDataSource) List() sdk.ListFunc {
+	return sdk.ListFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData, addPage sdk.ListPageFunc) error {
+			client := metadata.Client.Policy.AssignmentsClient
+
+			var state AssignmentsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the Policy Assignments within any scope are listed using the same endpoint, defaulting to the Subscription
+			scopeId := state.ScopeId
+			if scopeId == "" {
+				scopeId = commonids.NewSubscriptionID(metadata.Client.Account.SubscriptionId).ID()
+			}
+			id := commonids.NewScopeID(scopeId)
+
+			resp, err := client.ListForResourceComplete(ctx, id, assignments.DefaultListForResourceOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing Policy Assignments within %s: %+v", id, err)
+			}
+
+			page := make([]interface{}, 0, len(resp.Items))
+			for _, item := range resp.Items {
+				if item.Id == nil {
+					continue
+				}
+
+				model := AssignmentsDataSourceItemModel{
+					Id:       *item.Id,
+					Name:     pointer.From(item.Name),
+					Location: location.NormalizeNilable(item.Location),
+				}
+				if props := item.Properties; props != nil {
+					model.Description = pointer.From(props.Description)
+					model.DisplayName = pointer.From(props.DisplayName)
+					if mode := props.EnforcementMode; mode != nil {
+						model.Enforce = *mode == assignments.EnforcementModeDefault
+					}
+					model.NotScopes = pointer.From(props.NotScopes)
+					model.PolicyDefinitionId = pointer.From(props.PolicyDefinitionId)
+					model.Scope = pointer.From(props.Scope)
+				}
+				page = append(page, model)
+			}
+
+			return addPage(page...)
+		},
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type AssignmentsDataSource struct{}
+
+func TestAccDataSourceAssignments_resourceGroup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_policy_assignments", "test")
+	d := AssignmentsDataSource{}
+	r := ResourceGroupAssignmentTestResource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.resourceGroup(data, r),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("policy_assignments.#").HasValue("1"),
+				check.That(data.ResourceName).Key("policy_assignments.0.name").HasValue(fmt.Sprintf("acctestpa-rg-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("policy_assignments.0.enforce").HasValue("true"),
+				check.That(data.ResourceName).Key("policy_assignments.0.policy_definition_id").Exists(),
+			),
+		},
+	})
+}
+
+func (AssignmentsDataSource) resourceGroup(data acceptance.TestData, r ResourceGroupAssignmentTestResource) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_policy_assignments" "test" {
+  scope_id = azurerm_resource_group.test.id
+  name     = azurerm_resource_group_policy_assignment.test.name
+}
+`, r.withBuiltInPolicyBasic(data))
+}
@@ -22,6 +22,7 @@ type Registration struct{}
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		AssignmentDataSource{},
+		sdk.NewListDataSource(AssignmentsDataSource{}),
 	}
 }
 
@@ -9,12 +9,12 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2020-06-01/recordsets"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -112,7 +112,7 @@ func resourcePrivateDnsARecordCreateUpdate(d *pluginsdk.ResourceData, meta inter
 	parameters := recordsets.RecordSet{
 		Name: utils.String(id.RelativeRecordSetName),
 		Properties: &recordsets.RecordSetProperties{
-			Metadata: tags.Expand(d.Get("tags").(map[string]interface{})),
+			Metadata: tags.ExpandPointer(d.Get("tags").(map[string]interface{})),
 			Ttl:      utils.Int64(int64(d.Get("ttl").(int))),
 			ARecords: expandAzureRmPrivateDnsARecords(d),
 		},
@@ -162,7 +162,7 @@ func resourcePrivateDnsARecordRead(d *pluginsdk.ResourceData, meta interface{})
 				return err
 			}
 
-			return tags.FlattenAndSet(d, props.Metadata)
+			return tags.FlattenPointerAndSet(d, props.Metadata)
 		}
 	}
 
@@ -60,7 +60,7 @@ func (r SiteRecoveryReplicationRecoveryPlanDataSource) ResourceType() string {
 }
 
 func (r SiteRecoveryReplicationRecoveryPlanDataSource) ModelObject() interface{} {
-	return &SiteRecoveryReplicationRecoveryPlanModel{}
+	return &SiteRecoveryReplicationRecoveryPlanDataSourceModel{}
 }
 
 func (r SiteRecoveryReplicationRecoveryPlanDataSource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
@@ -71,7 +71,7 @@ func (r SiteRecoveryReplicationRecoveryPlanDataSource) Read() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			var metaModel SiteRecoveryReplicationRecoveryPlanModel
+			var metaModel SiteRecoveryReplicationRecoveryPlanDataSourceModel
 			if err := metadata.Decode(&metaModel); err != nil {
 				return fmt.Errorf("decoding %+v", err)
 			}
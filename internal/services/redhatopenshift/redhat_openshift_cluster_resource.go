@@ -446,7 +446,7 @@ func (r RedHatOpenShiftCluster) Update() sdk.ResourceFunc {
 
 			parameter := openshiftclusters.OpenShiftClusterUpdate{}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				parameter.Tags = pointer.To(state.Tags)
 			}
 
@@ -238,7 +238,7 @@ func subscriptionTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData, met
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		deployment.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
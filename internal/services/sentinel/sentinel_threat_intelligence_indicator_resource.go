@@ -604,7 +604,7 @@ func (r ThreatIntelligenceIndicator) Update() sdk.ResourceFunc {
 				properties.KillChainPhases = expandThreatIntelligenceKillChainPhaseModel(model.KillChainPhases)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				properties.Labels = &model.Labels
 			}
 
@@ -11,12 +11,12 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicenetworking/2023-11-01/frontendsinterface"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicenetworking/2023-11-01/trafficcontrollerinterface"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
@@ -117,7 +117,7 @@ func (f FrontendsResource) Create() sdk.ResourceFunc {
 			frontend := frontendsinterface.Frontend{
 				Location:   loc,
 				Properties: &frontendsinterface.FrontendProperties{},
-				Tags:       tags.Expand(config.Tags),
+				Tags:       tags.ExpandPointer(config.Tags),
 			}
 
 			if err := client.CreateOrUpdateThenPoll(ctx, id, frontend); err != nil {
@@ -156,7 +156,7 @@ func (f FrontendsResource) Read() sdk.ResourceFunc {
 			}
 
 			if model := resp.Model; model != nil {
-				state.Tags = tags.Flatten(model.Tags)
+				state.Tags = tags.FlattenPointer(model.Tags)
 
 				if prop := model.Properties; prop != nil {
 					state.Fqdn = pointer.From(prop.Fqdn)
@@ -186,8 +186,8 @@ func (f FrontendsResource) Update() sdk.ResourceFunc {
 
 			update := frontendsinterface.FrontendUpdate{}
 
-			if metadata.ResourceData.HasChange("tags") {
-				update.Tags = tags.Expand(config.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				update.Tags = tags.ExpandPointer(config.Tags)
 			}
 			if _, err := client.Update(ctx, *id, update); err != nil {
 				return fmt.Errorf("updating `azurerm_application_load_balancer_frontend` %s: %+v", *id, err)
@@ -12,11 +12,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicenetworking/2023-11-01/associationsinterface"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicenetworking/2023-11-01/trafficcontrollerinterface"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -108,7 +108,7 @@ func (t ApplicationLoadBalancerSubnetAssociationResource) Create() sdk.ResourceF
 					},
 					AssociationType: associationsinterface.AssociationTypeSubnets,
 				},
-				Tags: tags.Expand(config.Tags),
+				Tags: tags.ExpandPointer(config.Tags),
 			}
 
 			if err := client.CreateOrUpdateThenPoll(ctx, id, association); err != nil {
@@ -147,7 +147,7 @@ func (t ApplicationLoadBalancerSubnetAssociationResource) Read() sdk.ResourceFun
 			}
 
 			if model := resp.Model; model != nil {
-				state.Tags = tags.Flatten(model.Tags)
+				state.Tags = tags.FlattenPointer(model.Tags)
 
 				if prop := model.Properties; prop != nil {
 					if prop.Subnet != nil {
@@ -185,8 +185,8 @@ func (t ApplicationLoadBalancerSubnetAssociationResource) Update() sdk.ResourceF
 			// Tracked on https://github.com/Azure/azure-rest-api-specs/issues/26657
 			associationUpdate := associationsinterface.AssociationUpdate{}
 
-			if metadata.ResourceData.HasChange("tags") {
-				associationUpdate.Tags = tags.Expand(config.Tags)
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
+				associationUpdate.Tags = tags.ExpandPointer(config.Tags)
 			}
 
 			if _, err = client.Update(ctx, *id, associationUpdate); err != nil {
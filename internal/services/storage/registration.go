@@ -77,6 +77,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 		storageTableDataSource{},
 		storageTableEntitiesDataSource{},
 		storageContainersDataSource{},
+		sdk.NewListDataSource(StorageDeletedAccountsDataSource{}),
 	}
 }
 
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/deletedaccounts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+)
+
+// StorageAccountsClient works around the Storage Account create payload not exposing the fields
+// required to recover a soft-deleted Storage Account, which are only documented on the Deleted Account
+type StorageAccountsClient struct {
+	client *storageaccounts.StorageAccountsClient
+}
+
+func NewStorageAccountsClient(client *storageaccounts.StorageAccountsClient) StorageAccountsClient {
+	return StorageAccountsClient{
+		client: client,
+	}
+}
+
+// RecoverThenPoll recovers the soft-deleted Storage Account `deleted` by creating the Storage Account `id` using the
+// `restoreReference` and `creationTime` of the Deleted Account, then polls until the Storage Account has been recovered
+func (c StorageAccountsClient) RecoverThenPoll(ctx context.Context, id commonids.StorageAccountId, input storageaccounts.StorageAccountCreateParameters, deleted deletedaccounts.DeletedAccountProperties) error {
+	if deleted.RestoreReference == nil || deleted.CreationTime == nil {
+		return fmt.Errorf("the Deleted Account for %s is missing the `restoreReference` and/or `creationTime` required to recover it", id)
+	}
+
+	payload, err := recoverPayload(input, deleted)
+	if err != nil {
+		return fmt.Errorf("building the payload to recover %s: %+v", id, err)
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.client.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("building request: %+v", err)
+	}
+
+	if err = req.Marshal(payload); err != nil {
+		return fmt.Errorf("marshaling request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing Create: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.client.Client)
+	if err != nil {
+		return fmt.Errorf("building poller: %+v", err)
+	}
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after Create: %+v", err)
+	}
+
+	return nil
+}
+
+// recoverPayload returns the Storage Account create payload including the `restoreReference` and
+// `deletedAccountCreationTime` of the Deleted Account within the `properties` block
+func recoverPayload(input storageaccounts.StorageAccountCreateParameters, deleted deletedaccounts.DeletedAccountProperties) (map[string]interface{}, error) {
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := make(map[string]interface{})
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, err
+	}
+
+	properties, ok := payload["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+	}
+	properties["restoreReference"] = *deleted.RestoreReference
+	properties["deletedAccountCreationTime"] = *deleted.CreationTime
+	payload["properties"] = properties
+
+	return payload, nil
+}
@@ -18,8 +18,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/edgezones"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/blobservice"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/deletedaccounts"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/fileservice"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
@@ -36,7 +36,9 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -1305,6 +1307,26 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		return tf.ImportAsExistsError("azurerm_storage_account", id.ID())
 	}
 
+	var softDeleted *deletedaccounts.DeletedAccountProperties
+	if meta.(*clients.Client).Features.StorageAccount.RecoverSoftDeleted {
+		deletedId := deletedaccounts.NewDeletedAccountID(subscriptionId, location.Normalize(d.Get("location").(string)), id.StorageAccountName)
+		deleted, err := storageClient.ResourceManager.DeletedAccounts.Get(ctx, deletedId)
+		if err != nil {
+			if !response.WasNotFound(deleted.HttpResponse) {
+				return fmt.Errorf("checking for presence of soft-deleted %s: %+v", deletedId, err)
+			}
+			// if the soft-deleted Storage Account isn't found, create it as usual
+		} else if deleted.Model != nil && deleted.Model.Properties != nil {
+			// a soft-deleted Storage Account can only be recovered into the Resource Group it was deleted from
+			if deletedFrom := pointer.From(deleted.Model.Properties.StorageAccountResourceId); !strings.EqualFold(deletedFrom, id.ID()) {
+				return fmt.Errorf("the soft-deleted %s can only be recovered as %q", deletedId, deletedFrom)
+			}
+
+			log.Printf("[DEBUG] Soft-Deleted %s exists, marked for recovery", id)
+			softDeleted = deleted.Model.Properties
+		}
+	}
+
 	accountKind := storageaccounts.Kind(d.Get("account_kind").(string))
 	accountTier := storageaccounts.SkuTier(d.Get("account_tier").(string))
 	replicationType := d.Get("account_replication_type").(string)
@@ -1357,7 +1379,7 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 			Name: storageaccounts.SkuName(fmt.Sprintf("%s_%s", string(accountTier), replicationType)),
 			Tier: pointer.To(accountTier),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandPointer(d.Get("tags").(map[string]interface{})),
 	}
 
 	if v := d.Get("allowed_copy_scope").(string); v != "" {
@@ -1468,7 +1490,11 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 
 	payload.Properties.Encryption = encryption
 
-	if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+	if softDeleted != nil {
+		if err := sdkhacks.NewStorageAccountsClient(client).RecoverThenPoll(ctx, id, payload, *softDeleted); err != nil {
+			return fmt.Errorf("recovering soft-deleted %s: %+v", id, err)
+		}
+	} else if err := client.CreateThenPoll(ctx, id, payload); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
@@ -1823,8 +1849,8 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	if d.HasChange("identity") {
 		payload.Identity = expandedIdentity
 	}
-	if d.HasChange("tags") {
-		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		payload.Tags = tags.ExpandPointer(d.Get("tags").(map[string]interface{}))
 	}
 
 	if err := client.CreateThenPoll(ctx, *id, payload); err != nil {
@@ -2192,7 +2218,7 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 			return fmt.Errorf("setting `identity`: %+v", err)
 		}
 
-		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+		if err := tags.FlattenPointerAndSet(d, model.Tags); err != nil {
 			return err
 		}
 	}
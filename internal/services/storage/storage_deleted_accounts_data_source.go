@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StorageDeletedAccountsDataSource struct{}
+
+var _ sdk.ListDataSource = StorageDeletedAccountsDataSource{}
+
+type StorageDeletedAccountsDataSourceItemModel struct {
+	Id               string `tfschema:"id"`
+	Name             string `tfschema:"name"`
+	CreationTime     string `tfschema:"creation_time"`
+	DeletionTime     string `tfschema:"deletion_time"`
+	Location         string `tfschema:"location"`
+	RestoreReference string `tfschema:"restore_reference"`
+	StorageAccountId string `tfschema:"storage_account_id"`
+}
+
+func (StorageDeletedAccountsDataSource) ResourceType() string {
+	return "azurerm_storage_deleted_accounts"
+}
+
+func (StorageDeletedAccountsDataSource) ItemsAttribute() string {
+	return "deleted_accounts"
+}
+
+func (StorageDeletedAccountsDataSource) ItemModelObject() interface{} {
+	return &StorageDeletedAccountsDataSourceItemModel{}
+}
+
+func (StorageDeletedAccountsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (StorageDeletedAccountsDataSource) ItemAttributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"creation_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"deletion_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"location": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"restore_reference": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"storage_account_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (StorageDeletedAccountsDataSource) List() sdk.ListFunc {
+	return sdk.ListFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData, addPage sdk.ListPageFunc) error {
+			client := metadata.Client.Storage.ResourceManager.DeletedAccounts
+
+			id := commonids.NewSubscriptionID(metadata.Client.Account.SubscriptionId)
+			resp, err := client.ListComplete(ctx, id)
+			if err != nil {
+				return fmt.Errorf("listing Deleted Storage Accounts within %s: %+v", id, err)
+			}
+
+			page := make([]interface{}, 0, len(resp.Items))
+			for _, item := range resp.Items {
+				if item.Id == nil {
+					continue
+				}
+
+				model := StorageDeletedAccountsDataSourceItemModel{
+					Id:   *item.Id,
+					Name: pointer.From(item.Name),
+				}
+				if props := item.Properties; props != nil {
+					model.CreationTime = pointer.From(props.CreationTime)
+					model.DeletionTime = pointer.From(props.DeletionTime)
+					model.Location = location.NormalizeNilable(props.Location)
+					model.RestoreReference = pointer.From(props.RestoreReference)
+					model.StorageAccountId = pointer.From(props.StorageAccountResourceId)
+				}
+				page = append(page, model)
+			}
+
+			return addPage(page...)
+		},
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type StorageDeletedAccountsDataSource struct{}
+
+func TestAccStorageDeletedAccountsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_deleted_accounts", "test")
+	r := StorageDeletedAccountsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: StorageAccountResource{}.basic(data),
+		},
+		{
+			// delete the storage account, which is soft-deleted
+			Config: StorageAccountResource{}.softDeletedAbsent(data),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("deleted_accounts.#").HasValue("1"),
+				check.That(data.ResourceName).Key("deleted_accounts.0.name").HasValue(fmt.Sprintf("unlikely23exst2acct%s", data.RandomString)),
+				check.That(data.ResourceName).Key("deleted_accounts.0.restore_reference").Exists(),
+				check.That(data.ResourceName).Key("deleted_accounts.0.storage_account_id").Exists(),
+			),
+		},
+	})
+}
+
+func (StorageDeletedAccountsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_deleted_accounts" "test" {
+  name = "unlikely23exst2acct%s"
+}
+`, StorageAccountResource{}.softDeletedAbsent(data), data.RandomString)
+}
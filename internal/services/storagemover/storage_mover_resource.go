@@ -28,11 +28,16 @@ type StorageMoverModel struct {
 type StorageMoverResource struct{}
 
 var _ sdk.ResourceWithUpdate = StorageMoverResource{}
+var _ sdk.ResourceWithAzureResourceType = StorageMoverResource{}
 
 func (r StorageMoverResource) ResourceType() string {
 	return "azurerm_storage_mover"
 }
 
+func (r StorageMoverResource) AzureResourceType() string {
+	return "Microsoft.StorageMover/storageMovers"
+}
+
 func (r StorageMoverResource) ModelObject() interface{} {
 	return &StorageMoverModel{}
 }
@@ -142,7 +147,7 @@ func (r StorageMoverResource) Update() sdk.ResourceFunc {
 				properties.Properties.Description = &model.Description
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				properties.Tags = &model.Tags
 			}
 
@@ -11,10 +11,10 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/clusters"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/migration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
@@ -105,7 +105,7 @@ func (r ClusterResource) Create() sdk.ResourceFunc {
 					Name:     pointer.To(clusters.ClusterSkuNameDefault),
 					Capacity: pointer.To(model.StreamingCapacity),
 				},
-				Tags: tags.Expand(model.Tags),
+				Tags: tags.ExpandPointer(model.Tags),
 			}
 
 			var opts clusters.CreateOrUpdateOperationOptions
@@ -146,7 +146,7 @@ func (r ClusterResource) Read() sdk.ResourceFunc {
 
 			if model := resp.Model; model != nil {
 				state.Location = *model.Location
-				state.Tags = tags.Flatten(model.Tags)
+				state.Tags = tags.FlattenPointer(model.Tags)
 
 				var capacity int64
 				if v := model.Sku.Capacity; v != nil {
@@ -197,12 +197,12 @@ func (r ClusterResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
-			if metadata.ResourceData.HasChange("streaming_capacity") || metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChange("streaming_capacity") || metadata.ResourceData.HasChanges("tags", "tags_all") {
 				props := clusters.Cluster{
 					Sku: &clusters.ClusterSku{
 						Capacity: pointer.To(state.StreamingCapacity),
 					},
-					Tags: tags.Expand(state.Tags),
+					Tags: tags.ExpandPointer(state.Tags),
 				}
 
 				var opts clusters.UpdateOperationOptions
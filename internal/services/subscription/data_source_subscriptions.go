@@ -10,8 +10,8 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
@@ -137,7 +137,7 @@ func dataSourceSubscriptionsRead(d *pluginsdk.ResourceData, meta interface{}) er
 			"spending_limit":        spendingLimit,
 			"state":                 string(pointer.From(item.State)),
 			"subscription_id":       pointer.From(item.SubscriptionId),
-			"tags":                  tags.Flatten(item.Tags),
+			"tags":                  tags.FlattenPointer(item.Tags),
 			"tenant_id":             pointer.From(item.TenantId),
 		})
 	}
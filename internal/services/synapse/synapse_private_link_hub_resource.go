@@ -128,7 +128,7 @@ func resourceSynapsePrivateLinkHubUpdate(d *pluginsdk.ResourceData, meta interfa
 		return err
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		privateLinkHubPatchInfo := synapse.PrivateLinkHubPatchInfo{
 			Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 		}
@@ -181,7 +181,7 @@ func (r SystemCenterVirtualMachineManagerAvailabilitySetResource) Update() sdk.R
 
 			parameters := availabilitysets.AvailabilitySetTagsUpdate{}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				parameters.Tags = pointer.To(model.Tags)
 			}
 
@@ -179,7 +179,7 @@ func (r SystemCenterVirtualMachineManagerCloudResource) Update() sdk.ResourceFun
 
 			parameters := clouds.CloudTagsUpdate{}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				parameters.Tags = pointer.To(model.Tags)
 			}
 
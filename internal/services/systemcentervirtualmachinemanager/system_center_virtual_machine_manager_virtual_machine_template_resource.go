@@ -179,7 +179,7 @@ func (r SystemCenterVirtualMachineManagerVirtualMachineTemplateResource) Update(
 
 			parameters := virtualmachinetemplates.VirtualMachineTemplateTagsUpdate{}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				parameters.Tags = pointer.To(model.Tags)
 			}
 
@@ -178,7 +178,7 @@ func (r SystemCenterVirtualMachineManagerVirtualNetworkResource) Update() sdk.Re
 
 			parameters := virtualnetworks.VirtualNetworkTagsUpdate{}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				parameters.Tags = pointer.To(model.Tags)
 			}
 
@@ -369,7 +369,7 @@ func (r CommunicationsGatewayResource) Update() sdk.ResourceFunc {
 				properties.Properties.OnPremMcpEnabled = &model.OnPremMcpEnabled
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				properties.Tags = &model.Tags
 			}
 
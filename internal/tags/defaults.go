@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"strings"
+	"sync"
+)
+
+// ProviderDefaults defines the `default_tags` and `ignore_tags` configured in the Provider block
+type ProviderDefaults struct {
+	// DefaultTags are merged into the tags of every resource exposing `tags_all`, where a tag
+	// with the same key defined on the resource takes precedence
+	DefaultTags map[string]string
+
+	// IgnoreKeys are tag keys which are removed from the tags returned from the API
+	IgnoreKeys []string
+
+	// IgnoreKeyPrefixes are tag key prefixes which are removed from the tags returned from the API
+	IgnoreKeyPrefixes []string
+}
+
+var (
+	providerDefaults     ProviderDefaults
+	providerDefaultsLock sync.RWMutex
+)
+
+// ConfigureProviderDefaults sets the `default_tags` and `ignore_tags` used by the Expand/Flatten functions within
+// this package, which is called when the Provider is configured. Terraform runs a separate instance of the Provider
+// for each Provider block, so these apply to every resource managed by this instance of the Provider.
+func ConfigureProviderDefaults(input ProviderDefaults) {
+	providerDefaultsLock.Lock()
+	defer providerDefaultsLock.Unlock()
+
+	providerDefaults = input
+}
+
+// CurrentProviderDefaults returns the `default_tags` and `ignore_tags` configured in the Provider block
+func CurrentProviderDefaults() ProviderDefaults {
+	providerDefaultsLock.RLock()
+	defer providerDefaultsLock.RUnlock()
+
+	return providerDefaults
+}
+
+// IsIgnored returns whether the specified tag key should be ignored as defined in the `ignore_tags` block
+func (p ProviderDefaults) IsIgnored(key string) bool {
+	for _, v := range p.IgnoreKeys {
+		if strings.EqualFold(v, key) {
+			return true
+		}
+	}
+
+	for _, v := range p.IgnoreKeyPrefixes {
+		if v != "" && strings.HasPrefix(strings.ToLower(key), strings.ToLower(v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsDefault returns whether the specified tag key and value match a tag defined in the `default_tags` block
+func (p ProviderDefaults) IsDefault(key, value string) bool {
+	defaultValue, ok := p.DefaultTags[key]
+	return ok && defaultValue == value
+}
+
+// Merge returns the effective set of tags for a resource, being the `default_tags` overridden by
+// the specified tags
+func (p ProviderDefaults) Merge(input map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(input)+len(p.DefaultTags))
+	for k, v := range p.DefaultTags {
+		output[k] = v
+	}
+	for k, v := range input {
+		value, _ := TagValueToString(v)
+		output[k] = value
+	}
+
+	return output
+}
+
+// mergeDefaults returns a copy of the input with any `default_tags` not already present added
+func (p ProviderDefaults) mergeDefaults(input map[string]*string) map[string]*string {
+	if len(p.DefaultTags) == 0 {
+		return input
+	}
+
+	output := make(map[string]*string, len(input)+len(p.DefaultTags))
+	for k, v := range p.DefaultTags {
+		value := v
+		output[k] = &value
+	}
+	for k, v := range input {
+		output[k] = v
+	}
+
+	return output
+}
+
+// RemoveIgnored returns a copy of the input without any tags matching the `ignore_tags`
+func (p ProviderDefaults) RemoveIgnored(input map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(input))
+	for k, v := range input {
+		if p.IsIgnored(k) {
+			continue
+		}
+		output[k] = v
+	}
+
+	return output
+}
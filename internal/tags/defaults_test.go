@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestProviderDefaultsIsIgnored(t *testing.T) {
+	defaults := ProviderDefaults{
+		IgnoreKeys:        []string{"CreatedBy"},
+		IgnoreKeyPrefixes: []string{"policy-"},
+	}
+
+	testData := map[string]bool{
+		"createdby":     true,
+		"CreatedBy":     true,
+		"hello":         false,
+		"policy-owner":  true,
+		"Policy-Region": true,
+		"policy":        false,
+	}
+
+	for key, expected := range testData {
+		t.Logf("[DEBUG] Testing %q", key)
+
+		if actual := defaults.IsIgnored(key); actual != expected {
+			t.Fatalf("Expected %t but got %t for %q", expected, actual, key)
+		}
+	}
+}
+
+func TestProviderDefaultsMerge(t *testing.T) {
+	defaults := ProviderDefaults{
+		DefaultTags: map[string]string{
+			"environment": "production",
+			"team":        "platform",
+		},
+	}
+
+	testData := []struct {
+		Name     string
+		Input    map[string]interface{}
+		Expected map[string]interface{}
+	}{
+		{
+			Name:  "Empty",
+			Input: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"environment": "production",
+				"team":        "platform",
+			},
+		},
+		{
+			Name: "Additional Tag",
+			Input: map[string]interface{}{
+				"hello": "world",
+			},
+			Expected: map[string]interface{}{
+				"environment": "production",
+				"hello":       "world",
+				"team":        "platform",
+			},
+		},
+		{
+			Name: "Overridden Tag",
+			Input: map[string]interface{}{
+				"environment": "staging",
+			},
+			Expected: map[string]interface{}{
+				"environment": "staging",
+				"team":        "platform",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q", v.Name)
+
+		if actual := defaults.Merge(v.Input); !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestProviderDefaultsRemoveIgnored(t *testing.T) {
+	defaults := ProviderDefaults{
+		IgnoreKeys:        []string{"CreatedBy"},
+		IgnoreKeyPrefixes: []string{"policy-"},
+	}
+
+	input := map[string]interface{}{
+		"createdby":    "someone",
+		"hello":        "world",
+		"policy-owner": "security",
+	}
+	expected := map[string]interface{}{
+		"hello": "world",
+	}
+
+	if actual := defaults.RemoveIgnored(input); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+// configureTestProviderDefaults configures the `default_tags` and `ignore_tags` used by the Expand/Flatten functions
+// for the duration of the test
+func configureTestProviderDefaults(t *testing.T) {
+	ConfigureProviderDefaults(ProviderDefaults{
+		DefaultTags: map[string]string{
+			"environment": "production",
+			"team":        "platform",
+		},
+		IgnoreKeys:        []string{"CreatedBy"},
+		IgnoreKeyPrefixes: []string{"policy-"},
+	})
+	t.Cleanup(func() {
+		ConfigureProviderDefaults(ProviderDefaults{})
+	})
+}
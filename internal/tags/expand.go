@@ -3,7 +3,15 @@
 
 package tags
 
+// Expand converts the tags defined in the Schema into the format used by the API, merging in any `default_tags`
+// configured in the Provider block
 func Expand(tagsMap map[string]interface{}) map[string]*string {
+	return CurrentProviderDefaults().mergeDefaults(ExpandWithoutDefaults(tagsMap))
+}
+
+// ExpandWithoutDefaults converts the tags defined in the Schema into the format used by the API, without merging in
+// the `default_tags` - for tags which don't belong to the resource itself, such as a filter or a nested item
+func ExpandWithoutDefaults(tagsMap map[string]interface{}) map[string]*string {
 	output := make(map[string]*string, len(tagsMap))
 
 	for i, v := range tagsMap {
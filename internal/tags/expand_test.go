@@ -5,6 +5,7 @@ package tags
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -34,3 +35,29 @@ func TestExpand(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandWithProviderDefaults(t *testing.T) {
+	configureTestProviderDefaults(t)
+
+	input := map[string]interface{}{
+		"environment": "staging",
+		"hello":       "world",
+	}
+
+	expected := map[string]string{
+		"environment": "staging",
+		"hello":       "world",
+		"team":        "platform",
+	}
+	if actual := ToTypedObject(Expand(input)); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+
+	expected = map[string]string{
+		"environment": "staging",
+		"hello":       "world",
+	}
+	if actual := ToTypedObject(ExpandWithoutDefaults(input)); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v without the defaults but got %+v", expected, actual)
+	}
+}
@@ -9,7 +9,15 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+// Flatten converts the tags returned from the API into the format used in the Schema, removing any tags matching
+// the `ignore_tags` configured in the Provider block
 func Flatten(tagMap map[string]*string) map[string]interface{} {
+	return CurrentProviderDefaults().RemoveIgnored(FlattenWithoutIgnoring(tagMap))
+}
+
+// FlattenWithoutIgnoring converts the tags returned from the API into the format used in the Schema, without removing
+// the tags matching the `ignore_tags` - for tags which don't belong to the resource itself, such as a nested item
+func FlattenWithoutIgnoring(tagMap map[string]*string) map[string]interface{} {
 	// If tagsMap is nil, len(tagsMap) will be 0.
 	output := make(map[string]interface{}, len(tagMap))
 
@@ -24,6 +32,8 @@ func Flatten(tagMap map[string]*string) map[string]interface{} {
 	return output
 }
 
+// FlattenAndSet sets the tags returned from the API into `tags`, removing any tags matching the `ignore_tags`
+// configured in the Provider block
 func FlattenAndSet(d *pluginsdk.ResourceData, tagMap map[string]*string) error {
 	flattened := Flatten(tagMap)
 	if err := d.Set("tags", flattened); err != nil {
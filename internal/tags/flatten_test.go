@@ -54,3 +54,32 @@ func TestFlatten(t *testing.T) {
 		}
 	}
 }
+
+func TestFlattenWithProviderDefaults(t *testing.T) {
+	configureTestProviderDefaults(t)
+
+	input := map[string]*string{
+		"CreatedBy":    utils.String("someone"),
+		"environment":  utils.String("production"),
+		"hello":        utils.String("world"),
+		"policy-owner": utils.String("security"),
+	}
+
+	expected := map[string]interface{}{
+		"environment": "production",
+		"hello":       "world",
+	}
+	if actual := Flatten(input); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+
+	expected = map[string]interface{}{
+		"CreatedBy":    "someone",
+		"environment":  "production",
+		"hello":        "world",
+		"policy-owner": "security",
+	}
+	if actual := FlattenWithoutIgnoring(input); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v including the ignored tags but got %+v", expected, actual)
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// ExpandPointer converts the tags defined in the Schema into the `*map[string]string` format used by the newer
+// API SDKs, merging in any `default_tags` configured in the Provider block
+func ExpandPointer(tagsMap map[string]interface{}) *map[string]string {
+	output := make(map[string]string, len(tagsMap))
+
+	for k, v := range Expand(tagsMap) {
+		if v == nil {
+			continue
+		}
+
+		output[k] = *v
+	}
+
+	return &output
+}
+
+// FlattenPointer converts the tags returned from the newer API SDKs as a `*map[string]string` into the format used
+// in the Schema, removing any tags matching the `ignore_tags` configured in the Provider block
+func FlattenPointer(tagMap *map[string]string) map[string]interface{} {
+	if tagMap == nil {
+		return make(map[string]interface{})
+	}
+
+	output := make(map[string]*string, len(*tagMap))
+	for k, v := range *tagMap {
+		value := v
+		output[k] = &value
+	}
+
+	return Flatten(output)
+}
+
+// FlattenPointerAndSet sets the tags returned from the newer API SDKs as a `*map[string]string` into `tags`,
+// removing any tags matching the `ignore_tags` configured in the Provider block
+func FlattenPointerAndSet(d *pluginsdk.ResourceData, tagMap *map[string]string) error {
+	if err := d.Set("tags", FlattenPointer(tagMap)); err != nil {
+		return fmt.Errorf("setting `tags`: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandPointer(t *testing.T) {
+	if actual := ExpandPointer(map[string]interface{}{"hello": "world"}); !reflect.DeepEqual(*actual, map[string]string{"hello": "world"}) {
+		t.Fatalf("Expected %+v but got %+v", map[string]string{"hello": "world"}, *actual)
+	}
+
+	configureTestProviderDefaults(t)
+
+	input := map[string]interface{}{
+		"environment": "staging",
+		"hello":       "world",
+	}
+	expected := map[string]string{
+		"environment": "staging",
+		"hello":       "world",
+		"team":        "platform",
+	}
+	if actual := ExpandPointer(input); !reflect.DeepEqual(*actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, *actual)
+	}
+}
+
+func TestFlattenPointer(t *testing.T) {
+	if actual := FlattenPointer(nil); len(actual) != 0 {
+		t.Fatalf("Expected no tags but got %+v", actual)
+	}
+
+	configureTestProviderDefaults(t)
+
+	input := map[string]string{
+		"CreatedBy":    "someone",
+		"environment":  "production",
+		"hello":        "world",
+		"policy-owner": "security",
+	}
+	expected := map[string]interface{}{
+		"environment": "production",
+		"hello":       "world",
+	}
+	if actual := FlattenPointer(&input); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
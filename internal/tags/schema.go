@@ -65,3 +65,18 @@ func SchemaEnforceLowerCaseKeys() *pluginsdk.Schema {
 		},
 	}
 }
+
+// SchemaTagsAll returns the Schema used for the computed `tags_all` field, which contains the effective
+// set of tags for a resource including those inherited from the Provider `default_tags` block.
+//
+// This is added to every Resource defining a configurable `tags` map when the Provider is built, so Resources
+// needn't define it themselves - but must check `HasChanges("tags", "tags_all")` when determining whether to update tags.
+func SchemaTagsAll() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeMap,
+		Computed: true,
+		Elem: &pluginsdk.Schema{
+			Type: pluginsdk.TypeString,
+		},
+	}
+}
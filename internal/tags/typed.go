@@ -3,6 +3,8 @@
 
 package tags
 
+// FromTypedObject converts the tags defined in a Typed Model into the format used by the API, merging in any
+// `default_tags` configured in the Provider block
 func FromTypedObject(input map[string]string) map[string]*string {
 	output := make(map[string]*string, len(input))
 
@@ -12,14 +14,17 @@ func FromTypedObject(input map[string]string) map[string]*string {
 		output[k] = &value
 	}
 
-	return output
+	return CurrentProviderDefaults().mergeDefaults(output)
 }
 
+// ToTypedObject converts the tags returned from the API into the format used in a Typed Model, removing any tags
+// matching the `ignore_tags` configured in the Provider block
 func ToTypedObject(input map[string]*string) map[string]string {
 	output := make(map[string]string)
 
+	defaults := CurrentProviderDefaults()
 	for k, v := range input {
-		if v == nil {
+		if v == nil || defaults.IsIgnored(k) {
 			continue
 		}
 
@@ -74,3 +74,32 @@ func TestToTypedObject(t *testing.T) {
 		}
 	}
 }
+
+func TestTypedObjectWithProviderDefaults(t *testing.T) {
+	configureTestProviderDefaults(t)
+
+	expanded := FromTypedObject(map[string]string{
+		"environment": "staging",
+		"hello":       "world",
+	})
+	expected := map[string]string{
+		"environment": "staging",
+		"hello":       "world",
+		"team":        "platform",
+	}
+	if actual := ToTypedObject(expanded); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+
+	flattened := ToTypedObject(map[string]*string{
+		"CreatedBy":    utils.String("someone"),
+		"hello":        utils.String("world"),
+		"policy-owner": utils.String("security"),
+	})
+	expected = map[string]string{
+		"hello": "world",
+	}
+	if !reflect.DeepEqual(flattened, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, flattened)
+	}
+}
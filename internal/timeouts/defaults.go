@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// Defaults defines the default timeout for each operation, where a nil value isn't configured
+type Defaults struct {
+	Create *time.Duration
+	Read   *time.Duration
+	Update *time.Duration
+	Delete *time.Duration
+}
+
+// ProviderDefaults defines the `default_timeouts` configured in the Provider block
+type ProviderDefaults struct {
+	// Global are the default timeouts for every Resource
+	Global Defaults
+
+	// ResourceTypes are the default timeouts for a specific Resource Type (e.g. `azurerm_resource_group`),
+	// which take precedence over the Global defaults
+	ResourceTypes map[string]Defaults
+}
+
+// Resolve returns the default timeouts for the specified Resource Type, where (for each operation) the
+// default for the Resource Type takes precedence over the global default, which takes precedence over
+// the hard-coded default for the Resource. Operations which the Resource doesn't support are left unset.
+//
+// NOTE: a `timeouts` block within the Resource takes precedence over all of these, which Terraform handles
+func (p ProviderDefaults) Resolve(resourceType string, hardCoded pluginsdk.ResourceTimeout) *pluginsdk.ResourceTimeout {
+	forType := p.ResourceTypes[resourceType]
+	resolve := func(hardCoded, global, forResourceType *time.Duration) *time.Duration {
+		if hardCoded == nil {
+			return nil
+		}
+		if forResourceType != nil {
+			return forResourceType
+		}
+		if global != nil {
+			return global
+		}
+		return hardCoded
+	}
+
+	return &pluginsdk.ResourceTimeout{
+		Create:  resolve(hardCoded.Create, p.Global.Create, forType.Create),
+		Read:    resolve(hardCoded.Read, p.Global.Read, forType.Read),
+		Update:  resolve(hardCoded.Update, p.Global.Update, forType.Update),
+		Delete:  resolve(hardCoded.Delete, p.Global.Delete, forType.Delete),
+		Default: hardCoded.Default,
+	}
+}
+
+// ResolveOperation returns the default timeout for the specified operation (`create`, `read`, `update` or `delete`)
+// of the specified Resource Type, in the same manner as Resolve - for Resources which aren't built using the Plugin
+// SDK, and so aren't updated by ApplyProviderDefaults
+func (p ProviderDefaults) ResolveOperation(resourceType string, operation string, hardCoded time.Duration) time.Duration {
+	if v := p.ResourceTypes[resourceType].forOperation(operation); v != nil {
+		return *v
+	}
+	if v := p.Global.forOperation(operation); v != nil {
+		return *v
+	}
+
+	return hardCoded
+}
+
+func (d Defaults) forOperation(operation string) *time.Duration {
+	switch operation {
+	case pluginsdk.TimeoutCreate:
+		return d.Create
+	case pluginsdk.TimeoutRead:
+		return d.Read
+	case pluginsdk.TimeoutUpdate:
+		return d.Update
+	case pluginsdk.TimeoutDelete:
+		return d.Delete
+	}
+
+	return nil
+}
+
+var (
+	// hardCodedDefaults contains the hard-coded timeouts for each Resource, prior to ApplyProviderDefaults
+	// being called, so that these can be re-resolved should the Provider be configured more than once
+	hardCodedDefaults     = map[*pluginsdk.Resource]pluginsdk.ResourceTimeout{}
+	hardCodedDefaultsLock = &sync.Mutex{}
+)
+
+// ApplyProviderDefaults updates the default timeouts for each of the specified Resources to take into account
+// the `default_timeouts` configured in the Provider block. Since Terraform resolves these default timeouts
+// when planning a Resource, this must be called when the Provider is configured.
+func ApplyProviderDefaults(resources map[string]*pluginsdk.Resource, defaults ProviderDefaults) {
+	hardCodedDefaultsLock.Lock()
+	defer hardCodedDefaultsLock.Unlock()
+
+	for resourceType, resource := range resources {
+		if resource == nil || resource.Timeouts == nil {
+			// Resources without timeouts don't support a `timeouts` block, so there's nothing to default
+			continue
+		}
+
+		hardCoded, ok := hardCodedDefaults[resource]
+		if !ok {
+			hardCoded = *resource.Timeouts
+			hardCodedDefaults[resource] = hardCoded
+		}
+
+		resource.Timeouts = defaults.Resolve(resourceType, hardCoded)
+	}
+}
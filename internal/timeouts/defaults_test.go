@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func duration(d time.Duration) *time.Duration {
+	return &d
+}
+
+func TestProviderDefaultsResolve(t *testing.T) {
+	hardCoded := pluginsdk.ResourceTimeout{
+		Create: duration(30 * time.Minute),
+		Read:   duration(5 * time.Minute),
+		Delete: duration(30 * time.Minute),
+	}
+	defaults := ProviderDefaults{
+		Global: Defaults{
+			Create: duration(60 * time.Minute),
+			Update: duration(60 * time.Minute),
+		},
+		ResourceTypes: map[string]Defaults{
+			"azurerm_linux_virtual_machine": {
+				Create: duration(90 * time.Minute),
+				Delete: duration(45 * time.Minute),
+			},
+		},
+	}
+
+	testData := []struct {
+		Name         string
+		ResourceType string
+		Expected     pluginsdk.ResourceTimeout
+	}{
+		{
+			Name:         "Global Defaults",
+			ResourceType: "azurerm_resource_group",
+			Expected: pluginsdk.ResourceTimeout{
+				Create: duration(60 * time.Minute),
+				Read:   duration(5 * time.Minute),
+				Delete: duration(30 * time.Minute),
+			},
+		},
+		{
+			// the Update timeout isn't set since the Resource doesn't support Update
+			Name:         "Resource Type Defaults",
+			ResourceType: "azurerm_linux_virtual_machine",
+			Expected: pluginsdk.ResourceTimeout{
+				Create: duration(90 * time.Minute),
+				Read:   duration(5 * time.Minute),
+				Delete: duration(45 * time.Minute),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := defaults.Resolve(v.ResourceType, hardCoded)
+		if !reflect.DeepEqual(*actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, *actual)
+		}
+	}
+
+	if actual := (ProviderDefaults{}).Resolve("azurerm_resource_group", hardCoded); !reflect.DeepEqual(*actual, hardCoded) {
+		t.Fatalf("expected the hard-coded timeouts %+v when no defaults are configured but got %+v", hardCoded, *actual)
+	}
+}
+
+func TestProviderDefaultsResolveOperation(t *testing.T) {
+	defaults := ProviderDefaults{
+		Global: Defaults{
+			Create: duration(60 * time.Minute),
+		},
+		ResourceTypes: map[string]Defaults{
+			"azurerm_linux_virtual_machine": {
+				Create: duration(90 * time.Minute),
+				Delete: duration(45 * time.Minute),
+			},
+		},
+	}
+
+	testData := []struct {
+		ResourceType string
+		Operation    string
+		Expected     time.Duration
+	}{
+		{
+			ResourceType: "azurerm_linux_virtual_machine",
+			Operation:    pluginsdk.TimeoutCreate,
+			Expected:     90 * time.Minute,
+		},
+		{
+			ResourceType: "azurerm_resource_group",
+			Operation:    pluginsdk.TimeoutCreate,
+			Expected:     60 * time.Minute,
+		},
+		{
+			ResourceType: "azurerm_linux_virtual_machine",
+			Operation:    pluginsdk.TimeoutDelete,
+			Expected:     45 * time.Minute,
+		},
+		{
+			ResourceType: "azurerm_resource_group",
+			Operation:    pluginsdk.TimeoutRead,
+			Expected:     5 * time.Minute,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q for %q", v.Operation, v.ResourceType)
+
+		if actual := defaults.ResolveOperation(v.ResourceType, v.Operation, 5*time.Minute); actual != v.Expected {
+			t.Fatalf("expected %s but got %s", v.Expected, actual)
+		}
+	}
+}
+
+func TestApplyProviderDefaults(t *testing.T) {
+	withTimeouts := &pluginsdk.Resource{
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: duration(30 * time.Minute),
+			Read:   duration(5 * time.Minute),
+		},
+	}
+	withoutTimeouts := &pluginsdk.Resource{}
+	resources := map[string]*pluginsdk.Resource{
+		"azurerm_with_timeouts":    withTimeouts,
+		"azurerm_without_timeouts": withoutTimeouts,
+	}
+
+	ApplyProviderDefaults(resources, ProviderDefaults{
+		Global: Defaults{
+			Create: duration(60 * time.Minute),
+			Delete: duration(60 * time.Minute),
+		},
+	})
+	if *withTimeouts.Timeouts.Create != 60*time.Minute || *withTimeouts.Timeouts.Read != 5*time.Minute || withTimeouts.Timeouts.Delete != nil {
+		t.Fatalf("unexpected timeouts %+v", *withTimeouts.Timeouts)
+	}
+	if withoutTimeouts.Timeouts != nil {
+		t.Fatalf("expected no timeouts to be added to a resource which doesn't support them")
+	}
+
+	// configuring the Provider again resolves the defaults against the hard-coded timeouts
+	ApplyProviderDefaults(resources, ProviderDefaults{})
+	if *withTimeouts.Timeouts.Create != 30*time.Minute {
+		t.Fatalf("expected the hard-coded Create timeout to be restored but got %s", *withTimeouts.Timeouts.Create)
+	}
+}
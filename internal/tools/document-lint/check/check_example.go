@@ -0,0 +1,421 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/md"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/util"
+)
+
+type ExampleIssue int
+
+const (
+	ExampleUnknownType ExampleIssue = iota
+	ExampleUnknownArgument
+	ExampleReadOnlyArgument
+	ExampleMissingRequired
+	ExampleShouldBeBlock
+	ExampleShouldBeArgument
+	ExampleWrongPlace
+	ExampleUnknownReference
+	ExampleDeprecated
+)
+
+type exampleDiff struct {
+	checkBase
+	Issue       ExampleIssue
+	correctName string // the path the field should be nested in for a wrong placed field, or the replacement for a deprecated field
+	skip        bool
+}
+
+func newExampleDiff(line int, key string, issue ExampleIssue, correctName string) exampleDiff {
+	rt, prop, _ := strings.Cut(key, ".")
+	return exampleDiff{
+		checkBase:   newCheckBase(line, key, nil),
+		Issue:       issue,
+		correctName: correctName,
+		skip:        isSkipProp(rt, prop),
+	}
+}
+
+func (e exampleDiff) ShouldSkip() bool {
+	return e.skip
+}
+
+func (e exampleDiff) String() string {
+	prefix := fmt.Sprintf("%s in example", e.checkBase.Str())
+	switch e.Issue {
+	case ExampleUnknownType:
+		return fmt.Sprintf("%s is not a resource or data source in the provider", prefix)
+	case ExampleUnknownArgument:
+		return fmt.Sprintf("%s does not exist in the schema", prefix)
+	case ExampleReadOnlyArgument:
+		return fmt.Sprintf("%s is Computed only and can not be set", prefix)
+	case ExampleMissingRequired:
+		return fmt.Sprintf("%s is Required but is not set", prefix)
+	case ExampleShouldBeBlock:
+		return fmt.Sprintf("%s should be declared as a block", prefix)
+	case ExampleShouldBeArgument:
+		return fmt.Sprintf("%s should be declared as an argument rather than a block", prefix)
+	case ExampleWrongPlace:
+		if e.correctName == "" {
+			return fmt.Sprintf("%s should be declared at the top level", prefix)
+		}
+		return fmt.Sprintf("%s should be nested in %s", prefix, util.ItalicCode(e.correctName))
+	case ExampleUnknownReference:
+		return fmt.Sprintf("%s references an attribute which does not exist in the schema", prefix)
+	case ExampleDeprecated:
+		return fmt.Sprintf("%s is deprecated - should this be %s?", prefix, util.FixedCode(e.correctName))
+	}
+	return prefix
+}
+
+// Fix renames a deprecated argument or block to its replacement, the other issues can not be fixed by line
+func (e exampleDiff) Fix(line string) (result string, err error) {
+	if e.Issue != ExampleDeprecated || e.correctName == "" {
+		return line, nil
+	}
+	reg := regexp.MustCompile(`^(\s*(?:dynamic\s+")?)` + regexp.QuoteMeta(util.XPathBase(e.Key())) + `("?\s*[={])`)
+	return reg.ReplaceAllString(line, "${1}"+e.correctName+"${2}"), nil
+}
+
+var _ Checker = (*exampleDiff)(nil)
+
+var (
+	providerSchema     *schema.Provider
+	providerSchemaOnce sync.Once
+)
+
+func azurermProvider() *schema.Provider {
+	providerSchemaOnce.Do(func() {
+		providerSchema = provider.AzureProvider()
+	})
+	return providerSchema
+}
+
+// the arguments and blocks which can be used within any resource or data source
+var (
+	metaArguments = map[string]struct{}{
+		"count":      {},
+		"depends_on": {},
+		"for_each":   {},
+		"provider":   {},
+	}
+	metaBlocks = map[string]struct{}{
+		"connection":  {},
+		"lifecycle":   {},
+		"provisioner": {},
+	}
+)
+
+// exampleChecker checks the HCL examples within a document against the provider schema
+type exampleChecker struct {
+	resources   map[string]*schema.Resource
+	dataSources map[string]*schema.Resource
+
+	offset int    // the line of the example within the document
+	src    []byte // the content of the example
+	res    []Checker
+}
+
+func newExampleChecker(resources, dataSources map[string]*schema.Resource) *exampleChecker {
+	return &exampleChecker{
+		resources:   resources,
+		dataSources: dataSources,
+	}
+}
+
+// checkExamples checks each HCL example in the document against the provider schema
+func checkExamples(mdFile string) []Checker {
+	examples, err := md.ExamplesFromFile(mdFile)
+	if err != nil {
+		return nil
+	}
+	p := azurermProvider()
+	c := newExampleChecker(p.ResourcesMap, p.DataSourcesMap)
+	for _, example := range examples {
+		c.checkExample(example)
+	}
+	return c.res
+}
+
+func (c *exampleChecker) add(pos hcl.Pos, key string, issue ExampleIssue, correctName string) {
+	c.res = append(c.res, newExampleDiff(c.offset+pos.Line-1, key, issue, correctName))
+}
+
+func (c *exampleChecker) checkExample(example md.Example) {
+	file, diags := hclsyntax.ParseConfig([]byte(example.Content), "example.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		// examples containing placeholders (e.g. `...`) can not be parsed, so are skipped
+		return
+	}
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return
+	}
+	c.offset = example.Line
+	c.src = []byte(example.Content)
+
+	for _, block := range body.Blocks {
+		if len(block.Labels) != 2 || !strings.HasPrefix(block.Labels[0], "azurerm_") {
+			continue
+		}
+		var res *schema.Resource
+		var ok bool
+		switch block.Type {
+		case "resource":
+			res, ok = c.resources[block.Labels[0]]
+		case "data":
+			res, ok = c.dataSources[block.Labels[0]]
+		default:
+			continue
+		}
+		if !ok {
+			c.add(block.TypeRange.Start, block.Labels[0], ExampleUnknownType, "")
+			continue
+		}
+		c.checkBody(block.Labels[0], "", block.Body, res.Schema, res.Schema, res.Timeouts != nil)
+	}
+
+	c.checkReferences(body)
+}
+
+// checkBody checks the arguments and blocks within a resource, or a nested block when path is not empty
+func (c *exampleChecker) checkBody(rt, path string, body *hclsyntax.Body, sch, rootSch map[string]*schema.Schema, hasTimeouts bool) {
+	key := func(name string) string {
+		return rt + "." + util.XPath(path, name)
+	}
+	declared := map[string]struct{}{}
+
+	for _, attr := range sortedAttributes(body) {
+		name := attr.Name
+		if _, ok := metaArguments[name]; ok && path == "" {
+			continue
+		}
+		declared[name] = struct{}{}
+		item, ok := sch[name]
+		switch {
+		case !ok:
+			c.addUnknown(attr.NameRange.Start, rt, path, name, rootSch)
+		case !item.Optional && !item.Required:
+			c.add(attr.NameRange.Start, key(name), ExampleReadOnlyArgument, "")
+		case isBlock(item) && item.ConfigMode != schema.SchemaConfigModeAttr:
+			c.add(attr.NameRange.Start, key(name), ExampleShouldBeBlock, "")
+		case item.Deprecated != "":
+			if replacement := deprecationReplacement(name, sch); replacement != "" {
+				c.add(attr.NameRange.Start, key(name), ExampleDeprecated, replacement)
+			}
+		}
+	}
+
+	for _, block := range body.Blocks {
+		name, nested := block.Type, block.Body
+		if path == "" {
+			if _, ok := metaBlocks[name]; ok {
+				continue
+			}
+			if name == "timeouts" && hasTimeouts {
+				continue
+			}
+		}
+		if name == "dynamic" && len(block.Labels) == 1 {
+			name, nested = block.Labels[0], nil
+			for _, content := range block.Body.Blocks {
+				if content.Type == "content" {
+					nested = content.Body
+				}
+			}
+		}
+		declared[name] = struct{}{}
+		item, ok := sch[name]
+		switch {
+		case !ok:
+			c.addUnknown(block.TypeRange.Start, rt, path, name, rootSch)
+			continue
+		case !item.Optional && !item.Required:
+			c.add(block.TypeRange.Start, key(name), ExampleReadOnlyArgument, "")
+			continue
+		case !isBlock(item):
+			c.add(block.TypeRange.Start, key(name), ExampleShouldBeArgument, "")
+			continue
+		case item.Deprecated != "":
+			if replacement := deprecationReplacement(name, sch); replacement != "" {
+				c.add(block.TypeRange.Start, key(name), ExampleDeprecated, replacement)
+			}
+		}
+		if nested != nil {
+			c.checkBody(rt, util.XPath(path, name), nested, item.Elem.(*schema.Resource).Schema, rootSch, false)
+		}
+	}
+
+	// the arguments of an abbreviated block (e.g. containing `# ...`) are intentionally incomplete
+	if c.isAbbreviated(body) {
+		return
+	}
+	var missing []string
+	for name, item := range sch {
+		if _, ok := declared[name]; !ok && item.Required {
+			missing = append(missing, name)
+		}
+	}
+	sort.Strings(missing)
+	for _, name := range missing {
+		c.add(body.SrcRange.Start, key(name), ExampleMissingRequired, "")
+	}
+}
+
+var abbreviatedReg = regexp.MustCompile(`(?i)\.\.\.|omitted`)
+
+func (c *exampleChecker) isAbbreviated(body *hclsyntax.Body) bool {
+	r := body.SrcRange
+	if r.Start.Byte < 0 || r.End.Byte > len(c.src) || r.Start.Byte > r.End.Byte {
+		return false
+	}
+	return abbreviatedReg.Match(c.src[r.Start.Byte:r.End.Byte])
+}
+
+// addUnknown reports a field which doesn't exist at this level of the schema, which may be nested in the wrong block
+func (c *exampleChecker) addUnknown(pos hcl.Pos, rt, path, name string, rootSch map[string]*schema.Schema) {
+	if correct, ok := findFieldPath(rootSch, "", name); ok && correct != path {
+		c.add(pos, rt+"."+util.XPath(path, name), ExampleWrongPlace, correct)
+		return
+	}
+	c.add(pos, rt+"."+util.XPath(path, name), ExampleUnknownArgument, "")
+}
+
+// checkReferences checks the references to the attributes of a resource or data source within the example
+func (c *exampleChecker) checkReferences(body *hclsyntax.Body) {
+	for _, attr := range sortedAttributes(body) {
+		for _, traversal := range attr.Expr.Variables() {
+			c.checkTraversal(traversal)
+		}
+	}
+	for _, block := range body.Blocks {
+		c.checkReferences(block.Body)
+	}
+}
+
+func (c *exampleChecker) checkTraversal(traversal hcl.Traversal) {
+	steps := traversal.SimpleSplit().Rel
+	rt := traversal.RootName()
+	resources := c.resources
+	if rt == "data" {
+		if len(steps) == 0 {
+			return
+		}
+		attr, ok := steps[0].(hcl.TraverseAttr)
+		if !ok {
+			return
+		}
+		rt, resources, steps = attr.Name, c.dataSources, steps[1:]
+	}
+	if !strings.HasPrefix(rt, "azurerm_") {
+		return
+	}
+	res, ok := resources[rt]
+	// the first step is the name of the resource, unknown resource types are reported where they're declared
+	if !ok || len(steps) == 0 {
+		return
+	}
+
+	sch := res.Schema
+	var path string
+	for _, step := range steps[1:] {
+		switch s := step.(type) {
+		case hcl.TraverseAttr:
+			if path == "" && s.Name == "id" {
+				return
+			}
+			item, ok := sch[s.Name]
+			if !ok {
+				c.add(s.SrcRange.Start, rt+"."+util.XPath(path, s.Name), ExampleUnknownReference, "")
+				return
+			}
+			path = util.XPath(path, s.Name)
+			nested, ok := item.Elem.(*schema.Resource)
+			if !ok {
+				// the attributes of a map or primitive can't be checked
+				return
+			}
+			sch = nested.Schema
+		case hcl.TraverseIndex, hcl.TraverseSplat:
+			continue
+		default:
+			return
+		}
+	}
+}
+
+func sortedAttributes(body *hclsyntax.Body) []*hclsyntax.Attribute {
+	res := make([]*hclsyntax.Attribute, 0, len(body.Attributes))
+	for _, attr := range body.Attributes {
+		res = append(res, attr)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].SrcRange.Start.Byte < res[j].SrcRange.Start.Byte
+	})
+	return res
+}
+
+func isBlock(item *schema.Schema) bool {
+	_, ok := item.Elem.(*schema.Resource)
+	return ok && (item.Type == schema.TypeList || item.Type == schema.TypeSet)
+}
+
+// findFieldPath finds the path of the block containing a field with the name, the path is empty for a top level field
+func findFieldPath(sch map[string]*schema.Schema, path, name string) (string, bool) {
+	if _, ok := sch[name]; ok {
+		return path, true
+	}
+	keys := make([]string, 0, len(sch))
+	for key := range sch {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if nested, ok := sch[key].Elem.(*schema.Resource); ok {
+			if res, ok := findFieldPath(nested.Schema, util.XPath(path, key), name); ok {
+				return res, true
+			}
+		}
+	}
+	return "", false
+}
+
+var quotedNameReg = regexp.MustCompile("[`']([a-z0-9_]+)[`']")
+
+// deprecationReplacement returns the replacement for a deprecated field, when the deprecation message refers to exactly one
+// other field at the same level which is not deprecated and has the same type
+func deprecationReplacement(name string, sch map[string]*schema.Schema) string {
+	item := sch[name]
+	candidates := map[string]struct{}{}
+	for _, match := range quotedNameReg.FindAllStringSubmatch(item.Deprecated, -1) {
+		if other := match[1]; other != name {
+			if _, ok := sch[other]; ok {
+				candidates[other] = struct{}{}
+			}
+		}
+	}
+	if len(candidates) != 1 {
+		return ""
+	}
+	for other := range candidates {
+		replacement := sch[other]
+		if replacement.Deprecated == "" && replacement.Type == item.Type && isBlock(replacement) == isBlock(item) &&
+			(replacement.Optional || replacement.Required) {
+			return other
+		}
+	}
+	return ""
+}
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/md"
+)
+
+func exampleTestResources() (resources, dataSources map[string]*schema.Resource) {
+	resources = map[string]*schema.Resource{
+		"azurerm_example": {
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"sku_name": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"sku": {
+					Type:       schema.TypeString,
+					Optional:   true,
+					Deprecated: "`sku` has been renamed to `sku_name` and will be removed in v5.0 of the AzureRM Provider",
+				},
+				"tags": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"network": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"subnet_id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+				"endpoint": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+			Timeouts: &schema.ResourceTimeout{},
+		},
+	}
+	dataSources = map[string]*schema.Resource{
+		"azurerm_example": {
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"network": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"subnet_id": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	return
+}
+
+func TestExampleChecker(t *testing.T) {
+	content := `resource "azurerm_example" "example" {
+  sku  = "Basic"
+  subnet_id = "a"
+  endpoint = "b"
+  tags {
+  }
+  network {
+    unknown = "c"
+  }
+  timeouts {
+    create = "10m"
+  }
+}
+
+resource "azurerm_missing" "example" {
+}
+
+resource "azurerm_example" "abbreviated" {
+  # ...
+}
+
+data "azurerm_example" "example" {
+  name = azurerm_example.example.id
+}
+
+output "subnet_id" {
+  value = data.azurerm_example.example.network.0.subnet_id
+}
+
+output "unknown" {
+  value = azurerm_example.example.network[0].missing
+}
+`
+	want := []struct {
+		line  int
+		key   string
+		issue ExampleIssue
+	}{
+		{11, "azurerm_example.sku", ExampleDeprecated},
+		{12, "azurerm_example.subnet_id", ExampleWrongPlace},
+		{13, "azurerm_example.endpoint", ExampleReadOnlyArgument},
+		{14, "azurerm_example.tags", ExampleShouldBeArgument},
+		{17, "azurerm_example.network.unknown", ExampleUnknownArgument},
+		{16, "azurerm_example.network.subnet_id", ExampleMissingRequired},
+		{10, "azurerm_example.name", ExampleMissingRequired},
+		{24, "azurerm_missing", ExampleUnknownType},
+		{40, "azurerm_example.network.missing", ExampleUnknownReference},
+	}
+
+	resources, dataSources := exampleTestResources()
+	c := newExampleChecker(resources, dataSources)
+	c.checkExample(md.Example{Line: 10, Content: content})
+
+	if len(c.res) != len(want) {
+		for _, item := range c.res {
+			t.Logf("%s", item.String())
+		}
+		t.Fatalf("expect %d issues, got: %d", len(want), len(c.res))
+	}
+	for idx, item := range c.res {
+		diff := item.(exampleDiff)
+		if diff.Line() != want[idx].line || diff.Key() != want[idx].key || diff.Issue != want[idx].issue {
+			t.Fatalf("expect issue %d to be %+v, got: %d %s %d", idx, want[idx], diff.Line(), diff.Key(), diff.Issue)
+		}
+	}
+}
+
+func TestExampleChecker_unparsable(t *testing.T) {
+	resources, dataSources := exampleTestResources()
+	c := newExampleChecker(resources, dataSources)
+	c.checkExample(md.Example{Line: 10, Content: "resource \"azurerm_example\" \"example\" {\n  ...\n}"})
+	if len(c.res) != 0 {
+		t.Fatalf("expect no issues for an example which can't be parsed, got: %d", len(c.res))
+	}
+}
+
+func TestExampleDiff_Fix(t *testing.T) {
+	resources, _ := exampleTestResources()
+	if got := deprecationReplacement("sku", resources["azurerm_example"].Schema); got != "sku_name" {
+		t.Fatalf("expect replacement `sku_name`, got: %q", got)
+	}
+
+	args := []struct {
+		line string
+		want string
+	}{
+		{`  sku = "Basic"`, `  sku_name = "Basic"`},
+		{`  sku    = "Basic"`, `  sku_name    = "Basic"`},
+		{`  dynamic "sku" {`, `  dynamic "sku_name" {`},
+		{`  sku_tier = "Basic"`, `  sku_tier = "Basic"`},
+	}
+	diff := newExampleDiff(1, "azurerm_example.sku", ExampleDeprecated, "sku_name")
+	for _, arg := range args {
+		got, err := diff.Fix(arg.line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != arg.want {
+			t.Fatalf("expect %q, got: %q", arg.want, got)
+		}
+	}
+}
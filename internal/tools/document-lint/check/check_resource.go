@@ -73,7 +73,24 @@ func NewResourceDiff(tf *schema.Resource) *ResourceDiff {
 	return r
 }
 
+// NewDataSourceDiff as NewResourceDiff, for a data source
+func NewDataSourceDiff(tf *schema.Resource) *ResourceDiff {
+	r := &ResourceDiff{
+		tf: tf,
+	}
+	r.MDFile = md.DataSourceMDPathFor(tf.ResourceType)
+	return r
+}
+
 func (r *ResourceDiff) DiffAll() {
+	// only the examples are checked in data source documents
+	if r.tf.IsDataSource {
+		if r.MDFile != "" {
+			r.Diff = checkExamples(r.MDFile)
+		}
+		return
+	}
+
 	if r.md == nil {
 		if r.MDFile == "" {
 			r.Diff = append(r.Diff, newDiffWithMessage(fmt.Sprintf("%s has no document", r.tf.ResourceType), r.tf.IsDeprecated()))
@@ -95,4 +112,7 @@ func (r *ResourceDiff) DiffAll() {
 
 	timeouts := diffTimeout(r.tf, r.md)
 	r.Diff = append(r.Diff, timeouts...)
+
+	examples := checkExamples(r.MDFile)
+	r.Diff = append(r.Diff, examples...)
 }
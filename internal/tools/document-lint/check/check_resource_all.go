@@ -65,7 +65,7 @@ func (d *DiffResult) ToString() string {
 	var count int
 	var possiblevalueMiss int
 	var crossCount, resourceCount int
-	var reqCount, defaultCount, timeoutCount, forceNewCount int
+	var reqCount, defaultCount, timeoutCount, forceNewCount, exampleCount int
 	var skipCount int
 	for _, diff := range d.result {
 		if len(diff.Diffs()) > 0 {
@@ -92,6 +92,8 @@ func (d *DiffResult) ToString() string {
 					timeoutCount++
 				case forceNewDiff:
 					forceNewCount++
+				case exampleDiff:
+					exampleCount++
 
 				}
 			}
@@ -142,6 +144,21 @@ func DiffAll(regs Resources, dryRun bool) *DiffResult {
 			dr.mux.Unlock()
 		}
 	}
+
+	for _, ds := range regs.dataSources {
+		sch := schema.NewDataSource(ds.schema, ds.name)
+		if sch == nil {
+			continue
+		}
+		rd := NewDataSourceDiff(sch)
+		rd.DiffAll()
+
+		if len(rd.Diffs()) > 0 {
+			dr.mux.Lock()
+			dr.result = append(dr.result, rd)
+			dr.mux.Unlock()
+		}
+	}
 	dr.end = time.Now()
 	return dr
 }
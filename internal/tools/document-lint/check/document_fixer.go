@@ -115,6 +115,16 @@ func (f *Fixer) TryFix() (err error) {
 			continue
 		}
 
+		// examples are fixed as-is, since HCL must not have a trailing period added
+		if example, ok := item.(exampleDiff); ok {
+			if !example.ShouldSkip() {
+				if lines[example.Line()], err = example.Fix(lines[example.Line()]); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
 		// mdField is nil for no document exists or page title mismatch
 		if item.ShouldSkip() {
 			continue
@@ -15,7 +15,8 @@ type resource struct {
 }
 
 type Resources struct {
-	resources []resource
+	resources   []resource
+	dataSources []resource
 }
 
 type set map[string]struct{}
@@ -82,6 +83,15 @@ func AzurermAllResources(service, skipService string, resources, skipResources s
 				schema: svc,
 			})
 		}
+		for _, svc := range r.DataSources() {
+			if shouldSKipResource(svc.ResourceType()) {
+				continue
+			}
+			res.dataSources = append(res.dataSources, resource{
+				name:   svc.ResourceType(),
+				schema: svc,
+			})
+		}
 	}
 
 	for _, r := range provider.SupportedUntypedServices() {
@@ -98,6 +108,15 @@ func AzurermAllResources(service, skipService string, resources, skipResources s
 				schema: svc,
 			})
 		}
+		for name, svc := range r.SupportedDataSources() {
+			if shouldSKipResource(name) {
+				continue
+			}
+			res.dataSources = append(res.dataSources, resource{
+				name:   name,
+				schema: svc,
+			})
+		}
 	}
 	return res
 }
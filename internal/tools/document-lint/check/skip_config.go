@@ -16,6 +16,8 @@ var skipProps = []string{
 	"all.timezone",
 	"all.time_zone",
 	"all.time_zone_id",
+	// added to every taggable resource by the provider and documented once alongside `default_tags`
+	"all.tags_all",
 	"azurerm_nginx_deployment.identity.type", // there is a diff between real supported values and common identity schema
 	"azurerm_kubernetes_cluster.default_node_pool.os_sku",
 	"azurerm_kubernetes_cluster_node_pool.os_sku",
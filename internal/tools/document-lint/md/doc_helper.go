@@ -17,6 +17,7 @@ import (
 
 var (
 	docRDir             string
+	docDDir             string
 	resourceFilePathMap map[string]string
 	file2Reosurce       = map[string]string{}
 	once                sync.Once
@@ -33,6 +34,15 @@ func MDPathFor(resourceType string) string {
 	return fullPath
 }
 
+// DataSourceMDPathFor return full path of markdown file of data source, or an empty string if there's no such file
+func DataSourceMDPathFor(dataSourceType string) string {
+	fullPath := path.Join(DataSourceDir(), fmt.Sprintf("%s.html.markdown", strings.TrimPrefix(dataSourceType, "azurerm_")))
+	if _, err := os.Stat(fullPath); os.IsNotExist(err) {
+		return ""
+	}
+	return fullPath
+}
+
 func getMappingPath(resourceName string) (res string) {
 	if resourceFilePathMap == nil {
 		once.Do(func() {
@@ -80,3 +90,10 @@ func ResourceDir() string {
 	}
 	return docRDir
 }
+
+func DataSourceDir() string {
+	if docDDir == "" {
+		docDDir = path.Join(docDir(), "d")
+	}
+	return docDDir
+}
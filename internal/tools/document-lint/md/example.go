@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package md
+
+import (
+	"os"
+	"strings"
+)
+
+// Example is a fenced HCL code block within a document
+type Example struct {
+	Line    int // index of the first line of HCL within the document (the line after the opening fence)
+	Content string
+}
+
+// the fenced code blocks containing HCL, other code blocks (e.g. ```shell) are ignored
+var exampleFences = []string{"```hcl", "```terraform"}
+
+func isExampleFence(line string) bool {
+	line = strings.TrimSpace(line)
+	for _, fence := range exampleFences {
+		if line == fence {
+			return true
+		}
+	}
+	return false
+}
+
+// ExamplesFromFile returns the HCL examples within the document
+func ExamplesFromFile(file string) ([]Example, error) {
+	bs, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return examplesFromString(string(bs)), nil
+}
+
+func examplesFromString(content string) (res []Example) {
+	var current *Example
+	var lines []string
+	for idx, line := range strings.Split(content, "\n") {
+		if current == nil {
+			if isExampleFence(line) {
+				current = &Example{Line: idx + 1}
+				lines = nil
+			}
+			continue
+		}
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			current.Content = strings.Join(lines, "\n")
+			res = append(res, *current)
+			current = nil
+			continue
+		}
+		lines = append(lines, line)
+	}
+	// an unclosed fence is ignored since the example may be incomplete
+	return res
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package md
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestExamplesFromFile(t *testing.T) {
+	args := []struct {
+		file  string
+		lines []int
+	}{
+		{"key_vault.html.markdown", []int{23}},
+		{"media_transform.html.markdown", []int{15, 58}},
+	}
+	for _, arg := range args {
+		examples, err := ExamplesFromFile(filepath.Join(testDir, arg.file))
+		if err != nil {
+			t.Fatalf("%s: %v", arg.file, err)
+		}
+		if len(examples) != len(arg.lines) {
+			t.Fatalf("%s expect %d examples, got: %d", arg.file, len(arg.lines), len(examples))
+		}
+		for idx, example := range examples {
+			if example.Line != arg.lines[idx] {
+				t.Fatalf("%s expect example %d at line %d, got: %d", arg.file, idx, arg.lines[idx], example.Line)
+			}
+			if !strings.HasPrefix(example.Content, "provider \"azurerm\"") && !strings.HasPrefix(example.Content, "resource ") {
+				t.Fatalf("%s example %d has unexpected content: %s", arg.file, idx, example.Content)
+			}
+		}
+	}
+}
+
+func TestExamplesFromString(t *testing.T) {
+	content := "# Example\n\n```shell\nterraform import\n```\n\n```terraform\nresource \"a\" \"b\" {\n# comment\n}\n```\n\n```hcl\nunclosed"
+	examples := examplesFromString(content)
+	if len(examples) != 1 {
+		t.Fatalf("expect 1 example, got: %d", len(examples))
+	}
+	if examples[0].Line != 7 {
+		t.Fatalf("expect example at line 7, got: %d", examples[0].Line)
+	}
+	if want := "resource \"a\" \"b\" {\n# comment\n}"; examples[0].Content != want {
+		t.Fatalf("expect content %q, got: %q", want, examples[0].Content)
+	}
+}
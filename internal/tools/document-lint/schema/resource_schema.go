@@ -26,8 +26,10 @@ type Resource struct {
 	ResourceType string // azurerm_xxx
 
 	// one of Schema or SDKResource must use
-	Schema      *schema.Resource `json:"-"`
-	SDKResource sdk.Resource     `json:"-"`
+	Schema        *schema.Resource `json:"-"`
+	SDKResource   sdk.Resource     `json:"-"`
+	SDKDataSource sdk.DataSource   `json:"-"`
+	IsDataSource  bool
 
 	PossibleValues map[string][]string // possible values for key(property path)
 }
@@ -38,6 +40,12 @@ func ResourceForSDKType(res sdk.Resource) *schema.Resource {
 	return ins
 }
 
+func DataSourceForSDKType(ds sdk.DataSource) *schema.Resource {
+	r := sdk.NewDataSourceWrapper(ds)
+	ins, _ := r.DataSource()
+	return ins
+}
+
 // NewResourceByTyped NewResource ...
 // r is Schema.Resource or Typed SDK Resource
 func NewResourceByTyped(r sdk.Resource) *Resource {
@@ -67,8 +75,29 @@ func NewResource(r interface{}, rType string) *Resource {
 	return nil
 }
 
+// NewDataSource ...
+// r is Schema.Resource or Typed SDK Data Source
+func NewDataSource(r interface{}, rType string) *Resource {
+	s := &Resource{IsDataSource: true}
+	switch ins := r.(type) {
+	case sdk.DataSource:
+		s.SDKDataSource = ins
+		s.Schema = DataSourceForSDKType(ins)
+		s.ResourceType = ins.ResourceType()
+	case *schema.Resource:
+		s.Schema = ins
+		s.ResourceType = rType
+	default:
+		return nil
+	}
+	s.Init()
+	return s
+}
+
 func (r *Resource) Init() {
-	if r.SDKResource != nil {
+	if r.SDKDataSource != nil {
+		r.FilePath = FileForResource(r.SDKDataSource.Read().Func)
+	} else if r.SDKResource != nil {
 		// SDKResource is a type of interface, have to get the real
 		// vd := reflect.ValueOf(r.SDKResource).Interface()
 		// vd = reflect.ValueOf(vd).MethodByName("Arguments")
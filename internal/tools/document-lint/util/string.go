@@ -87,3 +87,11 @@ func XPathDir(p string) string {
 	}
 	return p
 }
+
+// XPath joins the path of a block with the name of a field within it, dir is empty for a top level field
+func XPath(dir, base string) string {
+	if dir == "" {
+		return base
+	}
+	return dir + "." + base
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+// changelogSections is the order in which the sections are output, matching CHANGELOG.md
+var changelogSections = []ChangeType{
+	ChangeTypeBreaking,
+	ChangeTypeFeature,
+	ChangeTypeEnhancement,
+	ChangeTypeDeprecation,
+}
+
+// Changelog writes a Markdown summary of the changes between the schema in baseFile and the current schema, grouped in
+// the same manner as CHANGELOG.md. The current schema is loaded from currentFile when specified, otherwise from the Provider.
+func (d *Differ) Changelog(baseFile string, currentFile string, providerName string, w io.Writer) error {
+	if currentFile != "" {
+		current, err := readFile(currentFile)
+		if err != nil {
+			return fmt.Errorf("loading the current schema from %q: %+v", currentFile, err)
+		}
+		d.current = current
+	} else if err := d.loadFromProvider(providerjson.LoadData(), providerName); err != nil {
+		return fmt.Errorf("loading the current schema from the provider: %+v", err)
+	}
+
+	if err := d.loadFromFile(baseFile); err != nil {
+		return fmt.Errorf("loading the base schema from %q: %+v", baseFile, err)
+	}
+
+	if d.base.ProviderName != d.current.ProviderName {
+		return fmt.Errorf("provider name mismatch, expected %q, got %q", d.base.ProviderName, d.current.ProviderName)
+	}
+
+	return writeChangelog(d.changes(), w)
+}
+
+func writeChangelog(changes []Change, w io.Writer) error {
+	lines := make(map[ChangeType][]string)
+	for _, change := range changes {
+		lines[change.Type] = append(lines[change.Type], change.Line)
+	}
+
+	first := true
+	for _, section := range changelogSections {
+		if len(lines[section]) == 0 {
+			continue
+		}
+		sort.Strings(lines[section])
+
+		if !first {
+			if _, err := fmt.Fprintln(w); err != nil {
+				return err
+			}
+		}
+		first = false
+
+		if _, err := fmt.Fprintf(w, "%s:\n\n", section); err != nil {
+			return err
+		}
+		for _, line := range lines[section] {
+			if _, err := fmt.Fprintf(w, "* %s\n", line); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
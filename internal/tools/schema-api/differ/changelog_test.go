@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const changelogBaseSchema = `{
+  "providerName": "azurerm",
+  "schemaVersion": "1",
+  "providerSchema": {
+    "schema": {},
+    "resources": {
+      "azurerm_example": {
+        "schema": {
+          "name": {"type": "String", "required": true, "forceNew": true},
+          "sku": {"type": "String", "optional": true, "forceNew": true},
+          "secret": {"type": "String", "optional": true, "sensitive": true},
+          "removed": {"type": "String", "optional": true},
+          "block": {
+            "type": "TypeList",
+            "optional": true,
+            "maxItems": 2,
+            "elem": {"schema": {"value": {"type": "String", "optional": true}}}
+          }
+        },
+        "timeouts": {"create": 30, "read": 5, "update": 30, "delete": 30}
+      },
+      "azurerm_legacy": {
+        "schema": {},
+        "deprecationMessage": "superseded by azurerm_example"
+      },
+      "azurerm_removed": {
+        "schema": {}
+      }
+    },
+    "dataSources": {
+      "azurerm_example": {
+        "schema": {
+          "name": {"type": "String", "required": true}
+        }
+      }
+    }
+  }
+}`
+
+const changelogCurrentSchema = `{
+  "providerName": "azurerm",
+  "schemaVersion": "1",
+  "providerSchema": {
+    "schema": {},
+    "resources": {
+      "azurerm_example": {
+        "schema": {
+          "name": {"type": "String", "required": true, "forceNew": true},
+          "sku": {"type": "String", "optional": true},
+          "secret": {"type": "String", "optional": true},
+          "tags": {"type": "TypeMap", "optional": true},
+          "block": {
+            "type": "TypeList",
+            "optional": true,
+            "maxItems": 1,
+            "elem": {"schema": {"value": {"type": "String", "optional": true}, "other": {"type": "String", "optional": true}}}
+          }
+        },
+        "timeouts": {"create": 30, "read": 5, "update": 30, "delete": 10},
+        "deprecationMessage": "superseded by azurerm_example_v2"
+      },
+      "azurerm_example_v2": {
+        "schema": {}
+      }
+    },
+    "dataSources": {
+      "azurerm_example": {
+        "schema": {
+          "name": {"type": "String", "required": true}
+        }
+      },
+      "azurerm_other": {
+        "schema": {}
+      }
+    }
+  }
+}`
+
+const changelogExpected = "BREAKING CHANGES:\n\n" +
+	"* `azurerm_example` - cannot reduce MaxItems for the property \"block\" (2 to 1)\n" +
+	"* `azurerm_example` - cannot remove Sensitive from the property \"secret\" as this would expose the value\n" +
+	"* `azurerm_example` - property \"removed\" has been removed\n" +
+	"* `azurerm_example` - the delete timeout for \"azurerm_example\" has been shortened (30 to 10 minutes)\n" +
+	"* `azurerm_removed` - \"azurerm_removed\" has been removed without first being deprecated\n" +
+	"\n" +
+	"FEATURES:\n\n" +
+	"* **New Data Source**: `azurerm_other`\n" +
+	"* **New Resource**: `azurerm_example_v2`\n" +
+	"\n" +
+	"ENHANCEMENTS:\n\n" +
+	"* `azurerm_example` - support for the `block.other` property\n" +
+	"* `azurerm_example` - support for the `tags` property\n" +
+	"* `azurerm_example` - the `sku` property can now be updated without creating a new resource\n" +
+	"\n" +
+	"DEPRECATIONS:\n\n" +
+	"* `azurerm_example` - superseded by azurerm_example_v2\n"
+
+func TestDiffer_Changelog(t *testing.T) {
+	dir := t.TempDir()
+	baseFile := filepath.Join(dir, "base.json")
+	currentFile := filepath.Join(dir, "current.json")
+	if err := os.WriteFile(baseFile, []byte(changelogBaseSchema), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(currentFile, []byte(changelogCurrentSchema), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	d := Differ{}
+	out := &bytes.Buffer{}
+	if err := d.Changelog(baseFile, currentFile, "azurerm", out); err != nil {
+		t.Fatalf("generating changelog: %+v", err)
+	}
+
+	if out.String() != changelogExpected {
+		t.Fatalf("expected:\n%s\n\ngot:\n%s", changelogExpected, out.String())
+	}
+}
+
+func TestDiffer_ChangelogNoChanges(t *testing.T) {
+	dir := t.TempDir()
+	baseFile := filepath.Join(dir, "base.json")
+	if err := os.WriteFile(baseFile, []byte(changelogBaseSchema), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	d := Differ{}
+	out := &bytes.Buffer{}
+	if err := d.Changelog(baseFile, baseFile, "azurerm", out); err != nil {
+		t.Fatalf("generating changelog: %+v", err)
+	}
+
+	if out.Len() != 0 {
+		t.Fatalf("expected no output but got:\n%s", out.String())
+	}
+}
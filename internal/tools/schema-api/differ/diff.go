@@ -5,6 +5,7 @@ package differ
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
 	schema_rules "github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/schema-rules"
@@ -15,6 +16,21 @@ type Differ struct {
 	current *providerjson.ProviderWrapper
 }
 
+type ChangeType string
+
+const (
+	ChangeTypeBreaking    ChangeType = "BREAKING CHANGES"
+	ChangeTypeFeature     ChangeType = "FEATURES"
+	ChangeTypeEnhancement ChangeType = "ENHANCEMENTS"
+	ChangeTypeDeprecation ChangeType = "DEPRECATIONS"
+)
+
+// Change is a single change to the schema between the base and current schemas, formatted as a CHANGELOG entry
+type Change struct {
+	Type ChangeType
+	Line string
+}
+
 func (d *Differ) Diff(fileName string, providerName string) []string {
 	if err := d.loadFromProvider(providerjson.LoadData(), providerName); err != nil {
 		return []string{err.Error()}
@@ -29,90 +45,159 @@ func (d *Differ) Diff(fileName string, providerName string) []string {
 	}
 
 	violations := make([]string, 0)
+	for _, change := range d.changes() {
+		if change.Type == ChangeTypeBreaking {
+			violations = append(violations, change.Line)
+		}
+	}
+
+	return violations
+}
 
-	for resource, rs := range d.current.ProviderSchema.ResourcesMap {
-		_, ok := d.base.ProviderSchema.ResourcesMap[resource]
-		if !ok {
-			// New resource, no breaking changes to worry about
+// changes returns all of the changes (both additive and breaking) between the base and current schemas
+func (d *Differ) changes() []Change {
+	changes := make([]Change, 0)
+	changes = append(changes, compareResources(d.base.ProviderSchema.ResourcesMap, d.current.ProviderSchema.ResourcesMap, false)...)
+	changes = append(changes, compareResources(d.base.ProviderSchema.DataSourcesMap, d.current.ProviderSchema.DataSourcesMap, true)...)
+	return changes
+}
+
+func compareResources(base map[string]providerjson.ResourceJSON, current map[string]providerjson.ResourceJSON, isDataSource bool) (changes []Change) {
+	resourceRules := schema_rules.ResourceBreakingChangeRules
+	propertyRules := schema_rules.BreakingChangeRules
+	newResourceLine := "**New Resource**: `%s`"
+	if isDataSource {
+		resourceRules = schema_rules.ResourceBreakingChangeRulesDataSource
+		propertyRules = schema_rules.BreakingChangeRulesDataSource
+		newResourceLine = "**New Data Source**: `%s`"
+	}
+
+	for _, name := range sortedKeys(base, current) {
+		baseResource, inBase := base[name]
+		currentResource, inCurrent := current[name]
+		if !inBase {
+			changes = append(changes, Change{
+				Type: ChangeTypeFeature,
+				Line: fmt.Sprintf(newResourceLine, name),
+			})
 			continue
 		}
-		for propertyName, propertySchema := range rs.Schema {
-			// Get the same from the base (released) json
-			baseItem, ok := d.base.ProviderSchema.ResourcesMap[resource].Schema[propertyName]
-			if !ok {
-				// New property, could be breaking - Required etc
-				baseItem = providerjson.SchemaJSON{}
-			}
-			if errs := compareNodeResource(baseItem, propertySchema, propertyName); errs != nil {
-				violations = append(violations, errs...)
+
+		var currentItem *providerjson.ResourceJSON
+		if inCurrent {
+			currentItem = &currentResource
+		}
+		for _, v := range resourceRules {
+			if err := v.Check(baseResource, currentItem, name); err != nil {
+				changes = append(changes, Change{
+					Type: ChangeTypeBreaking,
+					Line: fmt.Sprintf("`%s` - %s", name, *err),
+				})
 			}
 		}
-	}
-
-	for dataSource, ds := range d.current.ProviderSchema.DataSourcesMap {
-		_, ok := d.base.ProviderSchema.DataSourcesMap[dataSource]
-		if !ok {
-			// New data source, no breaking changes to worry about
+		if !inCurrent {
 			continue
 		}
-		for propertyName, propertySchema := range ds.Schema {
-			// Get the same from the base (released) json
-			baseItem, ok := d.base.ProviderSchema.DataSourcesMap[dataSource].Schema[propertyName]
-			if !ok {
-				// New property, could be breaking - Required etc
-				baseItem = providerjson.SchemaJSON{}
-			}
-			if errs := compareNodeDataSource(baseItem, propertySchema, propertyName); errs != nil {
-				violations = append(violations, errs...)
-			}
+
+		if baseResource.DeprecationMessage == "" && currentResource.DeprecationMessage != "" {
+			changes = append(changes, Change{
+				Type: ChangeTypeDeprecation,
+				Line: fmt.Sprintf("`%s` - %s", name, currentResource.DeprecationMessage),
+			})
+		}
+
+		for _, change := range compareSchema(baseResource.Schema, currentResource.Schema, "", propertyRules) {
+			change.Line = fmt.Sprintf("`%s` - %s", name, change.Line)
+			changes = append(changes, change)
 		}
 	}
 
-	return violations
+	return
 }
 
-func compareNodeResource(base providerjson.SchemaJSON, current providerjson.SchemaJSON, nodeName string) (errs []string) {
-	if nodeIsBlock(base) {
-		newBaseRaw := base.Elem.(providerjson.ResourceJSON).Schema
-		newCurrent := current.Elem.(*providerjson.ResourceJSON).Schema
-		for k, newBase := range newBaseRaw {
-			errs = append(errs, compareNodeResource(newBase, newCurrent[k], k)...)
+// compareSchema compares the properties within a Resource/Data Source or nested block, where path is the path to
+// the nested block (if any) which is used to build the name of each property (e.g. `block.nested_property`)
+func compareSchema(base map[string]providerjson.SchemaJSON, current map[string]providerjson.SchemaJSON, path string, rules []schema_rules.BreakingChangeRule) (changes []Change) {
+	for _, name := range sortedKeys(base, current) {
+		propertyName := name
+		if path != "" {
+			propertyName = fmt.Sprintf("%s.%s", path, name)
 		}
-	}
 
-	for _, v := range schema_rules.BreakingChangeRules {
-		if err := v.Check(base, current, nodeName); err != nil {
-			errs = append(errs, *err)
+		// properties which have been added or removed are compared against an empty schema
+		baseItem, inBase := base[name]
+		currentItem, inCurrent := current[name]
+
+		baseBlock := nodeBlock(baseItem)
+		currentBlock := nodeBlock(currentItem)
+		if baseBlock != nil && currentBlock != nil {
+			changes = append(changes, compareSchema(baseBlock.Schema, currentBlock.Schema, propertyName, rules)...)
+		}
+
+		for _, v := range rules {
+			if err := v.Check(baseItem, currentItem, propertyName); err != nil {
+				changes = append(changes, Change{
+					Type: ChangeTypeBreaking,
+					Line: *err,
+				})
+			}
+		}
+
+		switch {
+		case !inBase:
+			changes = append(changes, Change{
+				Type: ChangeTypeEnhancement,
+				Line: fmt.Sprintf("support for the `%s` property", propertyName),
+			})
+
+		case !inCurrent:
+			// removing a property is covered by the breaking change rules
+
+		case baseItem.ForceNew && !currentItem.ForceNew:
+			changes = append(changes, Change{
+				Type: ChangeTypeEnhancement,
+				Line: fmt.Sprintf("the `%s` property can now be updated without creating a new resource", propertyName),
+			})
+
+		case baseItem.Required && currentItem.Optional:
+			changes = append(changes, Change{
+				Type: ChangeTypeEnhancement,
+				Line: fmt.Sprintf("the `%s` property is now Optional", propertyName),
+			})
 		}
 	}
 
 	return
 }
 
-func compareNodeDataSource(base providerjson.SchemaJSON, current providerjson.SchemaJSON, nodeName string) (errs []string) {
-	if nodeIsBlock(base) {
-		newBaseRaw := base.Elem.(providerjson.ResourceJSON).Schema
-		newCurrent := current.Elem.(*providerjson.ResourceJSON).Schema
-		for k, newBase := range newBaseRaw {
-			errs = append(errs, compareNodeDataSource(newBase, newCurrent[k], k)...)
-		}
+// nodeBlock returns the nested schema for the specified property, if it's a block - where this is a pointer when
+// loaded from the provider, and a value when loaded from a file
+func nodeBlock(input providerjson.SchemaJSON) *providerjson.ResourceJSON {
+	if input.Type != providerjson.SchemaTypeList && input.Type != providerjson.SchemaTypeSet {
+		return nil
 	}
 
-	for _, v := range schema_rules.BreakingChangeRulesDataSource {
-		if err := v.Check(base, current, nodeName); err != nil {
-			errs = append(errs, *err)
-		}
+	switch elem := input.Elem.(type) {
+	case providerjson.ResourceJSON:
+		return &elem
+	case *providerjson.ResourceJSON:
+		return elem
 	}
 
-	return
+	return nil
 }
 
-func nodeIsBlock(input providerjson.SchemaJSON) bool {
-	if input.Type == providerjson.SchemaTypeList || input.Type == providerjson.SchemaTypeSet {
-		if _, ok := input.Elem.(providerjson.ResourceJSON); ok {
-			return true
+// sortedKeys returns the keys present in either of the specified maps, sorted so that the output is stable
+func sortedKeys[T any](base map[string]T, current map[string]T) []string {
+	keys := make([]string, 0, len(current))
+	for k := range current {
+		keys = append(keys, k)
+	}
+	for k := range base {
+		if _, ok := current[k]; !ok {
+			keys = append(keys, k)
 		}
 	}
-
-	return false
+	sort.Strings(keys)
+	return keys
 }
@@ -11,16 +11,10 @@ import (
 )
 
 func (d *Differ) loadFromFile(fileName string) error {
-	f, err := os.Open(fileName)
+	buf, err := readFile(fileName)
 	if err != nil {
 		return err
 	}
-	defer f.Close()
-	buf := &providerjson.ProviderWrapper{}
-	// TODO - Custom marshalling to fix the type assertions later? meh, works for now...
-	if err := json.NewDecoder(f).Decode(buf); err != nil {
-		return err
-	}
 	d.base = buf
 
 	return nil
@@ -37,3 +31,18 @@ func (d *Differ) loadFromProvider(data *providerjson.ProviderJSON, providerName
 	}
 	return nil
 }
+
+func readFile(fileName string) (*providerjson.ProviderWrapper, error) {
+	f, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	buf := &providerjson.ProviderWrapper{}
+	// TODO - Custom marshalling to fix the type assertions later? meh, works for now...
+	if err := json.NewDecoder(f).Decode(buf); err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
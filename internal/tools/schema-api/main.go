@@ -26,6 +26,9 @@ func main() {
 	exportSchema := f.String("export", "", "export the schema to the given path/filename. Intended for use in the release process")
 	detectBreakingChanges := f.String("detect", "", "compare current schema to named dump.")
 	errorOnBreakingChange := f.Bool("error-on-violation", false, "should the detect mode exit with a non-zero error code. Defaults to `false`")
+	changelog := f.String("changelog", "", "output a Markdown summary of the changes between the named dump and the current schema, for use in the CHANGELOG")
+	changelogCurrent := f.String("changelog-current", "", "compare against the named dump rather than the current schema when used with -changelog")
+	changelogOutput := f.String("changelog-output", "", "write the changelog to the given path/filename rather than stdout")
 
 	if err := f.Parse(os.Args[1:]); err != nil {
 		fmt.Printf("error parsing args: %+v", err)
@@ -65,6 +68,31 @@ func main() {
 			os.Exit(0)
 		}
 
+	case pointer.From(changelog) != "":
+		{
+			out := os.Stdout
+			if pointer.From(changelogOutput) != "" {
+				f, err := os.Create(*changelogOutput)
+				if err != nil {
+					log.Fatalf("error creating %q: %+v", *changelogOutput, err)
+				}
+				out = f
+			}
+
+			d := differ.Differ{}
+			if err := d.Changelog(*changelog, pointer.From(changelogCurrent), *providerName, out); err != nil {
+				log.Fatalf("error generating changelog: %+v", err)
+			}
+			// only close the file we created, not the process's stdout
+			if out != os.Stdout {
+				if err := out.Close(); err != nil {
+					log.Fatalf("error writing changelog: %+v", err)
+				}
+			}
+
+			os.Exit(0)
+		}
+
 	case pointer.From(exportSchema) != "":
 		{
 			log.Printf("dumping schema for '%s'", *providerName)
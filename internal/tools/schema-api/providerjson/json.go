@@ -30,6 +30,7 @@ type SchemaJSON struct {
 	Description string      `json:"description,omitempty"`
 	Computed    bool        `json:"computed,omitempty"`
 	ForceNew    bool        `json:"forceNew,omitempty"`
+	Sensitive   bool        `json:"sensitive,omitempty"`
 	Elem        interface{} `json:"elem,omitempty"`
 	MaxItems    int         `json:"maxItems,omitempty"`
 	MinItems    int         `json:"minItems,omitempty"`
@@ -48,11 +49,12 @@ func (b *SchemaJSON) UnmarshalJSON(body []byte) error {
 	b.Description, _ = m["description"].(string)
 	b.Computed, _ = m["computed"].(bool)
 	b.ForceNew, _ = m["forceNew"].(bool)
+	b.Sensitive, _ = m["sensitive"].(bool)
 	if max, ok := m["maxItems"].(float64); ok {
 		b.MaxItems = int(max)
 	}
 	if min, ok := m["minItems"].(float64); ok {
-		b.MaxItems = int(min)
+		b.MinItems = int(min)
 	}
 
 	if def, ok := m["default"]; ok && def != nil {
@@ -84,8 +86,9 @@ func (b *SchemaJSON) UnmarshalJSON(body []byte) error {
 }
 
 type ResourceJSON struct {
-	Schema   map[string]SchemaJSON `json:"schema"`
-	Timeouts *ResourceTimeoutJSON  `json:"timeouts,omitempty"`
+	Schema             map[string]SchemaJSON `json:"schema"`
+	Timeouts           *ResourceTimeoutJSON  `json:"timeouts,omitempty"`
+	DeprecationMessage string                `json:"deprecationMessage,omitempty"`
 }
 
 type ResourceTimeoutJSON struct {
@@ -21,6 +21,7 @@ func resourceFromRaw(input *schema.Resource) (*ResourceJSON, error) {
 		translatedSchema[k] = schemaFromRaw(s)
 	}
 	result.Schema = translatedSchema
+	result.DeprecationMessage = input.DeprecationMessage
 
 	if input.Timeouts != nil {
 		timeouts := &ResourceTimeoutJSON{}
@@ -54,6 +55,7 @@ func schemaFromRaw(input *schema.Schema) SchemaJSON {
 		Description: input.Description,
 		Computed:    input.Computed,
 		ForceNew:    input.ForceNew,
+		Sensitive:   input.Sensitive,
 		Elem:        decodeElem(input.Elem),
 		MaxItems:    input.MaxItems,
 		MinItems:    input.MinItems,
@@ -94,8 +96,8 @@ func SchemaFromMap(input map[string]interface{}) SchemaJSON {
 		result.ForceNew = t.(bool)
 	}
 
-	if t, ok := input["forceNew"]; ok {
-		result.ForceNew = t.(bool)
+	if t, ok := input["sensitive"]; ok {
+		result.Sensitive = t.(bool)
 	}
 
 	if t, ok := input["elem"]; ok {
@@ -150,6 +152,14 @@ func decodeElem(input interface{}) interface{} {
 	case *schema.Resource:
 		r, _ := resourceFromRaw(t)
 		return r
+	case map[string]interface{}:
+		// a nested block or element (when loading from JSON) - as per SchemaJSON.UnmarshalJSON
+		if s, ok := t["schema"].(map[string]interface{}); ok {
+			return ResourceFromMap(s)
+		}
+		if s, ok := t["type"].(string); ok {
+			return s
+		}
 	}
 	return nil
 }
@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var _ BreakingChangeRule = forceNewAdded{}
+
+type forceNewAdded struct{}
+
+// Check - Checks that ForceNew is not added to an existing argument, since changes which could previously be applied in-place would recreate the resource
+func (forceNewAdded) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	if (base.Optional || base.Required) && !base.ForceNew && current.ForceNew {
+		return pointer.To(fmt.Sprintf("cannot add ForceNew to the existing property %q as changes to this would recreate the resource", propertyName))
+	}
+
+	return nil
+}
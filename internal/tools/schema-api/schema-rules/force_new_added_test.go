@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var forceNewAddedBaseNode = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeString,
+	Optional: true,
+}
+
+var forceNewAddedComputedBaseNode = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeString,
+	Computed: true,
+}
+
+var forceNewAddedPasses = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeString,
+	Optional: true,
+}
+
+var forceNewAddedViolates = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeString,
+	Optional: true,
+	ForceNew: true, // violation
+}
+
+func TestForceNewAdded_Check(t *testing.T) {
+	data := forceNewAdded{}
+	if res := data.Check(forceNewAddedBaseNode, forceNewAddedPasses, "example"); res != nil {
+		t.Errorf("expected no violation, got %+v", *res)
+	}
+	if res := data.Check(forceNewAddedViolates, forceNewAddedViolates, "example"); res != nil {
+		t.Errorf("expected no violation when the property was already ForceNew, got %+v", *res)
+	}
+	if res := data.Check(forceNewAddedComputedBaseNode, forceNewAddedViolates, "example"); res != nil {
+		t.Errorf("expected no violation when the property was Computed only, got %+v", *res)
+	}
+	if res := data.Check(forceNewAddedBaseNode, forceNewAddedViolates, "example"); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+}
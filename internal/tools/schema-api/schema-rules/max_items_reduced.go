@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var _ BreakingChangeRule = maxItemsReduced{}
+
+type maxItemsReduced struct{}
+
+// Check - Checks that MaxItems is not added or reduced, since users configurations may contain more items than are now allowed
+func (maxItemsReduced) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	if base.Type == "" || current.Type == "" || current.MaxItems == 0 {
+		return nil
+	}
+
+	if base.MaxItems == 0 || current.MaxItems < base.MaxItems {
+		return pointer.To(fmt.Sprintf("cannot reduce MaxItems for the property %q (%d to %d)", propertyName, base.MaxItems, current.MaxItems))
+	}
+
+	return nil
+}
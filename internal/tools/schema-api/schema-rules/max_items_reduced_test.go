@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var maxItemsReducedBaseNode = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeList,
+	Optional: true,
+	MaxItems: 5,
+}
+
+var maxItemsReducedUnlimitedBaseNode = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeList,
+	Optional: true,
+}
+
+var maxItemsReducedPasses = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeList,
+	Optional: true,
+	MaxItems: 10,
+}
+
+var maxItemsReducedViolates = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeList,
+	Optional: true,
+	MaxItems: 1, // violation
+}
+
+func TestMaxItemsReduced_Check(t *testing.T) {
+	data := maxItemsReduced{}
+	if res := data.Check(maxItemsReducedBaseNode, maxItemsReducedPasses, "example"); res != nil {
+		t.Errorf("expected no violation, got %+v", *res)
+	}
+	if res := data.Check(maxItemsReducedBaseNode, maxItemsReducedUnlimitedBaseNode, "example"); res != nil {
+		t.Errorf("expected no violation when MaxItems is removed, got %+v", *res)
+	}
+	if res := data.Check(providerjson.SchemaJSON{}, maxItemsReducedViolates, "example"); res != nil {
+		t.Errorf("expected no violation for a new property, got %+v", *res)
+	}
+	if res := data.Check(maxItemsReducedBaseNode, maxItemsReducedViolates, "example"); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+	if res := data.Check(maxItemsReducedUnlimitedBaseNode, maxItemsReducedViolates, "example"); res == nil {
+		t.Errorf("expected violation when MaxItems is added, but didn't get one")
+	}
+}
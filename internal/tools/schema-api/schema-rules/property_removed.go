@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var _ BreakingChangeRule = propertyRemoved{}
+
+type propertyRemoved struct{}
+
+// Check - Checks that an existing property (or block) has not been removed, since this may be referenced in users configurations
+func (propertyRemoved) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	if base.Type != "" && current.Type == "" {
+		return pointer.To(fmt.Sprintf("property %q has been removed", propertyName))
+	}
+
+	return nil
+}
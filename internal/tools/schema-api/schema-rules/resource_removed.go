@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var _ ResourceBreakingChangeRule = resourceRemoved{}
+
+type resourceRemoved struct{}
+
+// Check - Checks that a Resource/Data Source is only removed once it has been deprecated, so that users have been warned to migrate away from it
+func (resourceRemoved) Check(base providerjson.ResourceJSON, current *providerjson.ResourceJSON, resourceName string) *string {
+	if current == nil && base.DeprecationMessage == "" {
+		return pointer.To(fmt.Sprintf("%q has been removed without first being deprecated", resourceName))
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var resourceRemovedBase = providerjson.ResourceJSON{
+	Schema: map[string]providerjson.SchemaJSON{},
+}
+
+var resourceRemovedDeprecatedBase = providerjson.ResourceJSON{
+	Schema:             map[string]providerjson.SchemaJSON{},
+	DeprecationMessage: "this has been superseded by azurerm_example_v2",
+}
+
+func TestResourceRemoved_Check(t *testing.T) {
+	data := resourceRemoved{}
+	if res := data.Check(resourceRemovedBase, &resourceRemovedBase, "azurerm_example"); res != nil {
+		t.Errorf("expected no violation, got %+v", *res)
+	}
+	if res := data.Check(resourceRemovedDeprecatedBase, nil, "azurerm_example"); res != nil {
+		t.Errorf("expected no violation when the resource was deprecated, got %+v", *res)
+	}
+	if res := data.Check(resourceRemovedBase, nil, "azurerm_example"); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+}
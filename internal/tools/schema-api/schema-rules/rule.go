@@ -9,14 +9,36 @@ type BreakingChangeRule interface {
 	Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string
 }
 
+// ResourceBreakingChangeRule checks for breaking changes to a Resource/Data Source as a whole - where current is nil
+// when the Resource/Data Source has been removed
+type ResourceBreakingChangeRule interface {
+	Check(base providerjson.ResourceJSON, current *providerjson.ResourceJSON, resourceName string) *string
+}
+
 var BreakingChangeRules = []BreakingChangeRule{
 	becomeComputedOnly{},
+	forceNewAdded{},
+	maxItemsReduced{},
 	newRequiredPropertyExistingResource{},
 	optionalRemoveComputed{},
 	optionalToRequired{},
+	propertyRemoved{},
 	propertyType{},
+	sensitiveRemoved{},
 }
 
 var BreakingChangeRulesDataSource = []BreakingChangeRule{
+	propertyRemoved{},
 	propertyType{},
+	sensitiveRemoved{},
+}
+
+var ResourceBreakingChangeRules = []ResourceBreakingChangeRule{
+	resourceRemoved{},
+	timeoutsShortened{},
+}
+
+var ResourceBreakingChangeRulesDataSource = []ResourceBreakingChangeRule{
+	resourceRemoved{},
+	timeoutsShortened{},
 }
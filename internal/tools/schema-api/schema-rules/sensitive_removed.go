@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var _ BreakingChangeRule = sensitiveRemoved{}
+
+type sensitiveRemoved struct{}
+
+// Check - Checks that Sensitive is not removed from a property, since this would expose the (secret) value in the plan output
+func (sensitiveRemoved) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	if base.Sensitive && current.Type != "" && !current.Sensitive {
+		return pointer.To(fmt.Sprintf("cannot remove Sensitive from the property %q as this would expose the value", propertyName))
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var _ ResourceBreakingChangeRule = timeoutsShortened{}
+
+type timeoutsShortened struct{}
+
+// Check - Checks that the default Timeouts for a Resource/Data Source have not been shortened, since operations which previously succeeded may now time out
+func (timeoutsShortened) Check(base providerjson.ResourceJSON, current *providerjson.ResourceJSON, resourceName string) *string {
+	if current == nil || base.Timeouts == nil {
+		return nil
+	}
+
+	currentTimeouts := providerjson.ResourceTimeoutJSON{}
+	if current.Timeouts != nil {
+		currentTimeouts = *current.Timeouts
+	}
+
+	for _, v := range []struct {
+		operation string
+		base      int
+		current   int
+	}{
+		{"create", base.Timeouts.Create, currentTimeouts.Create},
+		{"read", base.Timeouts.Read, currentTimeouts.Read},
+		{"update", base.Timeouts.Update, currentTimeouts.Update},
+		{"delete", base.Timeouts.Delete, currentTimeouts.Delete},
+	} {
+		if v.base > 0 && v.current < v.base {
+			return pointer.To(fmt.Sprintf("the %s timeout for %q has been shortened (%d to %d minutes)", v.operation, resourceName, v.base, v.current))
+		}
+	}
+
+	return nil
+}
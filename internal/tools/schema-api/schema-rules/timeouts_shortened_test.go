@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var timeoutsShortenedBase = providerjson.ResourceJSON{
+	Timeouts: &providerjson.ResourceTimeoutJSON{
+		Create: 30,
+		Read:   5,
+		Update: 30,
+		Delete: 30,
+	},
+}
+
+var timeoutsShortenedPasses = providerjson.ResourceJSON{
+	Timeouts: &providerjson.ResourceTimeoutJSON{
+		Create: 60,
+		Read:   5,
+		Update: 30,
+		Delete: 30,
+	},
+}
+
+var timeoutsShortenedViolates = providerjson.ResourceJSON{
+	Timeouts: &providerjson.ResourceTimeoutJSON{
+		Create: 30,
+		Read:   5,
+		Update: 30,
+		Delete: 10, // violation
+	},
+}
+
+func TestTimeoutsShortened_Check(t *testing.T) {
+	data := timeoutsShortened{}
+	if res := data.Check(timeoutsShortenedBase, &timeoutsShortenedPasses, "azurerm_example"); res != nil {
+		t.Errorf("expected no violation, got %+v", *res)
+	}
+	if res := data.Check(timeoutsShortenedBase, nil, "azurerm_example"); res != nil {
+		t.Errorf("expected no violation when the resource is removed, got %+v", *res)
+	}
+	if res := data.Check(timeoutsShortenedBase, &timeoutsShortenedViolates, "azurerm_example"); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+	if res := data.Check(timeoutsShortenedBase, &providerjson.ResourceJSON{}, "azurerm_example"); res == nil {
+		t.Errorf("expected violation when the timeouts are removed, but didn't get one")
+	}
+}
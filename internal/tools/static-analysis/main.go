@@ -10,11 +10,18 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/static-analysis/rules"
 )
 
 var allRules = map[string]rules.Rule{
-	rules.TypedSDKBitCheck{}.Name(): rules.TypedSDKBitCheck{},
+	rules.TypedSDKBitCheck{}.Name():          rules.TypedSDKBitCheck{},
+	rules.IDValidationMatchesParser{}.Name(): rules.IDValidationMatchesParser{},
+	rules.ReadHandlesNotFound{}.Name():       rules.ReadHandlesNotFound{},
+	rules.UpdateWithAllForceNew{}.Name():     rules.UpdateWithAllForceNew{},
+	rules.ModelTagsInSchema{}.Name():         rules.ModelTagsInSchema{},
+	rules.TimeoutsNotZero{}.Name():           rules.TimeoutsNotZero{},
+	rules.DataSourceIDParser{}.Name():        rules.DataSourceIDParser{},
 }
 
 func main() {
@@ -37,19 +44,27 @@ func main() {
 		specifiedRules = []string{"all"}
 	}
 
+	services := rules.Services{
+		Typed:   provider.SupportedTypedServices(),
+		Untyped: provider.SupportedUntypedServices(),
+	}
+
 	errors := make([]error, 0)
 	for _, rule := range specifiedRules {
 		if strings.EqualFold(rule, "all") {
 			for _, r := range allRules {
-				errors = append(errors, r.Run()...)
+				errors = append(errors, r.Run(services)...)
 			}
 		}
 
 		if r, ok := allRules[rule]; ok {
-			errors = append(errors, r.Run()...)
+			errors = append(errors, r.Run(services)...)
 		}
 	}
 
+	// the same error can be reported for multiple Resources/Data Sources (e.g. which share a model), or by multiple rules
+	errors = rules.UniqueErrors(errors)
+
 	if len(errors) > 0 {
 		if *failOnError {
 			log.Fatalf("failed to run rules: %v", errors)
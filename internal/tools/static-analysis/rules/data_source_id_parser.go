@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ Rule = DataSourceIDParser{}
+
+type DataSourceIDParser struct{}
+
+func (r DataSourceIDParser) Run(services Services) (errors []error) {
+	// the Resource IDs parsed by each Resource, used to check the Data Source with the same name
+	resourceIds := make(map[string][]resourceIdReference)
+	for _, resource := range services.typedResources() {
+		if sources, err := functionSources(resource.Read().Func); err == nil {
+			resourceIds[resource.ResourceType()] = parsedResourceIds(sources...)
+		}
+	}
+	for _, resource := range services.untypedResources() {
+		if read := resource.readFunc(); read != nil {
+			if sources, err := functionSources(read); err == nil {
+				resourceIds[resource.Name] = parsedResourceIds(sources...)
+			}
+		}
+	}
+
+	for _, dataSource := range services.typedDataSources() {
+		if isException(r, dataSource.ResourceType()) {
+			continue
+		}
+		if _, ok := dataSource.(sdk.DataSourceWithItemModelObject); ok {
+			// the ID of a list Data Source is built from its arguments by the Typed SDK, rather than being a Resource ID
+			continue
+		}
+		if err := checkDataSourceResourceId(dataSource.Read().Func, resourceIds[dataSource.ResourceType()]); err != nil {
+			errors = append(errors, fmt.Errorf("%s: %+v", dataSource.ResourceType(), err))
+		}
+	}
+	for _, dataSource := range services.untypedDataSources() {
+		if isException(r, dataSource.Name) {
+			continue
+		}
+		read := dataSource.readFunc()
+		if read == nil {
+			errors = append(errors, fmt.Errorf("%s: no Read function is defined", dataSource.Name))
+			continue
+		}
+		if err := checkDataSourceResourceId(read, resourceIds[dataSource.Name]); err != nil {
+			errors = append(errors, fmt.Errorf("%s: %+v", dataSource.Name, err))
+		}
+	}
+
+	return
+}
+
+func (r DataSourceIDParser) Name() string {
+	return "dataSourceIDParser"
+}
+
+func (r DataSourceIDParser) Description() string {
+	return fmt.Sprintf(`
+The '%s' check function is used to check that the ID of each Data Source is a Resource ID which can be parsed (for
+example built using 'NewResourceGroupID') - and where a Resource exists with the same name, that the Data Source uses
+the same Resource ID as the Resource.
+`, r.Name())
+}
+
+func checkDataSourceResourceId(read interface{}, resourceIds []resourceIdReference) error {
+	sources, err := functionSources(read)
+	if err != nil {
+		return fmt.Errorf("locating the Read function: %+v", err)
+	}
+
+	ids := append(constructedResourceIds(sources...), parsedResourceIds(sources...)...)
+	if len(ids) == 0 {
+		return fmt.Errorf("the Read function doesn't construct or parse a Resource ID, so the ID of this Data Source can't be parsed")
+	}
+
+	if len(resourceIds) == 0 {
+		return nil
+	}
+	for _, id := range ids {
+		for _, resourceId := range resourceIds {
+			if id.matches(resourceId) || id.Segments == nil || resourceId.Segments == nil {
+				// Resource IDs which can't be located can't be compared
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("the Read function uses a %s but the Resource with the same name parses a %s", joinResourceIds(ids), joinResourceIds(resourceIds))
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestDataSourceIDParser(t *testing.T) {
+	services := fixtureServices([]sdk.Resource{
+		fixtureResource{
+			resourceType: "azurerm_other",
+			arguments:    exampleArguments(true),
+			model:        &exampleModel{},
+			read:         readOtherMarkedAsGone,
+			timeout:      30 * time.Minute,
+			idValidation: ValidateOtherID,
+		},
+		fixtureResource{
+			resourceType: "azurerm_renamed",
+			arguments:    exampleArguments(true),
+			model:        &exampleModel{},
+			read:         readRenamedExampleMarkedAsGone,
+			timeout:      30 * time.Minute,
+			idValidation: ValidateExampleID,
+		},
+	}, []sdk.DataSource{
+		fixtureDataSource{
+			resourceType: "azurerm_other",
+			model:        &exampleModel{},
+			read:         readExampleDataSource,
+			timeout:      5 * time.Minute,
+		},
+		fixtureDataSource{
+			resourceType: "azurerm_renamed",
+			model:        &exampleModel{},
+			read:         readExampleDataSource,
+			timeout:      5 * time.Minute,
+		},
+		sdk.NewListDataSource(fixtureListDataSource{
+			resourceType: "azurerm_examples",
+			itemModel:    &exampleItemModel{},
+		}),
+		fixtureDataSource{
+			resourceType: "azurerm_without_resource_id",
+			model:        &exampleModel{},
+			read:         readDataSourceWithoutResourceId,
+			timeout:      5 * time.Minute,
+		},
+	}, nil, map[string]*pluginsdk.Resource{
+		"azurerm_untyped_without_read": {
+			Timeouts: &pluginsdk.ResourceTimeout{Read: pluginsdk.DefaultTimeout(5 * time.Minute)},
+			Schema:   exampleArguments(false),
+		},
+	})
+
+	assertErrors(t, DataSourceIDParser{}.Run(services),
+		"azurerm_other: the Read function uses a ExampleID (/examples/{}) but the Resource with the same name parses a OtherID (/others/{})",
+		"azurerm_without_resource_id: the Read function doesn't construct or parse a Resource ID",
+		"azurerm_untyped_without_read: no Read function is defined",
+	)
+}
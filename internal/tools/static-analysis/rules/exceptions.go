@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+// exceptions are the Resources and Data Sources which are intentionally excluded from a Rule, keyed by the name of
+// the Rule and then the name of the Resource/Data Source, with the reason each is excluded.
+//
+// This shouldn't be used to exclude new Resources/Data Sources, which should instead be fixed.
+var exceptions = map[string]map[string]string{
+	DataSourceIDParser{}.Name(): {
+		"azurerm_billing_enrollment_account_scope":      "the ID is a Billing Scope, which is formatted from the arguments",
+		"azurerm_billing_mca_account_scope":             "the ID is a Billing Scope, which is formatted from the arguments",
+		"azurerm_billing_mpa_account_scope":             "the ID is a Billing Scope, which is formatted from the arguments",
+		"azurerm_client_config":                         "the ID is an encoded identifier for the authenticated client, since this isn't an Azure Resource",
+		"azurerm_eventgrid_verified_partners":           "the ID is the tenant-level collection of Verified Partners, which isn't a Resource ID",
+		"azurerm_eventhub_sas":                          "the ID is a hash of the generated token, since this isn't an Azure Resource",
+		"azurerm_key_vault_access_policy":               "the ID is the name of the built-in Access Policy template, since this isn't an Azure Resource",
+		"azurerm_management_group_template_deployment":  "the ID is the one returned from the API, rather than a Resource ID built from the arguments",
+		"azurerm_resource_group":                        "the ID is the one returned from the API, which is cased incorrectly (`resourcegroups`) and needs to be fixed in a major version",
+		"azurerm_resources":                             "the ID is randomly generated, since this lists Resources of different types",
+		"azurerm_storage_account_blob_container_sas":    "the ID is a hash of the generated token, since this isn't an Azure Resource",
+		"azurerm_storage_account_sas":                   "the ID is a hash of the generated token, since this isn't an Azure Resource",
+		"azurerm_subscription":                          "the Data Source retrieves a Subscription, whereas the Resource manages a Subscription Alias",
+		"azurerm_subscriptions":                         "the ID is scoped to the Tenant, since this lists the Subscriptions available",
+		"azurerm_traffic_manager_geographical_location": "the ID is the code of the Geographic Location, which isn't a Resource ID",
+	},
+	ReadHandlesNotFound{}.Name(): {
+		"azurerm_container_registry_task_schedule_run_now": "this triggers a run of the Task, which can't be retrieved afterwards",
+		"azurerm_security_center_setting":                  "each Setting always exists within a Subscription, such that deleting this disables the Setting",
+	},
+}
+
+// isException returns whether the Resource/Data Source with the specified name is excluded from the specified Rule
+func isException(rule Rule, name string) bool {
+	_, ok := exceptions[rule.Name()][name]
+	return ok
+}
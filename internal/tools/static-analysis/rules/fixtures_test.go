@@ -0,0 +1,487 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// This file contains the fixtures (that is, the Services, Resources and Data Sources) which each Rule is tested against,
+// where the source of the Read functions below is inspected by the Rules, and as such these are never called.
+
+type ExampleId struct {
+	Name string
+}
+
+func NewExampleID(name string) ExampleId {
+	return ExampleId{Name: name}
+}
+
+func ParseExampleID(input string) (*ExampleId, error) {
+	return &ExampleId{Name: strings.TrimPrefix(input, "/examples/")}, nil
+}
+
+func ValidateExampleID(input interface{}, key string) (warnings []string, errors []error) {
+	if _, err := ParseExampleID(input.(string)); err != nil {
+		errors = append(errors, err)
+	}
+	return
+}
+
+func (id ExampleId) ID() string {
+	fmtString := "/examples/%s"
+	return fmt.Sprintf(fmtString, id.Name)
+}
+
+func (id ExampleId) String() string {
+	return id.ID()
+}
+
+// RenamedExampleId is defined with a different name to ExampleId, but is comprised of the same segments
+type RenamedExampleId struct {
+	Name string
+}
+
+func ParseRenamedExampleID(input string) (*RenamedExampleId, error) {
+	return &RenamedExampleId{Name: strings.TrimPrefix(input, "/Examples/")}, nil
+}
+
+func (id RenamedExampleId) ID() string {
+	fmtString := "/Examples/%s"
+	return fmt.Sprintf(fmtString, id.Name)
+}
+
+func (id RenamedExampleId) String() string {
+	return id.ID()
+}
+
+type OtherId struct {
+	Name string
+}
+
+func ParseOtherID(input string) (*OtherId, error) {
+	return &OtherId{Name: strings.TrimPrefix(input, "/others/")}, nil
+}
+
+func ValidateOtherID(input interface{}, key string) (warnings []string, errors []error) {
+	if _, err := ParseOtherID(input.(string)); err != nil {
+		errors = append(errors, err)
+	}
+	return
+}
+
+func (id OtherId) ID() string {
+	fmtString := "/others/%s"
+	return fmt.Sprintf(fmtString, id.Name)
+}
+
+func (id OtherId) String() string {
+	return id.ID()
+}
+
+func readExampleMarkedAsGone(ctx context.Context, metadata sdk.ResourceMetaData) error {
+	id, err := ParseExampleID(metadata.ResourceData.Id())
+	if err != nil {
+		return err
+	}
+	return metadata.MarkAsGone(id)
+}
+
+func readExampleWithoutNotFound(ctx context.Context, metadata sdk.ResourceMetaData) error {
+	id, err := ParseExampleID(metadata.ResourceData.Id())
+	if err != nil {
+		return err
+	}
+	return metadata.Encode(&exampleModel{Name: id.Name})
+}
+
+func readOtherMarkedAsGone(ctx context.Context, metadata sdk.ResourceMetaData) error {
+	id, err := ParseOtherID(metadata.ResourceData.Id())
+	if err != nil {
+		return err
+	}
+	return metadata.MarkAsGone(id)
+}
+
+func readRenamedExampleMarkedAsGone(ctx context.Context, metadata sdk.ResourceMetaData) error {
+	id, err := ParseRenamedExampleID(metadata.ResourceData.Id())
+	if err != nil {
+		return err
+	}
+	return metadata.MarkAsGone(id)
+}
+
+func readExampleDataSource(ctx context.Context, metadata sdk.ResourceMetaData) error {
+	id := NewExampleID(metadata.ResourceData.Get("name").(string))
+	metadata.SetID(id)
+	return nil
+}
+
+func readDataSourceWithoutResourceId(ctx context.Context, metadata sdk.ResourceMetaData) error {
+	metadata.ResourceData.SetId(time.Now().UTC().String())
+	return nil
+}
+
+func readUntypedExample(d *pluginsdk.ResourceData, meta interface{}) error {
+	if _, err := ParseExampleID(d.Id()); err != nil {
+		d.SetId("")
+	}
+	return nil
+}
+
+// readUntypedDelegating delegates to another Read function, which is inspected alongside this
+func readUntypedDelegating(d *pluginsdk.ResourceData, meta interface{}) error {
+	return readUntypedExample(d, meta)
+}
+
+func readUntypedWithoutNotFound(d *pluginsdk.ResourceData, meta interface{}) error {
+	_, err := ParseExampleID(d.Id())
+	return err
+}
+
+func readUntypedDataSource(d *pluginsdk.ResourceData, meta interface{}) error {
+	d.SetId(NewExampleID(d.Get("name").(string)).ID())
+	return nil
+}
+
+func updateUntyped(d *pluginsdk.ResourceData, meta interface{}) error {
+	return nil
+}
+
+type exampleModel struct {
+	Name   string         `tfschema:"name"`
+	Blocks []exampleBlock `tfschema:"block"`
+}
+
+type exampleBlock struct {
+	Value string `tfschema:"value"`
+}
+
+type exampleModelWithMissingTags struct {
+	Name    string                    `tfschema:"name"`
+	Missing string                    `tfschema:"missing"`
+	Blocks  []exampleBlockMissingTags `tfschema:"block"`
+	Ignored string
+}
+
+type exampleBlockMissingTags struct {
+	Value   string `tfschema:"value"`
+	Nested  string `tfschema:"nested_missing"`
+	Removed string `tfschema:"removed,removedInNextMajorVersion"`
+}
+
+func exampleArguments(forceNew bool) map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"block": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: forceNew,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"value": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func exampleAttributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"computed": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+var _ sdk.Resource = fixtureResource{}
+
+type fixtureResource struct {
+	resourceType string
+	arguments    map[string]*pluginsdk.Schema
+	model        interface{}
+	read         sdk.ResourceRunFunc
+	timeout      time.Duration
+	idValidation pluginsdk.SchemaValidateFunc
+}
+
+func (r fixtureResource) Arguments() map[string]*pluginsdk.Schema {
+	return r.arguments
+}
+
+func (r fixtureResource) Attributes() map[string]*pluginsdk.Schema {
+	return exampleAttributes()
+}
+
+func (r fixtureResource) ModelObject() interface{} {
+	return r.model
+}
+
+func (r fixtureResource) ResourceType() string {
+	return r.resourceType
+}
+
+func (r fixtureResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: r.timeout,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return nil
+		},
+	}
+}
+
+func (r fixtureResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func:    r.read,
+	}
+}
+
+func (r fixtureResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return nil
+		},
+	}
+}
+
+func (r fixtureResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return r.idValidation
+}
+
+var _ sdk.ResourceWithUpdate = fixtureResourceWithUpdate{}
+
+type fixtureResourceWithUpdate struct {
+	fixtureResource
+}
+
+func (r fixtureResourceWithUpdate) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return nil
+		},
+	}
+}
+
+var _ sdk.DataSource = fixtureDataSource{}
+
+type fixtureDataSource struct {
+	resourceType string
+	model        interface{}
+	read         sdk.ResourceRunFunc
+	timeout      time.Duration
+}
+
+func (d fixtureDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return exampleArguments(false)
+}
+
+func (d fixtureDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return exampleAttributes()
+}
+
+func (d fixtureDataSource) ModelObject() interface{} {
+	return d.model
+}
+
+func (d fixtureDataSource) ResourceType() string {
+	return d.resourceType
+}
+
+func (d fixtureDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: d.timeout,
+		Func:    d.read,
+	}
+}
+
+type exampleItemModel struct {
+	Id   string `tfschema:"id"`
+	Name string `tfschema:"name"`
+}
+
+type exampleItemModelWithInt struct {
+	Id    string `tfschema:"id"`
+	Name  string `tfschema:"name"`
+	Count int    `tfschema:"count"`
+}
+
+var _ sdk.ListDataSource = fixtureListDataSource{}
+
+type fixtureListDataSource struct {
+	resourceType string
+	itemModel    interface{}
+}
+
+func (d fixtureListDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (d fixtureListDataSource) ItemAttributes() map[string]*pluginsdk.Schema {
+	return exampleAttributes()
+}
+
+func (d fixtureListDataSource) ItemModelObject() interface{} {
+	return d.itemModel
+}
+
+func (d fixtureListDataSource) ItemsAttribute() string {
+	return "examples"
+}
+
+func (d fixtureListDataSource) ResourceType() string {
+	return d.resourceType
+}
+
+func (d fixtureListDataSource) List() sdk.ListFunc {
+	return sdk.ListFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData, addPage sdk.ListPageFunc) error {
+			return nil
+		},
+	}
+}
+
+var _ sdk.TypedServiceRegistration = fixtureTypedService{}
+
+type fixtureTypedService struct {
+	resources   []sdk.Resource
+	dataSources []sdk.DataSource
+}
+
+func (s fixtureTypedService) Name() string {
+	return "Fixtures"
+}
+
+func (s fixtureTypedService) DataSources() []sdk.DataSource {
+	return s.dataSources
+}
+
+func (s fixtureTypedService) Resources() []sdk.Resource {
+	return s.resources
+}
+
+func (s fixtureTypedService) WebsiteCategories() []string {
+	return nil
+}
+
+var _ sdk.UntypedServiceRegistration = fixtureUntypedService{}
+
+type fixtureUntypedService struct {
+	resources   map[string]*pluginsdk.Resource
+	dataSources map[string]*pluginsdk.Resource
+}
+
+func (s fixtureUntypedService) Name() string {
+	return "Untyped Fixtures"
+}
+
+func (s fixtureUntypedService) WebsiteCategories() []string {
+	return nil
+}
+
+func (s fixtureUntypedService) SupportedDataSources() map[string]*pluginsdk.Resource {
+	return s.dataSources
+}
+
+func (s fixtureUntypedService) SupportedResources() map[string]*pluginsdk.Resource {
+	return s.resources
+}
+
+func untypedTimeouts(create, read, update, delete time.Duration) *pluginsdk.ResourceTimeout {
+	return &pluginsdk.ResourceTimeout{
+		Create: &create,
+		Read:   &read,
+		Update: &update,
+		Delete: &delete,
+	}
+}
+
+// fixtureServices returns Services containing a valid Resource/Data Source, alongside the specified Resources/Data Sources
+func fixtureServices(resources []sdk.Resource, dataSources []sdk.DataSource, untypedResources map[string]*pluginsdk.Resource, untypedDataSources map[string]*pluginsdk.Resource) Services {
+	resources = append([]sdk.Resource{
+		fixtureResourceWithUpdate{
+			fixtureResource: fixtureResource{
+				resourceType: "azurerm_valid",
+				arguments:    exampleArguments(false),
+				model:        &exampleModel{},
+				read:         readExampleMarkedAsGone,
+				timeout:      30 * time.Minute,
+				idValidation: ValidateExampleID,
+			},
+		},
+	}, resources...)
+	dataSources = append([]sdk.DataSource{
+		fixtureDataSource{
+			resourceType: "azurerm_valid",
+			model:        &exampleModel{},
+			read:         readExampleDataSource,
+			timeout:      5 * time.Minute,
+		},
+	}, dataSources...)
+
+	if untypedResources == nil {
+		untypedResources = map[string]*pluginsdk.Resource{}
+	}
+	untypedResources["azurerm_valid_untyped"] = &pluginsdk.Resource{
+		Read:     readUntypedExample,
+		Update:   updateUntyped,
+		Timeouts: untypedTimeouts(30*time.Minute, 5*time.Minute, 30*time.Minute, 30*time.Minute),
+		Schema:   exampleArguments(false),
+	}
+	if untypedDataSources == nil {
+		untypedDataSources = map[string]*pluginsdk.Resource{}
+	}
+	untypedDataSources["azurerm_valid_untyped"] = &pluginsdk.Resource{
+		Read:     readUntypedDataSource,
+		Timeouts: &pluginsdk.ResourceTimeout{Read: pluginsdk.DefaultTimeout(5 * time.Minute)},
+		Schema:   exampleArguments(false),
+	}
+
+	return Services{
+		Typed: []sdk.TypedServiceRegistration{
+			fixtureTypedService{
+				resources:   resources,
+				dataSources: dataSources,
+			},
+		},
+		Untyped: []sdk.UntypedServiceRegistration{
+			fixtureUntypedService{
+				resources:   untypedResources,
+				dataSources: untypedDataSources,
+			},
+		},
+	}
+}
+
+// assertErrors checks that the specified errors match the expected errors (by checking each contains the expected substring)
+func assertErrors(t *testing.T, actual []error, expected ...string) {
+	t.Helper()
+
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d errors but got %d: %+v", len(expected), len(actual), actual)
+	}
+	for i, err := range actual {
+		if !strings.Contains(err.Error(), expected[i]) {
+			t.Fatalf("expected error %d to contain %q but got %q", i, expected[i], err.Error())
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"strings"
+)
+
+var _ Rule = IDValidationMatchesParser{}
+
+type IDValidationMatchesParser struct{}
+
+func (r IDValidationMatchesParser) Run(services Services) (errors []error) {
+	for _, resource := range services.typedResources() {
+		if isException(r, resource.ResourceType()) {
+			continue
+		}
+
+		validationFunc := functionName(resource.IDValidationFunc())
+		expected := resourceIdForValidationFunc(validationFunc)
+		if expected == "" {
+			// the IDValidationFunc isn't specific to a Resource ID (e.g. a closure), so can't be checked
+			continue
+		}
+
+		// the Resource ID validated is the one parsed within the IDValidationFunc, falling back to the name of the
+		// IDValidationFunc when this can't be located
+		validated := resourceIdReference{Name: expected}
+		if src, err := functionSource(resource.IDValidationFunc()); err == nil {
+			ids := parsedResourceIds(*src)
+			for _, id := range ids {
+				if id.Name == expected || len(ids) == 1 {
+					validated = id
+				}
+			}
+		}
+
+		sources, err := functionSources(resource.Read().Func)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%s: locating the Read function: %+v", resource.ResourceType(), err))
+			continue
+		}
+
+		parsed := parsedResourceIds(sources...)
+		if len(parsed) == 0 {
+			// the Resource ID is parsed elsewhere (e.g. in a helper function), so can't be checked
+			continue
+		}
+
+		matches := false
+		comparable := validated.Segments != nil
+		for _, id := range parsed {
+			if validated.matches(id) {
+				matches = true
+			}
+			if id.Segments == nil {
+				comparable = false
+			}
+		}
+		if !matches && comparable {
+			errors = append(errors, fmt.Errorf("%s: the IDValidationFunc `%s` validates a %s but the Read function parses a %s", resource.ResourceType(), validationFunc, validated, joinResourceIds(parsed)))
+		}
+	}
+
+	return
+}
+
+func (r IDValidationMatchesParser) Name() string {
+	return "idValidationMatchesParser"
+}
+
+func (r IDValidationMatchesParser) Description() string {
+	return fmt.Sprintf(`
+The '%s' check function is used to check that the IDValidationFunc for a Typed Resource validates the same Resource ID
+which is parsed in the Read function, for example 'ValidateResourceGroupID' and 'ParseResourceGroupID' - where the
+Resource IDs are compared by their segments, since different packages can define the same Resource ID.
+`, r.Name())
+}
+
+// resourceIdForValidationFunc returns the Resource ID type validated by the specified function - either a go-azure-sdk
+// validation function (e.g. `resourcegroups.ValidateResourceGroupID`) or a `validate` package (e.g. `validate.ResourceGroupID`)
+func resourceIdForValidationFunc(name string) string {
+	pkg, fn, ok := strings.Cut(name, ".")
+	if !ok || strings.Contains(fn, ".") {
+		return ""
+	}
+
+	if strings.HasPrefix(fn, "Validate") && strings.HasSuffix(fn, "ID") {
+		return strings.TrimPrefix(fn, "Validate")
+	}
+	if pkg == "validate" && strings.HasSuffix(fn, "ID") {
+		return fn
+	}
+	return ""
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+func TestIDValidationMatchesParser(t *testing.T) {
+	services := fixtureServices([]sdk.Resource{
+		fixtureResource{
+			resourceType: "azurerm_mismatched",
+			arguments:    exampleArguments(true),
+			model:        &exampleModel{},
+			read:         readOtherMarkedAsGone,
+			timeout:      30 * time.Minute,
+			idValidation: ValidateExampleID,
+		},
+		fixtureResource{
+			resourceType: "azurerm_renamed",
+			arguments:    exampleArguments(true),
+			model:        &exampleModel{},
+			read:         readRenamedExampleMarkedAsGone,
+			timeout:      30 * time.Minute,
+			idValidation: ValidateExampleID,
+		},
+		fixtureResource{
+			resourceType: "azurerm_closure",
+			arguments:    exampleArguments(true),
+			model:        &exampleModel{},
+			read:         readOtherMarkedAsGone,
+			timeout:      30 * time.Minute,
+			idValidation: func(input interface{}, key string) (warnings []string, errors []error) {
+				return
+			},
+		},
+	}, nil, nil, nil)
+
+	assertErrors(t, IDValidationMatchesParser{}.Run(services),
+		"azurerm_mismatched: the IDValidationFunc `rules.ValidateExampleID` validates a ExampleID (/examples/{}) but the Read function parses a OtherID (/others/{})",
+	)
+}
+
+func TestResourceIdForValidationFunc(t *testing.T) {
+	testData := map[string]string{
+		"resourcegroups.ValidateResourceGroupID": "ResourceGroupID",
+		"validate.StorageAccountID":              "StorageAccountID",
+		"validation.StringIsNotEmpty":            "",
+		"parse.ValidateExample.func1":            "",
+	}
+	for input, expected := range testData {
+		if actual := resourceIdForValidationFunc(input); actual != expected {
+			t.Fatalf("expected %q for %q but got %q", expected, input, actual)
+		}
+	}
+}
@@ -3,8 +3,33 @@
 
 package rules
 
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
 type Rule interface {
-	Run() []error
+	Run(services Services) []error
 	Name() string
 	Description() string
 }
+
+// Services are the Service Registrations which the Rules are run against
+type Services struct {
+	Typed   []sdk.TypedServiceRegistration
+	Untyped []sdk.UntypedServiceRegistration
+}
+
+// UniqueErrors returns the specified errors with any duplicates (that is, errors with the same message) removed,
+// retaining the order of the errors
+func UniqueErrors(errors []error) []error {
+	out := make([]error, 0)
+	seen := make(map[string]struct{})
+	for _, err := range errors {
+		if _, ok := seen[err.Error()]; ok {
+			continue
+		}
+		seen[err.Error()] = struct{}{}
+		out = append(out, err)
+	}
+	return out
+}
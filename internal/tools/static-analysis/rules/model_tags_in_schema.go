@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ Rule = ModelTagsInSchema{}
+
+type ModelTagsInSchema struct{}
+
+func (r ModelTagsInSchema) Run(services Services) (errors []error) {
+	for _, resource := range services.typedResources() {
+		errors = append(errors, checkModelTags(resource.ResourceType(), resource.ModelObject(), schemaForResource(resource))...)
+	}
+
+	for _, dataSource := range services.typedDataSources() {
+		errors = append(errors, checkModelTags(dataSource.ResourceType(), dataSource.ModelObject(), schemaForResource(dataSource))...)
+	}
+
+	// a Resource and Data Source with the same name commonly share (parts of) a model, which only need reporting once
+	return UniqueErrors(errors)
+}
+
+func (r ModelTagsInSchema) Name() string {
+	return "modelTagsInSchema"
+}
+
+func (r ModelTagsInSchema) Description() string {
+	return fmt.Sprintf(`
+The '%s' check function is used to check that each 'tfschema' tag within the model for a Typed Resource/Data Source
+is defined in the Schema (including within nested blocks).
+`, r.Name())
+}
+
+func checkModelTags(resourceType string, modelObject interface{}, schema map[string]*pluginsdk.Schema) []error {
+	modelType := reflect.TypeOf(modelObject)
+	if modelType == nil || modelType.Kind() != reflect.Ptr || modelType.Elem().Kind() != reflect.Struct {
+		// base types don't have a model (e.g. roleAssignmentBaseResource) - which is checked by `checkBittiness`
+		return nil
+	}
+
+	errors := make([]error, 0)
+	for _, err := range checkModelTagsForType(modelType.Elem(), schema) {
+		errors = append(errors, fmt.Errorf("%s: %+v", resourceType, err))
+	}
+	return errors
+}
+
+// fieldInMajorVersion returns whether a field with the specified struct tag options (e.g. `removedInNextMajorVersion`)
+// is encoded into the State in the current major version of the Provider - matching the Typed SDK
+func fieldInMajorVersion(options string) bool {
+	for _, option := range strings.Split(options, ",") {
+		option = strings.TrimSpace(option)
+		if strings.EqualFold(option, "removedInNextMajorVersion") && features.FourPointOhBeta() {
+			return false
+		}
+		if strings.EqualFold(option, "addedInNextMajorVersion") && !features.FourPointOhBeta() {
+			return false
+		}
+	}
+	return true
+}
+
+func checkModelTagsForType(model reflect.Type, schema map[string]*pluginsdk.Schema) (errors []error) {
+	for i := 0; i < model.NumField(); i++ {
+		field := model.Field(i)
+		tag, ok := field.Tag.Lookup("tfschema")
+		if !ok {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		name = strings.TrimSpace(name)
+		if name == "" || name == "-" || !fieldInMajorVersion(options) {
+			continue
+		}
+
+		fieldSchema, ok := schema[name]
+		if !ok {
+			errors = append(errors, fmt.Errorf("the field %s in the model %s has the tfschema tag %q which isn't defined in the Schema", field.Name, model.Name(), name))
+			continue
+		}
+
+		// nested blocks are a (pointer to a) slice of structs, which are checked against the nested Schema
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() != reflect.Slice {
+			continue
+		}
+		elemType := fieldType.Elem()
+		if elemType.Kind() == reflect.Ptr {
+			elemType = elemType.Elem()
+		}
+		nested, ok := fieldSchema.Elem.(*pluginsdk.Resource)
+		if elemType.Kind() != reflect.Struct || !ok {
+			continue
+		}
+		errors = append(errors, checkModelTagsForType(elemType, nested.Schema)...)
+	}
+
+	return
+}
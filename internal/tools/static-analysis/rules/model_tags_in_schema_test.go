@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+func TestModelTagsInSchema(t *testing.T) {
+	services := fixtureServices([]sdk.Resource{
+		fixtureResource{
+			resourceType: "azurerm_missing_tags",
+			arguments:    exampleArguments(true),
+			model:        &exampleModelWithMissingTags{},
+			read:         readExampleMarkedAsGone,
+			timeout:      30 * time.Minute,
+			idValidation: ValidateExampleID,
+		},
+		fixtureResource{
+			resourceType: "azurerm_without_model",
+			arguments:    exampleArguments(true),
+			read:         readExampleMarkedAsGone,
+			timeout:      30 * time.Minute,
+			idValidation: ValidateExampleID,
+		},
+	}, []sdk.DataSource{
+		fixtureDataSource{
+			resourceType: "azurerm_missing_tags",
+			model:        &exampleModelWithMissingTags{},
+			read:         readExampleDataSource,
+			timeout:      5 * time.Minute,
+		},
+	}, nil, nil)
+
+	assertErrors(t, ModelTagsInSchema{}.Run(services),
+		"azurerm_missing_tags: the field Missing in the model exampleModelWithMissingTags has the tfschema tag \"missing\"",
+		"azurerm_missing_tags: the field Nested in the model exampleBlockMissingTags has the tfschema tag \"nested_missing\"",
+	)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+)
+
+var _ Rule = ReadHandlesNotFound{}
+
+type ReadHandlesNotFound struct{}
+
+func (r ReadHandlesNotFound) Run(services Services) (errors []error) {
+	for _, resource := range services.typedResources() {
+		if isException(r, resource.ResourceType()) {
+			continue
+		}
+		if err := checkReadHandlesNotFound(resource.Read().Func); err != nil {
+			errors = append(errors, fmt.Errorf("%s: %+v", resource.ResourceType(), err))
+		}
+	}
+
+	for _, resource := range services.untypedResources() {
+		if isException(r, resource.Name) {
+			continue
+		}
+		read := resource.readFunc()
+		if read == nil {
+			errors = append(errors, fmt.Errorf("%s: no Read function is defined", resource.Name))
+			continue
+		}
+		if err := checkReadHandlesNotFound(read); err != nil {
+			errors = append(errors, fmt.Errorf("%s: %+v", resource.Name, err))
+		}
+	}
+
+	return
+}
+
+func (r ReadHandlesNotFound) Name() string {
+	return "readHandlesNotFound"
+}
+
+func (r ReadHandlesNotFound) Description() string {
+	return fmt.Sprintf(`
+The '%s' check function is used to check that the Read function for a Resource removes this from the State when it
+no longer exists - either by calling 'metadata.MarkAsGone' or 'd.SetId("")', or by checking for a 404 response.
+`, r.Name())
+}
+
+// notFoundIdentifiers are the identifiers which indicate that a Read function handles the resource no longer existing
+var notFoundIdentifiers = []string{
+	"MarkAsGone",
+	"ResponseWasNotFound",
+	"StatusNotFound",
+	"WasNotFound",
+}
+
+func checkReadHandlesNotFound(read interface{}) error {
+	sources, err := functionSources(read)
+	if err != nil {
+		return fmt.Errorf("locating the Read function: %+v", err)
+	}
+
+	for _, src := range sources {
+		if referencesIdentifier(src.body, notFoundIdentifiers...) {
+			return nil
+		}
+
+		for _, call := range calledFunctions(src.body) {
+			if call.Name != "SetId" || len(call.Args) != 1 {
+				continue
+			}
+			if lit, ok := call.Args[0].(*ast.BasicLit); ok && lit.Kind == token.STRING && lit.Value == `""` {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("the Read function doesn't call `MarkAsGone` or check for a 404 response, so this won't be removed from the State when it no longer exists")
+}
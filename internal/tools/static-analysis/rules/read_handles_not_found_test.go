@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestReadHandlesNotFound(t *testing.T) {
+	services := fixtureServices([]sdk.Resource{
+		fixtureResource{
+			resourceType: "azurerm_without_not_found",
+			arguments:    exampleArguments(true),
+			model:        &exampleModel{},
+			read:         readExampleWithoutNotFound,
+			timeout:      30 * time.Minute,
+			idValidation: ValidateExampleID,
+		},
+		fixtureResource{
+			resourceType: "azurerm_closure_with_status_check",
+			arguments:    exampleArguments(true),
+			model:        &exampleModel{},
+			read: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+				statusCode := 404
+				if statusCode == http.StatusNotFound {
+					return nil
+				}
+				return nil
+			},
+			timeout:      30 * time.Minute,
+			idValidation: ValidateExampleID,
+		},
+	}, nil, map[string]*pluginsdk.Resource{
+		"azurerm_untyped_without_not_found": {
+			Read:     readUntypedWithoutNotFound,
+			Timeouts: untypedTimeouts(time.Minute, time.Minute, time.Minute, time.Minute),
+			Schema:   exampleArguments(true),
+		},
+		"azurerm_untyped_delegating": {
+			Read:     readUntypedDelegating,
+			Timeouts: untypedTimeouts(time.Minute, time.Minute, time.Minute, time.Minute),
+			Schema:   exampleArguments(true),
+		},
+	}, nil)
+
+	assertErrors(t, ReadHandlesNotFound{}.Run(services),
+		"azurerm_without_not_found: the Read function doesn't call `MarkAsGone` or check for a 404 response",
+		"azurerm_untyped_without_not_found: the Read function doesn't call `MarkAsGone` or check for a 404 response",
+	)
+}
@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+type resourceIdSegmentType string
+
+const (
+	// staticSegment is a fixed value, e.g. `resourceGroups` or `Microsoft.Web`
+	staticSegment resourceIdSegmentType = "static"
+
+	// userSpecifiedSegment is a value which varies between Resource IDs, e.g. the name of the Resource Group
+	userSpecifiedSegment resourceIdSegmentType = "user"
+
+	// scopeSegment is a (nested) Resource ID which this Resource ID is scoped to, comprising one or more segments
+	scopeSegment resourceIdSegmentType = "scope"
+)
+
+type resourceIdSegment struct {
+	Type  resourceIdSegmentType
+	Value string
+}
+
+// resourceIdReference is a Resource ID which is parsed or constructed within a function
+type resourceIdReference struct {
+	// Name is the name of the Resource ID, e.g. `ResourceGroupID`
+	Name string
+
+	// Segments are the segments which the Resource ID is comprised of, which is nil when the type of the Resource ID
+	// couldn't be located (and so can't be compared)
+	Segments []resourceIdSegment
+}
+
+func (r resourceIdReference) String() string {
+	if r.Segments == nil {
+		return r.Name
+	}
+
+	out := make([]string, 0)
+	for _, segment := range r.Segments {
+		switch segment.Type {
+		case staticSegment:
+			out = append(out, segment.Value)
+		case userSpecifiedSegment:
+			out = append(out, "{}")
+		case scopeSegment:
+			out = append(out, "{scope}")
+		}
+	}
+	return r.Name + " (/" + strings.Join(out, "/") + ")"
+}
+
+func joinResourceIds(ids []resourceIdReference) string {
+	out := make([]string, 0)
+	for _, id := range ids {
+		out = append(out, id.String())
+	}
+	return strings.Join(out, ", ")
+}
+
+// matches returns whether this Resource ID is either the same type as, or is comprised of the same segments as, the
+// other Resource ID - for example a `commonids.AppServiceId` and a `parse.WebAppId` both being `Microsoft.Web/sites`
+func (r resourceIdReference) matches(other resourceIdReference) bool {
+	if r.Name == other.Name {
+		return true
+	}
+	if r.Segments == nil || other.Segments == nil {
+		return false
+	}
+	return segmentsMatch(r.Segments, other.Segments)
+}
+
+func segmentsMatch(first, second []resourceIdSegment) bool {
+	switch {
+	case len(first) == 0 && len(second) == 0:
+		return true
+
+	case len(first) > 0 && first[0].Type == scopeSegment:
+		// a scope comprises one or more segments of the other Resource ID
+		for i := 1; i <= len(second); i++ {
+			if segmentsMatch(first[1:], second[i:]) {
+				return true
+			}
+		}
+		return false
+
+	case len(second) > 0 && second[0].Type == scopeSegment:
+		return segmentsMatch(second, first)
+
+	case len(first) == 0 || len(second) == 0:
+		return false
+
+	case first[0].Type != second[0].Type:
+		return false
+
+	case first[0].Type == staticSegment && !strings.EqualFold(first[0].Value, second[0].Value):
+		return false
+	}
+
+	return segmentsMatch(first[1:], second[1:])
+}
+
+// newResourceIdReference returns a reference to the Resource ID `name` (e.g. `ResourceGroupID`) returned from the
+// function `call` (e.g. `ParseResourceGroupID`), as called within the specified function
+func newResourceIdReference(src sourceFunction, call calledFunction, name string) resourceIdReference {
+	reference := resourceIdReference{
+		Name: name,
+	}
+
+	dir := importedPackageDir(src.fileName, src.file, call.Qualifier)
+	if dir == "" {
+		return reference
+	}
+	files, err := packageFiles(dir)
+	if err != nil {
+		return reference
+	}
+
+	// the type of the Resource ID is the type returned from the function, following any type aliases (for example
+	// `commonids.WebAppId` is an alias of `commonids.AppServiceId`)
+	typeName := ""
+	aliases := make(map[string]string)
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			switch t := decl.(type) {
+			case *ast.FuncDecl:
+				if t.Recv == nil && t.Name.Name == call.Name && t.Type.Results != nil && len(t.Type.Results.List) > 0 {
+					typeName = localTypeName(t.Type.Results.List[0].Type)
+				}
+			case *ast.GenDecl:
+				for _, spec := range t.Specs {
+					if v, ok := spec.(*ast.TypeSpec); ok && v.Assign.IsValid() {
+						aliases[v.Name.Name] = localTypeName(v.Type)
+					}
+				}
+			}
+		}
+	}
+	for i := 0; i < len(aliases) && aliases[typeName] != ""; i++ {
+		typeName = aliases[typeName]
+	}
+	if typeName == "" {
+		return reference
+	}
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil || fn.Recv == nil || len(fn.Recv.List) != 1 || localTypeName(fn.Recv.List[0].Type) != typeName {
+				continue
+			}
+
+			switch fn.Name.Name {
+			case "Segments":
+				// the go-azure-sdk defines the segments for each Resource ID, which are preferred when available
+				if segments := segmentsFromSegmentsFunc(fn.Body); segments != nil {
+					reference.Segments = segments
+					return reference
+				}
+			case "ID":
+				// whereas the Resource IDs within the `parse` packages are formatted using a format string
+				if segments := segmentsFromIdFunc(fn.Body); segments != nil {
+					reference.Segments = segments
+				}
+			}
+		}
+	}
+
+	return reference
+}
+
+// localTypeName returns the name of the type (or pointer to a type) defined within the same package
+func localTypeName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// segmentsFromSegmentsFunc returns the segments defined within a go-azure-sdk `Segments` function, for example:
+//
+//	return []resourceids.Segment{
+//		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+//		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+//	}
+func segmentsFromSegmentsFunc(body *ast.BlockStmt) []resourceIdSegment {
+	var lit *ast.CompositeLit
+	ast.Inspect(body, func(n ast.Node) bool {
+		if v, ok := n.(*ast.CompositeLit); ok && lit == nil {
+			lit = v
+		}
+		return lit == nil
+	})
+	if lit == nil {
+		return nil
+	}
+
+	segments := make([]resourceIdSegment, 0)
+	for _, elt := range lit.Elts {
+		call, ok := elt.(*ast.CallExpr)
+		if !ok {
+			return nil
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return nil
+		}
+
+		switch sel.Sel.Name {
+		case "StaticSegment", "ResourceProviderSegment":
+			if len(call.Args) == 0 {
+				return nil
+			}
+			value, ok := stringLiteral(call.Args[len(call.Args)-1])
+			if !ok {
+				return nil
+			}
+			segments = append(segments, resourceIdSegment{Type: staticSegment, Value: value})
+		case "ScopeSegment":
+			segments = append(segments, resourceIdSegment{Type: scopeSegment})
+		default:
+			// e.g. `UserSpecifiedSegment`, `SubscriptionIdSegment`, `ResourceGroupSegment` and `ConstantSegment`
+			segments = append(segments, resourceIdSegment{Type: userSpecifiedSegment})
+		}
+	}
+	return segments
+}
+
+// segmentsFromIdFunc returns the segments for the format string used within an `ID` function, for example:
+//
+//	fmtString := "/subscriptions/%s/resourceGroups/%s"
+//
+// where a format string starting with `%s` is scoped to another Resource ID
+func segmentsFromIdFunc(body *ast.BlockStmt) []resourceIdSegment {
+	format := ""
+	ast.Inspect(body, func(n ast.Node) bool {
+		if v, ok := stringLiteral(n); ok && format == "" && (strings.HasPrefix(v, "/") || strings.HasPrefix(v, "%s/")) {
+			format = v
+		}
+		return format == ""
+	})
+	if format == "" {
+		return nil
+	}
+
+	segments := make([]resourceIdSegment, 0)
+	for i, v := range strings.Split(strings.TrimPrefix(format, "/"), "/") {
+		switch {
+		case i == 0 && !strings.HasPrefix(format, "/"):
+			segments = append(segments, resourceIdSegment{Type: scopeSegment})
+		case v == "%s":
+			segments = append(segments, resourceIdSegment{Type: userSpecifiedSegment})
+		case strings.Contains(v, "%"):
+			// e.g. a segment comprised of multiple values, which can't be compared
+			return nil
+		default:
+			segments = append(segments, resourceIdSegment{Type: staticSegment, Value: v})
+		}
+	}
+	return segments
+}
+
+func stringLiteral(n ast.Node) (string, bool) {
+	lit, ok := n.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	value, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", false
+	}
+	return value, true
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"go/ast"
+	"go/parser"
+	"testing"
+)
+
+func TestSegmentsMatch(t *testing.T) {
+	testData := []struct {
+		first    string
+		second   string
+		expected bool
+	}{
+		{
+			first:    `"/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s"`,
+			second:   `"/subscriptions/%s/resourceGroups/%s/providers/microsoft.web/sites/%s"`,
+			expected: true,
+		},
+		{
+			first:    `"/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s"`,
+			second:   `"/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/serverFarms/%s"`,
+			expected: false,
+		},
+		{
+			first:    `"/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s"`,
+			second:   `"/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/slots/%s"`,
+			expected: false,
+		},
+		{
+			// a scope comprises one or more segments
+			first:    `"%s/providers/Microsoft.CostManagement/exports/%s"`,
+			second:   `"/providers/Microsoft.Billing/billingAccounts/%s/providers/Microsoft.CostManagement/exports/%s"`,
+			expected: true,
+		},
+		{
+			first:    `"/subscriptions/%s/providers/Microsoft.CostManagement/views/%s"`,
+			second:   `"%s/providers/Microsoft.CostManagement/exports/%s"`,
+			expected: false,
+		},
+	}
+	for _, v := range testData {
+		first := segmentsFromIdFunc(idFuncBody(t, v.first))
+		second := segmentsFromIdFunc(idFuncBody(t, v.second))
+		if actual := segmentsMatch(first, second); actual != v.expected {
+			t.Fatalf("expected %t for %s and %s but got %t", v.expected, v.first, v.second, actual)
+		}
+	}
+}
+
+func idFuncBody(t *testing.T, format string) *ast.BlockStmt {
+	t.Helper()
+
+	expr, err := parser.ParseExpr(`func() string { fmtString := ` + format + `; return fmtString }`)
+	if err != nil {
+		t.Fatalf("parsing %s: %+v", format, err)
+	}
+	return expr.(*ast.FuncLit).Body
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// untypedResource is a Resource or Data Source registered within an Untyped Service Registration
+type untypedResource struct {
+	Name     string
+	Resource *pluginsdk.Resource
+}
+
+func (r untypedResource) readFunc() interface{} {
+	switch {
+	case r.Resource.Read != nil:
+		return r.Resource.Read
+	case r.Resource.ReadContext != nil:
+		return r.Resource.ReadContext
+	case r.Resource.ReadWithoutTimeout != nil:
+		return r.Resource.ReadWithoutTimeout
+	}
+	return nil
+}
+
+func (r untypedResource) hasUpdate() bool {
+	return r.Resource.Update != nil || r.Resource.UpdateContext != nil || r.Resource.UpdateWithoutTimeout != nil
+}
+
+// untypedResources returns the Untyped Resources for the specified Services, sorted by name
+func (s Services) untypedResources() []untypedResource {
+	out := make([]untypedResource, 0)
+	for _, service := range s.Untyped {
+		for name, resource := range service.SupportedResources() {
+			out = append(out, untypedResource{Name: name, Resource: resource})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
+
+// untypedDataSources returns the Untyped Data Sources for the specified Services, sorted by name
+func (s Services) untypedDataSources() []untypedResource {
+	out := make([]untypedResource, 0)
+	for _, service := range s.Untyped {
+		for name, dataSource := range service.SupportedDataSources() {
+			out = append(out, untypedResource{Name: name, Resource: dataSource})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
+
+func (s Services) typedResources() []sdk.Resource {
+	out := make([]sdk.Resource, 0)
+	for _, service := range s.Typed {
+		out = append(out, service.Resources()...)
+	}
+	return out
+}
+
+func (s Services) typedDataSources() []sdk.DataSource {
+	out := make([]sdk.DataSource, 0)
+	for _, service := range s.Typed {
+		out = append(out, service.DataSources()...)
+	}
+	return out
+}
+
+// schemaForResource returns the combined Arguments and Attributes for a Typed Resource/Data Source
+func schemaForResource(r interface {
+	Arguments() map[string]*pluginsdk.Schema
+	Attributes() map[string]*pluginsdk.Schema
+}) map[string]*pluginsdk.Schema {
+	out := make(map[string]*pluginsdk.Schema)
+	for k, v := range r.Arguments() {
+		out[k] = v
+	}
+	for k, v := range r.Attributes() {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,376 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// The rules which inspect the body of a function (such as a Read function) locate the source of that function
+// using the runtime, and then parse the file containing it - as such these must be run from the repository.
+var (
+	sourceFileSet   = token.NewFileSet()
+	sourceFiles     = map[string]*ast.File{}
+	sourceFilesLock = &sync.Mutex{}
+
+	packageFilesCache     = map[string]map[string]*ast.File{}
+	packageFilesCacheLock = &sync.Mutex{}
+)
+
+// sourceFunction is the body of a function, alongside the file it's defined in (which is used to resolve the
+// packages referenced within the body)
+type sourceFunction struct {
+	fileName string
+	file     *ast.File
+	body     *ast.BlockStmt
+}
+
+// functionSource returns the source of the specified function (which can either be a named function or a closure)
+func functionSource(fn interface{}) (*sourceFunction, error) {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return nil, fmt.Errorf("expected a function but got %T", fn)
+	}
+
+	f := runtime.FuncForPC(v.Pointer())
+	if f == nil {
+		return nil, fmt.Errorf("unable to locate the function %T", fn)
+	}
+	fileName, line := f.FileLine(f.Entry())
+
+	file, err := parseSourceFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	// the line for the entry point of a function is generally the line it's declared on, however (for example when
+	// there's no prologue) this can be the first statement - as such the innermost function containing this is used
+	var body *ast.BlockStmt
+	span := 0
+	ast.Inspect(file, func(n ast.Node) bool {
+		var candidate *ast.BlockStmt
+		switch t := n.(type) {
+		case *ast.FuncDecl:
+			candidate = t.Body
+		case *ast.FuncLit:
+			candidate = t.Body
+		default:
+			return true
+		}
+		if candidate == nil {
+			return true
+		}
+
+		start := sourceFileSet.Position(n.Pos()).Line
+		end := sourceFileSet.Position(n.End()).Line
+		if start == line {
+			body = candidate
+			return false
+		}
+		if start < line && line <= end && (body == nil || end-start < span) {
+			body = candidate
+			span = end - start
+		}
+		return true
+	})
+	if body == nil {
+		return nil, fmt.Errorf("unable to locate the function %s in %s:%d", f.Name(), fileName, line)
+	}
+
+	return &sourceFunction{
+		fileName: fileName,
+		file:     file,
+		body:     body,
+	}, nil
+}
+
+// functionSources returns the source of the specified function, alongside the source of the functions within the
+// same package which it calls - since Read functions commonly delegate to a shared function (for example each type
+// of Automation Variable calls `resourceAutomationVariableRead`)
+func functionSources(fn interface{}) ([]sourceFunction, error) {
+	src, err := functionSource(fn)
+	if err != nil {
+		return nil, err
+	}
+
+	functions, err := packageFunctions(filepath.Dir(src.fileName))
+	if err != nil {
+		return nil, err
+	}
+
+	sources := []sourceFunction{*src}
+	seen := make(map[string]struct{})
+	for _, call := range calledFunctions(src.body) {
+		if _, ok := seen[call.Name]; ok || call.Qualifier != "" {
+			continue
+		}
+		seen[call.Name] = struct{}{}
+
+		if fn, ok := functions[call.Name]; ok && fn.body != src.body {
+			sources = append(sources, fn)
+		}
+	}
+
+	return sources, nil
+}
+
+// functionName returns the name of the specified function in the form `package.Name`
+func functionName(fn interface{}) string {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return ""
+	}
+
+	f := runtime.FuncForPC(v.Pointer())
+	if f == nil {
+		return ""
+	}
+
+	// e.g. `github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/resourcegroups.ValidateResourceGroupID`
+	name := f.Name()
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
+
+func parseSourceFile(fileName string) (*ast.File, error) {
+	sourceFilesLock.Lock()
+	defer sourceFilesLock.Unlock()
+
+	if file, ok := sourceFiles[fileName]; ok {
+		return file, nil
+	}
+
+	file, err := parser.ParseFile(sourceFileSet, fileName, nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", fileName, err)
+	}
+	sourceFiles[fileName] = file
+
+	return file, nil
+}
+
+// packageFiles returns the parsed files for the package within the specified directory, keyed by file name - this
+// includes the test files within the same package, since these define the fixtures used to test the Rules
+func packageFiles(dir string) (map[string]*ast.File, error) {
+	packageFilesCacheLock.Lock()
+	defer packageFilesCacheLock.Unlock()
+
+	if files, ok := packageFilesCache[dir]; ok {
+		return files, nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading %q: %+v", dir, err)
+	}
+
+	files := make(map[string]*ast.File)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") {
+			continue
+		}
+
+		fileName := filepath.Join(dir, entry.Name())
+		file, err := parseSourceFile(fileName)
+		if err != nil {
+			return nil, err
+		}
+		if strings.HasSuffix(file.Name.Name, "_test") {
+			// external test packages (e.g. the acceptance tests) aren't a part of this package
+			continue
+		}
+		files[fileName] = file
+	}
+	packageFilesCache[dir] = files
+
+	return files, nil
+}
+
+// packageFunctions returns the functions (excluding methods) declared within the package in the specified directory
+func packageFunctions(dir string) (map[string]sourceFunction, error) {
+	files, err := packageFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	functions := make(map[string]sourceFunction)
+	for fileName, file := range files {
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && fn.Body != nil {
+				functions[fn.Name.Name] = sourceFunction{
+					fileName: fileName,
+					file:     file,
+					body:     fn.Body,
+				}
+			}
+		}
+	}
+
+	return functions, nil
+}
+
+// importedPackageDir returns the directory containing the package imported as `qualifier` within the specified file,
+// or the directory containing the file itself when no qualifier is specified
+func importedPackageDir(fileName string, file *ast.File, qualifier string) string {
+	dir := filepath.Dir(fileName)
+	if qualifier == "" {
+		return dir
+	}
+
+	for _, spec := range file.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+
+		name := path.Base(importPath)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		if name != qualifier {
+			continue
+		}
+
+		root, module := moduleForDir(dir)
+		if root == "" {
+			return ""
+		}
+		if importPath == module || strings.HasPrefix(importPath, module+"/") {
+			return filepath.Join(root, strings.TrimPrefix(importPath, module))
+		}
+		return filepath.Join(root, "vendor", importPath)
+	}
+
+	return ""
+}
+
+// moduleForDir returns the root directory and the path of the Go Module containing the specified directory
+func moduleForDir(dir string) (root string, module string) {
+	for d := dir; ; d = filepath.Dir(d) {
+		if contents, err := os.ReadFile(filepath.Join(d, "go.mod")); err == nil {
+			for _, line := range strings.Split(string(contents), "\n") {
+				if v, ok := strings.CutPrefix(strings.TrimSpace(line), "module "); ok {
+					return d, strings.TrimSpace(v)
+				}
+			}
+			return "", ""
+		}
+
+		if filepath.Dir(d) == d {
+			return "", ""
+		}
+	}
+}
+
+// calledFunction is a function called within the body of another function
+type calledFunction struct {
+	// Qualifier is the package (or variable) the function was called on, if any
+	Qualifier string
+
+	// Name is the name of the function
+	Name string
+
+	Args []ast.Expr
+}
+
+// calledFunctions returns the functions called within the specified body
+func calledFunctions(body ast.Node) []calledFunction {
+	calls := make([]calledFunction, 0)
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		switch fun := call.Fun.(type) {
+		case *ast.Ident:
+			calls = append(calls, calledFunction{
+				Name: fun.Name,
+				Args: call.Args,
+			})
+		case *ast.SelectorExpr:
+			qualifier := ""
+			if ident, ok := fun.X.(*ast.Ident); ok {
+				qualifier = ident.Name
+			}
+			calls = append(calls, calledFunction{
+				Qualifier: qualifier,
+				Name:      fun.Sel.Name,
+				Args:      call.Args,
+			})
+		}
+		return true
+	})
+	return calls
+}
+
+// referencesIdentifier returns whether any of the specified identifiers are referenced within the specified body
+func referencesIdentifier(body ast.Node, identifiers ...string) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		if ident, ok := n.(*ast.Ident); ok {
+			for _, v := range identifiers {
+				if ident.Name == v {
+					found = true
+				}
+			}
+		}
+		return true
+	})
+	return found
+}
+
+var (
+	resourceIdParserPattern      = regexp.MustCompile(`^Parse([A-Za-z0-9]+ID)(Insensitively)?$`)
+	resourceIdConstructorPattern = regexp.MustCompile(`^New([A-Za-z0-9]+ID)$`)
+)
+
+// parsedResourceIds returns the Resource IDs (e.g. `ResourceGroupID`) parsed within the specified functions, either
+// using a go-azure-sdk parser (e.g. `ParseResourceGroupID`) or a `parse` package (e.g. `parse.ResourceGroupID`)
+func parsedResourceIds(sources ...sourceFunction) []resourceIdReference {
+	ids := make([]resourceIdReference, 0)
+	for _, src := range sources {
+		for _, call := range calledFunctions(src.body) {
+			if m := resourceIdParserPattern.FindStringSubmatch(call.Name); m != nil {
+				ids = append(ids, newResourceIdReference(src, call, m[1]))
+				continue
+			}
+			if name := strings.TrimSuffix(call.Name, "Insensitively"); call.Qualifier == "parse" && strings.HasSuffix(name, "ID") && !strings.HasPrefix(name, "New") {
+				ids = append(ids, newResourceIdReference(src, call, name))
+			}
+		}
+	}
+	return ids
+}
+
+// constructedResourceIds returns the Resource IDs (e.g. `ResourceGroupID`) constructed within the specified functions
+// (e.g. using `NewResourceGroupID`)
+func constructedResourceIds(sources ...sourceFunction) []resourceIdReference {
+	ids := make([]resourceIdReference, 0)
+	for _, src := range sources {
+		for _, call := range calledFunctions(src.body) {
+			if m := resourceIdConstructorPattern.FindStringSubmatch(call.Name); m != nil {
+				ids = append(ids, newResourceIdReference(src, call, m[1]))
+			}
+		}
+	}
+	return ids
+}
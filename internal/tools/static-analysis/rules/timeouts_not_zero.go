@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ Rule = TimeoutsNotZero{}
+
+type TimeoutsNotZero struct{}
+
+func (r TimeoutsNotZero) Run(services Services) (errors []error) {
+	for _, resource := range services.typedResources() {
+		timeouts := map[string]time.Duration{
+			"Create": resource.Create().Timeout,
+			"Read":   resource.Read().Timeout,
+			"Delete": resource.Delete().Timeout,
+		}
+		if v, ok := resource.(sdk.ResourceWithUpdate); ok {
+			timeouts["Update"] = v.Update().Timeout
+		}
+		for _, operation := range []string{"Create", "Read", "Update", "Delete"} {
+			if timeout, ok := timeouts[operation]; ok && timeout <= 0 {
+				errors = append(errors, fmt.Errorf("%s: the %s function has a Timeout of %s", resource.ResourceType(), operation, timeout))
+			}
+		}
+	}
+
+	for _, dataSource := range services.typedDataSources() {
+		if timeout := dataSource.Read().Timeout; timeout <= 0 {
+			errors = append(errors, fmt.Errorf("%s: the Read function has a Timeout of %s", dataSource.ResourceType(), timeout))
+		}
+	}
+
+	for _, resource := range services.untypedResources() {
+		errors = append(errors, checkUntypedTimeouts(resource, true)...)
+	}
+
+	for _, dataSource := range services.untypedDataSources() {
+		errors = append(errors, checkUntypedTimeouts(dataSource, false)...)
+	}
+
+	return
+}
+
+func (r TimeoutsNotZero) Name() string {
+	return "timeoutsNotZero"
+}
+
+func (r TimeoutsNotZero) Description() string {
+	return fmt.Sprintf(`
+The '%s' check function is used to check that each function for a Resource/Data Source has a Timeout which is greater
+than zero - since otherwise the context for this function is cancelled immediately.
+`, r.Name())
+}
+
+func checkUntypedTimeouts(r untypedResource, isResource bool) (errors []error) {
+	if r.Resource.Timeouts == nil {
+		return []error{fmt.Errorf("%s: no Timeouts are defined", r.Name)}
+	}
+
+	timeouts := map[string]*time.Duration{
+		"Read": r.Resource.Timeouts.Read,
+	}
+	if isResource {
+		timeouts["Create"] = r.Resource.Timeouts.Create
+		timeouts["Delete"] = r.Resource.Timeouts.Delete
+		if r.hasUpdate() {
+			timeouts["Update"] = r.Resource.Timeouts.Update
+		}
+	}
+
+	for _, operation := range []string{"Create", "Read", "Update", "Delete"} {
+		timeout, ok := timeouts[operation]
+		if !ok {
+			continue
+		}
+		if timeout == nil {
+			errors = append(errors, fmt.Errorf("%s: no %s Timeout is defined", r.Name, operation))
+			continue
+		}
+		if *timeout <= 0 {
+			errors = append(errors, fmt.Errorf("%s: the %s Timeout is %s", r.Name, operation, *timeout))
+		}
+	}
+
+	return
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestTimeoutsNotZero(t *testing.T) {
+	services := fixtureServices([]sdk.Resource{
+		fixtureResource{
+			resourceType: "azurerm_zero_timeout",
+			arguments:    exampleArguments(true),
+			model:        &exampleModel{},
+			read:         readExampleMarkedAsGone,
+			idValidation: ValidateExampleID,
+		},
+	}, []sdk.DataSource{
+		fixtureDataSource{
+			resourceType: "azurerm_zero_timeout",
+			model:        &exampleModel{},
+			read:         readExampleDataSource,
+		},
+	}, map[string]*pluginsdk.Resource{
+		"azurerm_untyped_zero_timeout": {
+			Read:     readUntypedExample,
+			Update:   updateUntyped,
+			Timeouts: untypedTimeouts(30*time.Minute, 5*time.Minute, 0, 30*time.Minute),
+			Schema:   exampleArguments(false),
+		},
+		"azurerm_untyped_without_timeouts": {
+			Read:   readUntypedExample,
+			Schema: exampleArguments(true),
+		},
+	}, map[string]*pluginsdk.Resource{
+		"azurerm_untyped_without_read_timeout": {
+			Read:     readUntypedDataSource,
+			Timeouts: &pluginsdk.ResourceTimeout{},
+			Schema:   exampleArguments(false),
+		},
+	})
+
+	assertErrors(t, TimeoutsNotZero{}.Run(services),
+		"azurerm_zero_timeout: the Create function has a Timeout of 0s",
+		"azurerm_zero_timeout: the Read function has a Timeout of 0s",
+		"azurerm_untyped_without_timeouts: no Timeouts are defined",
+		"azurerm_untyped_zero_timeout: the Update Timeout is 0s",
+		"azurerm_untyped_without_read_timeout: no Read Timeout is defined",
+	)
+}
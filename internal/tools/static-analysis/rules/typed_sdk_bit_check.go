@@ -7,15 +7,15 @@ import (
 	"fmt"
 	"reflect"
 
-	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 )
 
 var _ Rule = TypedSDKBitCheck{}
 
 type TypedSDKBitCheck struct{}
 
-func (r TypedSDKBitCheck) Run() (errors []error) {
-	for _, s := range provider.SupportedTypedServices() {
+func (r TypedSDKBitCheck) Run(services Services) (errors []error) {
+	for _, s := range services.Typed {
 		for _, resource := range s.Resources() {
 			modelType := reflect.TypeOf(resource.ModelObject())
 			switch {
@@ -37,7 +37,13 @@ func (r TypedSDKBitCheck) Run() (errors []error) {
 
 		}
 		for _, datasource := range s.DataSources() {
-			modelType := reflect.TypeOf(datasource.ModelObject())
+			model := datasource.ModelObject()
+			if v, ok := datasource.(sdk.DataSourceWithItemModelObject); ok {
+				// the arguments for a list Data Source aren't decoded into a model, but each item is encoded from one
+				model = v.ItemModelObject()
+			}
+
+			modelType := reflect.TypeOf(model)
 			if modelType != nil && modelType.Kind() == reflect.Ptr { // Have to nil-check here due to base types not having a model. e.g. roleAssignmentBaseResource
 				model := modelType.Elem()
 				if model.Kind() != reflect.Struct {
@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+func TestTypedSDKBitCheck(t *testing.T) {
+	services := fixtureServices(nil, []sdk.DataSource{
+		sdk.NewListDataSource(fixtureListDataSource{
+			resourceType: "azurerm_examples",
+			itemModel:    &exampleItemModel{},
+		}),
+		sdk.NewListDataSource(fixtureListDataSource{
+			resourceType: "azurerm_examples_with_int",
+			itemModel:    &exampleItemModelWithInt{},
+		}),
+		fixtureDataSource{
+			resourceType: "azurerm_without_model",
+			read:         readExampleDataSource,
+		},
+	}, nil, nil)
+
+	assertErrors(t, TypedSDKBitCheck{}.Run(services),
+		"property Count in model exampleItemModelWithInt should be type int64",
+		`"azurerm_without_model" cannot be bit checked`,
+	)
+}
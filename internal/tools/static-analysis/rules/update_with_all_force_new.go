@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ Rule = UpdateWithAllForceNew{}
+
+type UpdateWithAllForceNew struct{}
+
+func (r UpdateWithAllForceNew) Run(services Services) (errors []error) {
+	for _, resource := range services.typedResources() {
+		if _, ok := resource.(sdk.ResourceWithUpdate); !ok {
+			continue
+		}
+		if !hasUpdatableArgument(resource.Arguments()) {
+			errors = append(errors, fmt.Errorf("%s: implements `ResourceWithUpdate` but all of the Arguments are ForceNew", resource.ResourceType()))
+		}
+	}
+
+	for _, resource := range services.untypedResources() {
+		if !resource.hasUpdate() {
+			continue
+		}
+		if !hasUpdatableArgument(resource.Resource.Schema) {
+			errors = append(errors, fmt.Errorf("%s: defines an Update function but all of the Arguments are ForceNew", resource.Name))
+		}
+	}
+
+	return
+}
+
+func (r UpdateWithAllForceNew) Name() string {
+	return "updateWithAllForceNew"
+}
+
+func (r UpdateWithAllForceNew) Description() string {
+	return fmt.Sprintf(`
+The '%s' check function is used to check that Resources which support being Updated have at least one Argument which
+isn't ForceNew - otherwise the Update function can never be called and should be removed.
+`, r.Name())
+}
+
+func hasUpdatableArgument(input map[string]*pluginsdk.Schema) bool {
+	for _, v := range input {
+		// Computed-only fields are Attributes rather than Arguments
+		if v.Computed && !v.Optional && !v.Required {
+			continue
+		}
+		if !v.ForceNew {
+			return true
+		}
+	}
+	return false
+}
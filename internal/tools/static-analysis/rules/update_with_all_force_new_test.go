@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestUpdateWithAllForceNew(t *testing.T) {
+	services := fixtureServices([]sdk.Resource{
+		fixtureResourceWithUpdate{
+			fixtureResource: fixtureResource{
+				resourceType: "azurerm_all_force_new",
+				arguments:    exampleArguments(true),
+				model:        &exampleModel{},
+				read:         readExampleMarkedAsGone,
+				timeout:      30 * time.Minute,
+				idValidation: ValidateExampleID,
+			},
+		},
+		fixtureResource{
+			resourceType: "azurerm_all_force_new_without_update",
+			arguments:    exampleArguments(true),
+			model:        &exampleModel{},
+			read:         readExampleMarkedAsGone,
+			timeout:      30 * time.Minute,
+			idValidation: ValidateExampleID,
+		},
+	}, nil, map[string]*pluginsdk.Resource{
+		"azurerm_untyped_all_force_new": {
+			Read:     readUntypedExample,
+			Update:   updateUntyped,
+			Timeouts: untypedTimeouts(time.Minute, time.Minute, time.Minute, time.Minute),
+			Schema:   exampleArguments(true),
+		},
+	}, nil)
+
+	assertErrors(t, UpdateWithAllForceNew{}.Run(services),
+		"azurerm_all_force_new: implements `ResourceWithUpdate` but all of the Arguments are ForceNew",
+		"azurerm_untyped_all_force_new: defines an Update function but all of the Arguments are ForceNew",
+	)
+}
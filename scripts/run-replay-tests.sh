@@ -0,0 +1,36 @@
+#!/usr/bin/env bash
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+
+function runReplayTests {
+  echo "==> Running Acceptance Tests against the recorded traffic..."
+
+  IFS=$'\n' read -r -d '' -a cassettes < <(find ./internal -path '*/testdata/recordings/*.json' -type f | sort)
+  if [ ${#cassettes[@]} -eq 0 ]; then
+    # the recordings are expected to be committed to the repository, so this is a failure rather than a no-op
+    echo "No recordings were found within ./internal/**/testdata/recordings"
+    return 1
+  fi
+
+  # each recording is named after the test it was recorded for, and lives within the package containing that test
+  local packages
+  packages=$(for c in "${cassettes[@]}"; do dirname "$(dirname "$(dirname "$c")")"; done | sort -u)
+
+  local result=0
+  for pkg in $packages; do
+    local tests
+    tests=$(find "$pkg/testdata/recordings" -maxdepth 1 -name '*.json' -type f -exec basename {} .json \; | sort | paste -sd '|' -)
+
+    echo "==> Replaying $tests in $pkg..."
+    ARM_TEST_RECORDING_MODE=replay TF_ACC=1 go test -v "$pkg" -run "^($tests)\$" -timeout "${TESTTIMEOUT:-180m}" || result=1
+  done
+
+  return $result
+}
+
+function main {
+  runReplayTests || exit 1
+}
+
+main
@@ -5,9 +5,8 @@
 
 function runStaticAnalysis {
 # This tool checks for code conformity within the provider e.g. are the correct Go types used in TypedSDK structs.
-# Currently will not fail GHA's etc as we have existing violations in `main`. -fail-on-error=false can be removed when
-# these are resolved to prevent PRs introducing this in future.
-  go run internal/tools/static-analysis/main.go -fail-on-error=false
+# Existing violations which are intentional are listed in `internal/tools/static-analysis/rules/exceptions.go`.
+  go run internal/tools/static-analysis/main.go
 }
 
 function main {